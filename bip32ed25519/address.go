@@ -0,0 +1,321 @@
+package bip32ed25519
+
+import (
+	"fmt"
+
+	"github.com/islishude/bip32/v2/internal/bech32"
+	"golang.org/x/crypto/blake2b"
+)
+
+// KeyHashSize is the size of a BLAKE2b-224 key or script hash, the credential
+// that Shelley addresses commit to.
+const KeyHashSize = 28
+
+// NetworkID is the Cardano network id in the low four bits of an address
+// header. Every network other than Mainnet uses the _test bech32 prefixes.
+type NetworkID uint8
+
+const (
+	// Testnet is the network id of the preprod and preview test networks.
+	Testnet NetworkID = 0
+	// Mainnet is the network id of the Cardano mainnet.
+	Mainnet NetworkID = 1
+)
+
+// AddressType selects a CIP-19 Shelley address kind.
+type AddressType uint8
+
+const (
+	// BaseAddress pays to a payment credential and delegates with a stake
+	// credential.
+	BaseAddress AddressType = iota + 1
+	// PointerAddress delegates through a Pointer to the certificate that
+	// registered its stake credential.
+	PointerAddress
+	// EnterpriseAddress has a payment credential and takes no part in staking.
+	EnterpriseAddress
+	// RewardAddress is the stake1… account that staking rewards accrue to.
+	RewardAddress
+)
+
+// Address header types, the high four bits of the header byte. Types 0 to 3
+// are base addresses, whose low two bits mark script payment and stake
+// credentials; the others mark a script payment credential in the low bit.
+const (
+	headerBase       = 0b0000
+	headerPointer    = 0b0100
+	headerEnterprise = 0b0110
+	headerReward     = 0b1110
+)
+
+// Credential is a payment or stake credential: the BLAKE2b-224 hash of a
+// verification key or, when Script is set, of a native or Plutus script.
+type Credential struct {
+	Hash   [KeyHashSize]byte
+	Script bool
+}
+
+// Pointer locates the stake registration certificate a PointerAddress
+// delegates through, by slot, transaction index, and certificate index.
+type Pointer struct {
+	Slot, TxIndex, CertIndex uint64
+}
+
+// Address is a Shelley address. Stake is set for base and reward addresses,
+// Payment for all but reward addresses, and Pointer for pointer addresses.
+type Address struct {
+	Type    AddressType
+	Network NetworkID
+	Payment Credential
+	Stake   Credential
+	Pointer Pointer
+}
+
+// KeyHash returns the BLAKE2b-224 hash of the public key of p, its address
+// credential.
+func (p *XPub) KeyHash() ([KeyHashSize]byte, error) {
+	if p == nil {
+		return [KeyHashSize]byte{}, ErrNilKey
+	}
+	h, _ := blake2b.New(KeyHashSize, nil)
+	_, _ = h.Write(p.pub[:])
+	return [KeyHashSize]byte(h.Sum(nil)), nil
+}
+
+// NewBaseAddress returns the base address paying to payment and delegating
+// with stake, typically the keys at .../0/i and .../2/0 of one account.
+func NewBaseAddress(network NetworkID, payment, stake *XPub) (*Address, error) {
+	paymentCred, err := keyCredential(payment)
+	if err != nil {
+		return nil, err
+	}
+	stakeCred, err := keyCredential(stake)
+	if err != nil {
+		return nil, err
+	}
+	return &Address{Type: BaseAddress, Network: network, Payment: paymentCred, Stake: stakeCred}, nil
+}
+
+// NewPointerAddress returns the pointer address paying to payment and
+// delegating through pointer.
+func NewPointerAddress(network NetworkID, payment *XPub, pointer Pointer) (*Address, error) {
+	paymentCred, err := keyCredential(payment)
+	if err != nil {
+		return nil, err
+	}
+	return &Address{Type: PointerAddress, Network: network, Payment: paymentCred, Pointer: pointer}, nil
+}
+
+// NewEnterpriseAddress returns the enterprise address paying to payment.
+func NewEnterpriseAddress(network NetworkID, payment *XPub) (*Address, error) {
+	paymentCred, err := keyCredential(payment)
+	if err != nil {
+		return nil, err
+	}
+	return &Address{Type: EnterpriseAddress, Network: network, Payment: paymentCred}, nil
+}
+
+// NewRewardAddress returns the reward address of stake.
+func NewRewardAddress(network NetworkID, stake *XPub) (*Address, error) {
+	stakeCred, err := keyCredential(stake)
+	if err != nil {
+		return nil, err
+	}
+	return &Address{Type: RewardAddress, Network: network, Stake: stakeCred}, nil
+}
+
+func keyCredential(p *XPub) (Credential, error) {
+	hash, err := p.KeyHash()
+	if err != nil {
+		return Credential{}, err
+	}
+	return Credential{Hash: hash}, nil
+}
+
+// Bytes returns the binary address: a header byte of type and network id,
+// then the credentials and, for pointer addresses, the pointer.
+func (a *Address) Bytes() ([]byte, error) {
+	if a == nil {
+		return nil, ErrInvalidAddress
+	}
+	if a.Network > 0x0f {
+		return nil, fmt.Errorf("%w: network id %d does not fit in four bits", ErrInvalidAddress, a.Network)
+	}
+	var header byte
+	switch a.Type {
+	case BaseAddress:
+		header = headerBase | scriptBit(a.Stake)<<1 | scriptBit(a.Payment)
+	case PointerAddress:
+		header = headerPointer | scriptBit(a.Payment)
+	case EnterpriseAddress:
+		header = headerEnterprise | scriptBit(a.Payment)
+	case RewardAddress:
+		header = headerReward | scriptBit(a.Stake)
+	default:
+		return nil, fmt.Errorf("%w: unknown address type %d", ErrInvalidAddress, a.Type)
+	}
+
+	out := []byte{header<<4 | byte(a.Network)}
+	if a.Type != RewardAddress {
+		out = append(out, a.Payment.Hash[:]...)
+	}
+	switch a.Type {
+	case BaseAddress, RewardAddress:
+		out = append(out, a.Stake.Hash[:]...)
+	case PointerAddress:
+		out = appendVarNat(out, a.Pointer.Slot)
+		out = appendVarNat(out, a.Pointer.TxIndex)
+		out = appendVarNat(out, a.Pointer.CertIndex)
+	}
+	return out, nil
+}
+
+// Encode returns the bech32 form of a: addr1… or addr_test1… for payment
+// addresses and stake1… or stake_test1… for reward addresses.
+func (a *Address) Encode() (string, error) {
+	raw, err := a.Bytes()
+	if err != nil {
+		return "", err
+	}
+	data, _ := bech32.ConvertBits(raw, 8, 5, true)
+	encoded, _ := bech32.Encode(addressHRP(a.Type, a.Network), data, bech32.Bech32)
+	return encoded, nil
+}
+
+// ParseAddress parses a bech32 Shelley address and checks that its prefix
+// agrees with its header. Byron addresses return ErrInvalidAddress; use
+// ParseByronAddress for them.
+func ParseAddress(text string) (*Address, error) {
+	hrp, data, encoding, ok := bech32.Decode(text, bech32.MaxLengthCIP5)
+	if !ok || encoding != bech32.Bech32 {
+		return nil, fmt.Errorf("%w: malformed bech32 string", ErrInvalidAddress)
+	}
+	raw, ok := bech32.ConvertBits(data, 5, 8, false)
+	if !ok {
+		return nil, fmt.Errorf("%w: malformed bech32 string", ErrInvalidAddress)
+	}
+	a, err := NewAddressFromBytes(raw)
+	if err != nil {
+		return nil, err
+	}
+	if want := addressHRP(a.Type, a.Network); hrp != want {
+		return nil, fmt.Errorf("%w: prefix %q, want %q", ErrInvalidAddress, hrp, want)
+	}
+	return a, nil
+}
+
+// NewAddressFromBytes parses a binary Shelley address.
+func NewAddressFromBytes(b []byte) (*Address, error) {
+	if len(b) == 0 {
+		return nil, ErrInvalidAddress
+	}
+	header, body := b[0]>>4, b[1:]
+	a := &Address{Network: NetworkID(b[0] & 0x0f)}
+	switch {
+	case header <= headerBase|0b11:
+		a.Type = BaseAddress
+		a.Payment.Script = header&0b01 != 0
+		a.Stake.Script = header&0b10 != 0
+	case header&^1 == headerPointer:
+		a.Type = PointerAddress
+		a.Payment.Script = header&1 != 0
+	case header&^1 == headerEnterprise:
+		a.Type = EnterpriseAddress
+		a.Payment.Script = header&1 != 0
+	case header&^1 == headerReward:
+		a.Type = RewardAddress
+		a.Stake.Script = header&1 != 0
+	default:
+		return nil, fmt.Errorf("%w: unsupported header type %d", ErrInvalidAddress, header)
+	}
+
+	if a.Type != RewardAddress {
+		if len(body) < KeyHashSize {
+			return nil, fmt.Errorf("%w: %d-byte %s address", ErrInvalidAddress, len(b), a.Type)
+		}
+		a.Payment.Hash = [KeyHashSize]byte(body[:KeyHashSize])
+		body = body[KeyHashSize:]
+	}
+	switch a.Type {
+	case BaseAddress, RewardAddress:
+		if len(body) < KeyHashSize {
+			return nil, fmt.Errorf("%w: %d-byte %s address", ErrInvalidAddress, len(b), a.Type)
+		}
+		a.Stake.Hash = [KeyHashSize]byte(body[:KeyHashSize])
+		body = body[KeyHashSize:]
+	case PointerAddress:
+		var ok bool
+		for _, field := range []*uint64{&a.Pointer.Slot, &a.Pointer.TxIndex, &a.Pointer.CertIndex} {
+			if *field, body, ok = readVarNat(body); !ok {
+				return nil, fmt.Errorf("%w: malformed pointer", ErrInvalidAddress)
+			}
+		}
+	}
+	if len(body) != 0 {
+		return nil, fmt.Errorf("%w: %d-byte %s address", ErrInvalidAddress, len(b), a.Type)
+	}
+	return a, nil
+}
+
+// String returns "base", "pointer", "enterprise", or "reward".
+func (t AddressType) String() string {
+	switch t {
+	case BaseAddress:
+		return "base"
+	case PointerAddress:
+		return "pointer"
+	case EnterpriseAddress:
+		return "enterprise"
+	case RewardAddress:
+		return "reward"
+	default:
+		return fmt.Sprintf("AddressType(%d)", uint8(t))
+	}
+}
+
+func addressHRP(t AddressType, network NetworkID) string {
+	hrp := "addr"
+	if t == RewardAddress {
+		hrp = "stake"
+	}
+	if network != Mainnet {
+		hrp += "_test"
+	}
+	return hrp
+}
+
+func scriptBit(c Credential) byte {
+	if c.Script {
+		return 1
+	}
+	return 0
+}
+
+// appendVarNat appends n in the pointer encoding: big-endian groups of seven
+// bits, with the high bit set on every byte but the last.
+func appendVarNat(out []byte, n uint64) []byte {
+	var buf [10]byte
+	i := len(buf) - 1
+	buf[i] = byte(n & 0x7f)
+	for n >>= 7; n != 0; n >>= 7 {
+		i--
+		buf[i] = byte(n&0x7f) | 0x80
+	}
+	return append(out, buf[i:]...)
+}
+
+// readVarNat reads one appendVarNat value from b and returns the rest. It
+// reports false for a truncated value or one above 2^64-1.
+func readVarNat(b []byte) (uint64, []byte, bool) {
+	var n uint64
+	for i, c := range b {
+		if n > (1<<64-1)>>7 {
+			return 0, nil, false
+		}
+		n = n<<7 | uint64(c&0x7f)
+		if c&0x80 == 0 {
+			return n, b[i+1:], true
+		}
+	}
+	return 0, nil, false
+}
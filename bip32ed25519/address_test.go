@@ -0,0 +1,123 @@
+package bip32ed25519
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/islishude/bip32/v2/internal/bech32"
+)
+
+// CIP-19 test vectors.
+const (
+	cip19PaymentVK = "addr_vk1w0l2sr2zgfm26ztc6nl9xy8ghsk5sh6ldwemlpmp9xylzy4dtf7st80zhd"
+	cip19StakeVK   = "stake_vk1px4j0r2fk7ux5p23shz8f3y5y2qam7s954rgf3lg5merqcj6aetsft99wu"
+	cip19Script    = "c37b1b5dc0669f1d3c61a6fddb2e8fde96be87b881c60bce8e8d542f"
+)
+
+var cip19Pointer = Pointer{Slot: 2498243, TxIndex: 27, CertIndex: 3}
+
+func cip19XPub(t *testing.T, text string) *XPub {
+	t.Helper()
+	pub, _, err := ParsePublicKeyBech32(text)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &XPub{pub: pub}
+}
+
+func TestShelleyAddressCIP19Vectors(t *testing.T) {
+	payment := cip19XPub(t, cip19PaymentVK)
+	stake := cip19XPub(t, cip19StakeVK)
+	paymentCred, _ := keyCredential(payment)
+	stakeCred, _ := keyCredential(stake)
+	script := Credential{Hash: [KeyHashSize]byte(mustDecodeHex(t, cip19Script)), Script: true}
+
+	build := func(newAddress func() (*Address, error)) *Address {
+		t.Helper()
+		a, err := newAddress()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return a
+	}
+	for _, tc := range []struct {
+		address *Address
+		want    string
+	}{
+		{build(func() (*Address, error) { return NewBaseAddress(Mainnet, payment, stake) }),
+			"addr1qx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzer3n0d3vllmyqwsx5wktcd8cc3sq835lu7drv2xwl2wywfgse35a3x"},
+		{build(func() (*Address, error) { return NewBaseAddress(Testnet, payment, stake) }),
+			"addr_test1qz2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzer3n0d3vllmyqwsx5wktcd8cc3sq835lu7drv2xwl2wywfgs68faae"},
+		{&Address{Type: BaseAddress, Network: Mainnet, Payment: script, Stake: stakeCred},
+			"addr1z8phkx6acpnf78fuvxn0mkew3l0fd058hzquvz7w36x4gten0d3vllmyqwsx5wktcd8cc3sq835lu7drv2xwl2wywfgs9yc0hh"},
+		{&Address{Type: BaseAddress, Network: Mainnet, Payment: paymentCred, Stake: script},
+			"addr1yx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzerkr0vd4msrxnuwnccdxlhdjar77j6lg0wypcc9uar5d2shs2z78ve"},
+		{&Address{Type: BaseAddress, Network: Mainnet, Payment: script, Stake: script},
+			"addr1x8phkx6acpnf78fuvxn0mkew3l0fd058hzquvz7w36x4gt7r0vd4msrxnuwnccdxlhdjar77j6lg0wypcc9uar5d2shskhj42g"},
+		{build(func() (*Address, error) { return NewPointerAddress(Mainnet, payment, cip19Pointer) }),
+			"addr1gx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzer5pnz75xxcrzqf96k"},
+		{build(func() (*Address, error) { return NewPointerAddress(Testnet, payment, cip19Pointer) }),
+			"addr_test1gz2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzer5pnz75xxcrdw5vky"},
+		{&Address{Type: PointerAddress, Network: Mainnet, Payment: script, Pointer: cip19Pointer},
+			"addr128phkx6acpnf78fuvxn0mkew3l0fd058hzquvz7w36x4gtupnz75xxcrtw79hu"},
+		{build(func() (*Address, error) { return NewEnterpriseAddress(Mainnet, payment) }),
+			"addr1vx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzers66hrl8"},
+		{build(func() (*Address, error) { return NewEnterpriseAddress(Testnet, payment) }),
+			"addr_test1vz2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzerspjrlsz"},
+		{&Address{Type: EnterpriseAddress, Network: Mainnet, Payment: script},
+			"addr1w8phkx6acpnf78fuvxn0mkew3l0fd058hzquvz7w36x4gtcyjy7wx"},
+		{build(func() (*Address, error) { return NewRewardAddress(Mainnet, stake) }),
+			"stake1uyehkck0lajq8gr28t9uxnuvgcqrc6070x3k9r8048z8y5gh6ffgw"},
+		{build(func() (*Address, error) { return NewRewardAddress(Testnet, stake) }),
+			"stake_test1uqehkck0lajq8gr28t9uxnuvgcqrc6070x3k9r8048z8y5gssrtvn"},
+		{&Address{Type: RewardAddress, Network: Mainnet, Stake: script},
+			"stake178phkx6acpnf78fuvxn0mkew3l0fd058hzquvz7w36x4gtcccycj5"},
+	} {
+		got, err := tc.address.Encode()
+		if err != nil || got != tc.want {
+			t.Fatalf("%v address = %s, %v, want %s", tc.address.Type, got, err, tc.want)
+		}
+		parsed, err := ParseAddress(tc.want)
+		if err != nil {
+			t.Fatalf("ParseAddress(%s): %v", tc.want, err)
+		}
+		if *parsed != *tc.address {
+			t.Fatalf("ParseAddress(%s) = %+v, want %+v", tc.want, parsed, tc.address)
+		}
+	}
+}
+
+func TestParseAddressErrors(t *testing.T) {
+	encode := func(hrp string, raw []byte) string {
+		data, _ := bech32.ConvertBits(raw, 8, 5, true)
+		text, _ := bech32.Encode(hrp, data, bech32.Bech32)
+		return text
+	}
+	enterprise := append([]byte{0x61}, make([]byte, KeyHashSize)...)
+	for _, tc := range []struct {
+		name, text string
+	}{
+		{"not bech32", "addr1"},
+		{"stake prefix on payment address", encode("stake", enterprise)},
+		{"testnet prefix on mainnet address", encode("addr_test", enterprise)},
+		{"short", encode("addr", enterprise[:KeyHashSize])},
+		{"trailing byte", encode("addr", append(enterprise, 0))},
+		{"byron header", encode("addr", append([]byte{0x82}, make([]byte, KeyHashSize)...))},
+		{"truncated pointer", encode("addr", append(append([]byte{0x41}, make([]byte, KeyHashSize)...), 0x81, 0x00, 0x80))},
+		{"pointer overflow", encode("addr", append(append(append([]byte{0x41}, make([]byte, KeyHashSize)...),
+			0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x7f), 0, 0))},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := ParseAddress(tc.text); !errors.Is(err, ErrInvalidAddress) {
+				t.Fatalf("ParseAddress error = %v", err)
+			}
+		})
+	}
+
+	if _, err := (&Address{Type: EnterpriseAddress, Network: 16}).Encode(); !errors.Is(err, ErrInvalidAddress) {
+		t.Fatalf("network id 16 error = %v", err)
+	}
+	if _, err := NewBaseAddress(Mainnet, nil, nil); !errors.Is(err, ErrNilKey) {
+		t.Fatalf("nil key error = %v", err)
+	}
+}
@@ -52,6 +52,56 @@ func addMod256LE(dst *[32]byte, x []byte) {
 	// The final carry is intentionally discarded to get modulo 2^256 behavior.
 }
 
+// sub28Mul8LE subtracts 8 * ZL from dst, undoing add28Mul8LE.
+//
+// It reports whether the subtraction borrowed, in which case dst was not the
+// result of an add28Mul8LE that did not overflow.
+func sub28Mul8LE(dst *[32]byte, zl []byte) (borrow bool) {
+	if len(zl) != 28 {
+		panic("bip32ed25519: ZL must be 28 bytes")
+	}
+
+	var shiftCarry uint16
+	var subBorrow uint16
+
+	for i := range 32 {
+		var subtrahend uint16
+
+		switch {
+		case i < 28:
+			v := (uint16(zl[i]) << 3) | shiftCarry
+			subtrahend = v & 0xff
+			shiftCarry = v >> 8
+		case i == 28:
+			subtrahend = shiftCarry
+			shiftCarry = 0
+		default:
+			subtrahend = 0
+		}
+
+		diff := uint16(dst[i]) - subtrahend - subBorrow
+		dst[i] = byte(diff)
+		subBorrow = (diff >> 8) & 1
+	}
+
+	return subBorrow != 0
+}
+
+// subMod256LE subtracts x from dst modulo 2^256, undoing addMod256LE.
+func subMod256LE(dst *[32]byte, x []byte) {
+	if len(x) != 32 {
+		panic("bip32ed25519: subtrahend must be 32 bytes")
+	}
+
+	var borrow uint16
+	for i := range 32 {
+		diff := uint16(dst[i]) - uint16(x[i]) - borrow
+		dst[i] = byte(diff)
+		borrow = (diff >> 8) & 1
+	}
+	// The final borrow is intentionally discarded to get modulo 2^256 behavior.
+}
+
 // scalarFromLE32ModL reduces a little-endian 32-byte integer modulo L.
 func scalarFromLE32ModL(x [32]byte) (*edwards25519.Scalar, error) {
 	var wide [64]byte
@@ -80,3 +130,40 @@ func scalarFromZL28Times8(zl []byte) (*edwards25519.Scalar, error) {
 
 	return new(edwards25519.Scalar).SetCanonicalBytes(s[:])
 }
+
+// mul8V1 multiplies ZL by 8 the way the V1 scheme does: each of the 32 bytes
+// is shifted left by three on its own and the bits shifted out are dropped.
+// The original implementation forgot the carry, and V1 keeps the mistake.
+func mul8V1(zl []byte) [32]byte {
+	if len(zl) != 32 {
+		panic("bip32ed25519: V1 ZL must be 32 bytes")
+	}
+
+	var out [32]byte
+	for i := range 32 {
+		out[i] = zl[i] << 3
+	}
+	return out
+}
+
+// addBytewiseV1 adds x to dst byte by byte, dropping every carry, which is
+// the V1 kR rule.
+func addBytewiseV1(dst *[32]byte, x []byte) {
+	if len(x) != 32 {
+		panic("bip32ed25519: addend must be 32 bytes")
+	}
+
+	for i := range 32 {
+		dst[i] += x[i]
+	}
+}
+
+// addZLMul8V1 sets dst to kL + mul8V1(ZL) modulo L, the V1 kL rule.
+func addZLMul8V1(dst *[32]byte, zl []byte) {
+	zl8 := mul8V1(zl)
+	defer clear(zl8[:])
+	// Both inputs are 32 bytes, so the reductions cannot fail.
+	a, _ := scalarFromLE32ModL(*dst)
+	b, _ := scalarFromLE32ModL(zl8)
+	copy(dst[:], a.Add(a, b).Bytes())
+}
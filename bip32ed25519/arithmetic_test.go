@@ -47,6 +47,30 @@ func TestAddMod256LE(t *testing.T) {
 	}
 }
 
+func TestSubUndoesAdd(t *testing.T) {
+	var kL, kR [32]byte
+	copy(kL[:], mustDecodeHex(t, "b9d2ad48d44954b409f674a306c08da5b7e1c52005a512f5854c2593173db4a6"))
+	copy(kR[:], mustDecodeHex(t, "f9d2ad48d44954b409f674a306c08da5b7e1c52005a512f5854c2593173db4a6"))
+	z := mustDecodeHex(t, "1d9b4170ef340b683ddf03f847b1c1aeb8d276e271446c52c9fbd324a3fa8206ff9b4170ef340b683ddf03f847b1c1aeb8d276e271446c52c9fbd324a3fa82ff")
+	childL, childR := kL, kR
+	add28Mul8LE(&childL, z[:28])
+	addMod256LE(&childR, z[32:])
+
+	if borrow := sub28Mul8LE(&childL, z[:28]); borrow || childL != kL {
+		t.Fatalf("sub28Mul8LE = %x, %v, want %x", childL, borrow, kL)
+	}
+	subMod256LE(&childR, z[32:])
+	if childR != kR {
+		t.Fatalf("subMod256LE = %x, want %x", childR, kR)
+	}
+
+	var small [32]byte
+	small[0] = 7
+	if borrow := sub28Mul8LE(&small, z[:28]); !borrow {
+		t.Fatal("sub28Mul8LE did not report borrow")
+	}
+}
+
 func TestScalarFromZL28Times8RejectsWrongLength(t *testing.T) {
 	if _, err := scalarFromZL28Times8(make([]byte, 27)); !errorsIs(err, ErrInvalidTweak) {
 		t.Fatalf("scalarFromZL28Times8 error = %v, want %v", err, ErrInvalidTweak)
@@ -56,3 +80,23 @@ func TestScalarFromZL28Times8RejectsWrongLength(t *testing.T) {
 func errorsIs(err, target error) bool {
 	return err == target
 }
+
+func TestMul8V1DropsCarries(t *testing.T) {
+	zl := make([]byte, 32)
+	zl[0], zl[31] = 0xff, 0x21
+	got := mul8V1(zl)
+	var want [32]byte
+	want[0], want[31] = 0xf8, 0x08
+	if got != want {
+		t.Fatalf("mul8V1 = %x, want %x", got, want)
+	}
+
+	var dst [32]byte
+	dst[0] = 0xff
+	x := make([]byte, 32)
+	x[0] = 1
+	addBytewiseV1(&dst, x)
+	if dst != [32]byte{} {
+		t.Fatalf("addBytewiseV1 = %x", dst)
+	}
+}
@@ -0,0 +1,127 @@
+package bip32ed25519
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"io"
+)
+
+// Backend performs the private-key operations of an ED25519-BIP32 tree
+// without exposing its keys, so the root can stay in a hardware wallet or a
+// separate signer process. Paths are absolute and use the same syntax as
+// XPrv.DerivePath. Everything that needs only public keys, such as further
+// soft derivation, works on the returned XPub.
+//
+// LocalBackend implements Backend over an in-memory root for tests and for
+// programs that do not need process isolation. Implementations must be safe
+// for concurrent use.
+type Backend interface {
+	// DeriveXPub returns the extended public key at path.
+	DeriveXPub(path string) (*XPub, error)
+	// Sign signs message with the key at path and returns a pure Ed25519
+	// signature, as XPrv.Sign does.
+	Sign(path string, message []byte) ([]byte, error)
+}
+
+// LocalBackend is a Backend that holds the root in memory.
+type LocalBackend struct {
+	root *XPrv
+}
+
+var _ Backend = (*LocalBackend)(nil)
+
+// NewLocalBackend returns a LocalBackend over a copy of root. As with
+// XPrv.DerivePath, root does not have to be a master key.
+func NewLocalBackend(root *XPrv) (*LocalBackend, error) {
+	if root == nil {
+		return nil, ErrNilKey
+	}
+	return &LocalBackend{root: root.clone()}, nil
+}
+
+// DeriveXPub derives path and returns its extended public key.
+func (b *LocalBackend) DeriveXPub(path string) (*XPub, error) {
+	if b == nil {
+		return nil, ErrNilKey
+	}
+	child, err := b.root.DerivePath(path)
+	if err != nil {
+		return nil, err
+	}
+	defer child.Wipe()
+	return child.XPub()
+}
+
+// Sign derives path and signs message with the child key. The child is wiped
+// before returning.
+func (b *LocalBackend) Sign(path string, message []byte) ([]byte, error) {
+	if b == nil {
+		return nil, ErrNilKey
+	}
+	child, err := b.root.DerivePath(path)
+	if err != nil {
+		return nil, err
+	}
+	defer child.Wipe()
+	return child.Sign(message)
+}
+
+// Wipe clears the root on a best-effort basis. The LocalBackend must not be
+// used afterwards.
+func (b *LocalBackend) Wipe() {
+	if b == nil {
+		return
+	}
+	b.root.Wipe()
+}
+
+// BackendSigner adapts one key of a Backend to crypto.Signer, in the same way
+// Signer adapts an XPrv. Backend exposes only pure Ed25519, so Ed25519ph and
+// Ed25519ctx options are rejected.
+type BackendSigner struct {
+	backend Backend
+	path    string
+	pub     ed25519.PublicKey
+}
+
+var _ crypto.Signer = (*BackendSigner)(nil)
+
+// NewBackendSigner returns a crypto.Signer for the key at path. The public key
+// is fetched from the backend once here.
+func NewBackendSigner(backend Backend, path string) (*BackendSigner, error) {
+	if backend == nil {
+		return nil, ErrNilKey
+	}
+	xpub, err := backend.DeriveXPub(path)
+	if err != nil {
+		return nil, err
+	}
+	if xpub == nil {
+		return nil, ErrInvalidXPub
+	}
+	pub := xpub.PublicKey()
+	return &BackendSigner{backend: backend, path: path, pub: ed25519.PublicKey(pub[:])}, nil
+}
+
+// Public returns the ed25519.PublicKey of the signer.
+func (s *BackendSigner) Public() crypto.PublicKey {
+	if s == nil {
+		return nil
+	}
+	return append(ed25519.PublicKey(nil), s.pub...)
+}
+
+// Sign asks the backend to sign message. opts must be nil or have a zero
+// HashFunc and no context; rand is ignored.
+func (s *BackendSigner) Sign(_ io.Reader, message []byte, opts crypto.SignerOpts) ([]byte, error) {
+	if s == nil {
+		return nil, ErrNilKey
+	}
+	if opts != nil && opts.HashFunc() != 0 {
+		return nil, ErrUnsupportedSignerOpts
+	}
+	if o, ok := opts.(*ed25519.Options); ok && o.Context != "" {
+		return nil, ErrUnsupportedSignerOpts
+	}
+	return s.backend.Sign(s.path, message)
+}
@@ -0,0 +1,94 @@
+package bip32ed25519
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/sha512"
+	"errors"
+	"testing"
+)
+
+// remoteBackend stands in for a signer in another process: it only ever hands
+// out public keys and signatures.
+type remoteBackend struct {
+	inner Backend
+	signs int
+}
+
+func (r *remoteBackend) DeriveXPub(path string) (*XPub, error) { return r.inner.DeriveXPub(path) }
+
+func (r *remoteBackend) Sign(path string, message []byte) ([]byte, error) {
+	r.signs++
+	return r.inner.Sign(path, message)
+}
+
+func TestLocalBackend(t *testing.T) {
+	root := testIcarusRoot(t)
+	local, err := NewLocalBackend(root)
+	if err != nil {
+		t.Fatalf("NewLocalBackend: %v", err)
+	}
+	const path = "m/1852'/1815'/0'/0/0"
+	want, err := root.DerivePath(path)
+	if err != nil {
+		t.Fatalf("DerivePath: %v", err)
+	}
+	wantXPub, _ := want.XPub()
+
+	xpub, err := local.DeriveXPub(path)
+	if err != nil {
+		t.Fatalf("DeriveXPub: %v", err)
+	}
+	if !bytes.Equal(xpub.Bytes(), wantXPub.Bytes()) {
+		t.Fatal("DeriveXPub does not match XPrv.DerivePath")
+	}
+
+	message := []byte("backend")
+	sig, err := local.Sign(path, message)
+	if err != nil {
+		t.Fatalf("Sign: %v", err)
+	}
+	if wantSig, _ := want.Sign(message); !bytes.Equal(sig, wantSig) {
+		t.Fatal("Sign does not match XPrv.Sign")
+	}
+
+	remote := &remoteBackend{inner: local}
+	signer, err := NewBackendSigner(remote, path)
+	if err != nil {
+		t.Fatalf("NewBackendSigner: %v", err)
+	}
+	pub := signer.Public().(ed25519.PublicKey)
+	if !bytes.Equal(pub, wantXPub.Bytes()[:32]) {
+		t.Fatal("BackendSigner.Public does not match DeriveXPub")
+	}
+	sig, err = signer.Sign(nil, message, crypto.Hash(0))
+	if err != nil || remote.signs != 1 {
+		t.Fatalf("BackendSigner.Sign: %v after %d calls", err, remote.signs)
+	}
+	if !ed25519.Verify(pub, message, sig) {
+		t.Fatal("BackendSigner signature does not verify")
+	}
+	digest := sha512.Sum512(message)
+	for _, opts := range []crypto.SignerOpts{
+		crypto.SHA512,
+		&ed25519.Options{Context: "bip32"},
+	} {
+		if _, err := signer.Sign(nil, digest[:], opts); !errors.Is(err, ErrUnsupportedSignerOpts) || remote.signs != 1 {
+			t.Fatalf("Sign(%v) error = %v", opts, err)
+		}
+	}
+
+	if _, err := local.DeriveXPub("0/1"); !errors.Is(err, ErrInvalidPath) {
+		t.Fatalf("relative path error = %v", err)
+	}
+	if _, err := NewBackendSigner(nil, path); !errors.Is(err, ErrNilKey) {
+		t.Fatalf("NewBackendSigner(nil) error = %v", err)
+	}
+
+	// The backend keeps its own copy of the root.
+	root.Wipe()
+	if _, err := local.Sign(path, message); err != nil {
+		t.Fatalf("Sign after XPrv.Wipe: %v", err)
+	}
+}
@@ -0,0 +1,153 @@
+package bip32ed25519
+
+import (
+	"fmt"
+	"strings"
+
+	"filippo.io/edwards25519"
+	"github.com/islishude/bip32/v2/internal/bech32"
+)
+
+// Role is the CIP-5 prefix family of a key, such as "acct" for acct_xsk,
+// acct_xvk, and acct_vk. It names where in a CIP-1852 wallet the key sits.
+type Role string
+
+const (
+	// RoleRoot is a wallet root, m.
+	RoleRoot Role = "root"
+	// RoleAccount is an account key, m/1852'/1815'/account'.
+	RoleAccount Role = "acct"
+	// RoleAddress is a payment key on the external (0) or internal (1) chain,
+	// or a Byron address key.
+	RoleAddress Role = "addr"
+	// RoleStake is a stake key, on chain 2.
+	RoleStake Role = "stake"
+	// RoleDRep is a CIP-105 delegate representative key, on chain 3.
+	RoleDRep Role = "drep"
+	// RoleCommitteeCold is a CIP-105 constitutional committee cold key, on
+	// chain 4.
+	RoleCommitteeCold Role = "cc_cold"
+	// RoleCommitteeHot is a CIP-105 constitutional committee hot key, on
+	// chain 5.
+	RoleCommitteeHot Role = "cc_hot"
+)
+
+// Valid reports whether r is one of the roles above.
+func (r Role) Valid() bool {
+	switch r {
+	case RoleRoot, RoleAccount, RoleAddress, RoleStake, RoleDRep, RoleCommitteeCold, RoleCommitteeHot:
+		return true
+	}
+	return false
+}
+
+// CIP-5 suffixes of the key kinds this package encodes.
+const (
+	suffixXPrv      = "_xsk" // kL || kR || chainCode
+	suffixXPub      = "_xvk" // publicKey || chainCode
+	suffixPublicKey = "_vk"  // publicKey
+)
+
+// EncodeBech32 explicitly returns the CIP-5 role_xsk form of k, such as
+// acct_xsk1…. XPrv intentionally does not implement fmt.Stringer or
+// encoding.TextMarshaler.
+func (k *XPrv) EncodeBech32(role Role) (string, error) {
+	if k == nil {
+		return "", ErrNilKey
+	}
+	raw := k.Bytes()
+	defer clear(raw)
+	return encodeBech32(role, suffixXPrv, raw)
+}
+
+// EncodeBech32 returns the CIP-5 role_xvk form of p, such as acct_xvk1….
+func (p *XPub) EncodeBech32(role Role) (string, error) {
+	if p == nil {
+		return "", ErrNilKey
+	}
+	return encodeBech32(role, suffixXPub, p.Bytes())
+}
+
+// EncodeBech32PublicKey returns the CIP-5 role_vk form of the plain public
+// key of p, such as addr_vk1…, without the chain code.
+func (p *XPub) EncodeBech32PublicKey(role Role) (string, error) {
+	if p == nil {
+		return "", ErrNilKey
+	}
+	return encodeBech32(role, suffixPublicKey, p.pub[:])
+}
+
+// ParseXPrvBech32 parses a CIP-5 role_xsk string and returns the key and its
+// role. Other key kinds and unknown roles return ErrBech32Prefix.
+func ParseXPrvBech32(text string) (*XPrv, Role, error) {
+	raw, role, err := decodeBech32(text, suffixXPrv)
+	if err != nil {
+		return nil, "", err
+	}
+	defer clear(raw)
+	k, err := NewXPrvFromBytes(raw)
+	if err != nil {
+		return nil, "", err
+	}
+	return k, role, nil
+}
+
+// ParseXPubBech32 parses a CIP-5 role_xvk string and returns the key and its
+// role. Other key kinds and unknown roles return ErrBech32Prefix.
+func ParseXPubBech32(text string) (*XPub, Role, error) {
+	raw, role, err := decodeBech32(text, suffixXPub)
+	if err != nil {
+		return nil, "", err
+	}
+	p, err := NewXPubFromBytes(raw)
+	if err != nil {
+		return nil, "", err
+	}
+	return p, role, nil
+}
+
+// ParsePublicKeyBech32 parses a CIP-5 role_vk string and returns the public
+// key and its role. Other key kinds and unknown roles return ErrBech32Prefix,
+// and bytes that are not a curve point return ErrInvalidXPub.
+func ParsePublicKeyBech32(text string) ([32]byte, Role, error) {
+	raw, role, err := decodeBech32(text, suffixPublicKey)
+	if err != nil {
+		return [32]byte{}, "", err
+	}
+	if len(raw) != 32 {
+		return [32]byte{}, "", fmt.Errorf("%w: %d-byte public key", ErrInvalidBech32, len(raw))
+	}
+	if _, err := new(edwards25519.Point).SetBytes(raw); err != nil {
+		return [32]byte{}, "", ErrInvalidXPub
+	}
+	return [32]byte(raw), role, nil
+}
+
+func encodeBech32(role Role, suffix string, raw []byte) (string, error) {
+	if !role.Valid() {
+		return "", fmt.Errorf("%w: unknown role %q", ErrBech32Prefix, role)
+	}
+	data, _ := bech32.ConvertBits(raw, 8, 5, true)
+	defer clear(data)
+	encoded, _ := bech32.Encode(string(role)+suffix, data, bech32.Bech32)
+	return encoded, nil
+}
+
+// decodeBech32 returns the bytes and role of a CIP-5 string whose prefix is a
+// known role followed by suffix.
+func decodeBech32(text, suffix string) ([]byte, Role, error) {
+	hrp, data, encoding, ok := bech32.Decode(text, bech32.MaxLengthCIP5)
+	if !ok || encoding != bech32.Bech32 {
+		return nil, "", ErrInvalidBech32
+	}
+	defer clear(data)
+	role := Role(strings.TrimSuffix(hrp, suffix))
+	if !strings.HasSuffix(hrp, suffix) || !role.Valid() {
+		return nil, "", fmt.Errorf("%w: got %q, want role%s", ErrBech32Prefix, hrp, suffix)
+	}
+	raw, ok := bech32.ConvertBits(data, 5, 8, false)
+	if !ok {
+		return nil, "", ErrInvalidBech32
+	}
+	return raw, role, nil
+}
@@ -0,0 +1,106 @@
+package bip32ed25519
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/islishude/bip32/v2/internal/bech32"
+)
+
+func TestBech32RoundTrip(t *testing.T) {
+	root := testIcarusRoot(t)
+	encoded, err := root.EncodeBech32(RoleRoot)
+	if err != nil {
+		t.Fatalf("XPrv.EncodeBech32: %v", err)
+	}
+	if !strings.HasPrefix(encoded, "root_xsk1") {
+		t.Fatalf("XPrv.EncodeBech32 = %s", encoded)
+	}
+	parsed, role, err := ParseXPrvBech32(encoded)
+	if err != nil || role != RoleRoot || !bytes.Equal(parsed.Bytes(), root.Bytes()) {
+		t.Fatalf("ParseXPrvBech32 = %v, %v", role, err)
+	}
+
+	xpub, err := root.XPub()
+	if err != nil {
+		t.Fatal(err)
+	}
+	encoded, err = xpub.EncodeBech32(RoleAccount)
+	if err != nil || !strings.HasPrefix(encoded, "acct_xvk1") {
+		t.Fatalf("XPub.EncodeBech32 = %s, %v", encoded, err)
+	}
+	parsedPub, role, err := ParseXPubBech32(strings.ToUpper(encoded))
+	if err != nil || role != RoleAccount || !bytes.Equal(parsedPub.Bytes(), xpub.Bytes()) {
+		t.Fatalf("ParseXPubBech32 = %v, %v", role, err)
+	}
+
+	// CIP-19 test vectors.
+	for _, text := range []string{
+		"addr_vk1w0l2sr2zgfm26ztc6nl9xy8ghsk5sh6ldwemlpmp9xylzy4dtf7st80zhd",
+		"stake_vk1px4j0r2fk7ux5p23shz8f3y5y2qam7s954rgf3lg5merqcj6aetsft99wu",
+	} {
+		pub, role, err := ParsePublicKeyBech32(text)
+		if err != nil {
+			t.Fatalf("ParsePublicKeyBech32(%s): %v", text, err)
+		}
+		p := &XPub{pub: pub}
+		if again, err := p.EncodeBech32PublicKey(role); err != nil || again != text {
+			t.Fatalf("EncodeBech32PublicKey = %s, %v, want %s", again, err, text)
+		}
+	}
+}
+
+func TestBech32Errors(t *testing.T) {
+	root := testIcarusRoot(t)
+	xprv, _ := root.EncodeBech32(RoleAddress)
+	xpub, _ := root.XPub()
+	xvk, _ := xpub.EncodeBech32(RoleAddress)
+	vk, _ := xpub.EncodeBech32PublicKey(RoleAddress)
+	data, _ := bech32.ConvertBits(xpub.Bytes(), 8, 5, true)
+	poolXVK, _ := bech32.Encode("pool_xvk", data, bech32.Bech32)
+	mistyped := xprv[:len(xprv)-1] + "q"
+	if mistyped == xprv {
+		mistyped = xprv[:len(xprv)-1] + "p"
+	}
+
+	if _, err := root.EncodeBech32("payment"); !errors.Is(err, ErrBech32Prefix) {
+		t.Fatalf("unknown role error = %v", err)
+	}
+	for _, tc := range []struct {
+		name  string
+		parse func(string) error
+		text  string
+		want  error
+	}{
+		{"xvk as xsk", parseXPrvErr, xvk, ErrBech32Prefix},
+		{"xsk as xvk", parseXPubErr, xprv, ErrBech32Prefix},
+		{"xvk as vk", parsePublicKeyErr, xvk, ErrBech32Prefix},
+		{"vk as xvk", parseXPubErr, vk, ErrBech32Prefix},
+		{"unknown role", parseXPubErr, poolXVK, ErrBech32Prefix},
+		{"checksum", parseXPrvErr, mistyped, ErrInvalidBech32},
+		{"not bech32", parseXPrvErr, "root_xsk", ErrInvalidBech32},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if err := tc.parse(tc.text); !errors.Is(err, tc.want) {
+				t.Fatalf("error = %v, want %v", err, tc.want)
+			}
+		})
+	}
+}
+
+func parseXPrvErr(text string) error {
+	_, _, err := ParseXPrvBech32(text)
+	return err
+}
+
+func parseXPubErr(text string) error {
+	_, _, err := ParseXPubBech32(text)
+	return err
+}
+
+func parsePublicKeyErr(text string) error {
+	_, _, err := ParsePublicKeyBech32(text)
+	return err
+}
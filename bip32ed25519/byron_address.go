@@ -0,0 +1,325 @@
+package bip32ed25519
+
+import (
+	"crypto/cipher"
+	"crypto/sha3"
+	"crypto/sha512"
+	"fmt"
+	"hash/crc32"
+
+	"github.com/islishude/bip32/v2/internal/base58"
+	"golang.org/x/crypto/blake2b"
+	"golang.org/x/crypto/chacha20poly1305"
+	"golang.org/x/crypto/pbkdf2"
+)
+
+// ByronMainnetProtocolMagic is the protocol magic of the Cardano mainnet,
+// which Byron addresses omit. Test networks record theirs in the address.
+const ByronMainnetProtocolMagic = 764824073
+
+// Byron address attribute keys.
+const (
+	byronAttrHDPayload     = 1
+	byronAttrProtocolMagic = 2
+)
+
+// byronSpendingPubKey is the address type and spending data tag of addresses
+// that pay to an extended public key, the only kind wallets derive.
+const byronSpendingPubKey = 0
+
+// The HD payload is sealed with ChaCha20-Poly1305 under a key stretched from
+// the root XPub with these fixed parameters.
+var (
+	hdPayloadSalt  = []byte("address-hashing")
+	hdPayloadNonce = []byte("serokellfore")
+)
+
+const hdPayloadIterations = 500
+
+// ByronAddress is a Byron-era bootstrap address paying to an extended public
+// key. Its text form is the Base58 of
+//
+//	[ 24(bytes(CBOR [Root, attributes, 0])), CRC-32 ]
+//
+// where Root commits to the key and attributes, and the attributes hold the
+// HD payload and the protocol magic when present.
+type ByronAddress struct {
+	// Root is BLAKE2b-224 of SHA3-256 of the CBOR address type, spending key,
+	// and attributes.
+	Root [KeyHashSize]byte
+	// HDPayload is the encrypted derivation path of a Daedalus address, or
+	// nil for Icarus-style addresses.
+	HDPayload []byte
+	// ProtocolMagic identifies a test network. It is zero on mainnet.
+	ProtocolMagic uint32
+}
+
+// NewByronAddress returns the Icarus-style Byron address of key, which has no
+// HD payload. Pass zero or ByronMainnetProtocolMagic for mainnet.
+func NewByronAddress(key *XPub, protocolMagic uint32) (*ByronAddress, error) {
+	if key == nil {
+		return nil, ErrNilKey
+	}
+	a := &ByronAddress{ProtocolMagic: byronMagic(protocolMagic)}
+	a.Root = a.rootFor(key)
+	return a, nil
+}
+
+// NewByronAddressWithPath returns the Daedalus-style Byron address of key,
+// which records path, such as m/0'/1' as {0x80000000, 0x80000001}, encrypted
+// under the wallet's root XPub so that only its owner can read it.
+func NewByronAddressWithPath(root, key *XPubV1, path []uint32, protocolMagic uint32) (*ByronAddress, error) {
+	if root == nil || key == nil {
+		return nil, ErrNilKey
+	}
+	plaintext := []byte{cborIndefiniteArray}
+	for _, index := range path {
+		plaintext = appendCBORHead(plaintext, cborUint, uint64(index))
+	}
+	plaintext = append(plaintext, cborBreak)
+
+	aead := hdPayloadAEAD(root.p)
+	a := &ByronAddress{
+		HDPayload:     aead.Seal(nil, hdPayloadNonce, plaintext, nil),
+		ProtocolMagic: byronMagic(protocolMagic),
+	}
+	a.Root = a.rootFor(key.p)
+	return a, nil
+}
+
+// DecryptPath decrypts the HD payload with the wallet's root XPub and returns
+// the derivation path. It returns ErrDecryption when the address belongs to
+// another wallet, and ErrInvalidAddress when it has no payload. Confirm the
+// address with MatchesKeyV1 after deriving the key at the path.
+func (a *ByronAddress) DecryptPath(root *XPubV1) ([]uint32, error) {
+	if root == nil {
+		return nil, ErrNilKey
+	}
+	if a == nil || a.HDPayload == nil {
+		return nil, fmt.Errorf("%w: no HD payload", ErrInvalidAddress)
+	}
+	plaintext, err := hdPayloadAEAD(root.p).Open(nil, hdPayloadNonce, a.HDPayload, nil)
+	if err != nil {
+		return nil, ErrDecryption
+	}
+
+	r := cborReader{b: plaintext}
+	var path []uint32
+	if len(r.b) > 0 && r.b[0] == cborIndefiniteArray {
+		r.b = r.b[1:]
+		for len(r.b) > 0 && r.b[0] != cborBreak {
+			index, ok := r.expect(cborUint)
+			if !ok || index > 0xffffffff {
+				return nil, fmt.Errorf("%w: malformed HD payload", ErrInvalidAddress)
+			}
+			path = append(path, uint32(index))
+		}
+		if len(r.b) != 1 {
+			return nil, fmt.Errorf("%w: malformed HD payload", ErrInvalidAddress)
+		}
+		return path, nil
+	}
+	n, ok := r.expect(cborArray)
+	for ; ok && n > 0; n-- {
+		var index uint64
+		index, ok = r.expect(cborUint)
+		ok = ok && index <= 0xffffffff
+		path = append(path, uint32(index))
+	}
+	if !ok || !r.done() {
+		return nil, fmt.Errorf("%w: malformed HD payload", ErrInvalidAddress)
+	}
+	return path, nil
+}
+
+// MatchesKey reports whether a pays to key, as Icarus-style addresses do.
+func (a *ByronAddress) MatchesKey(key *XPub) bool {
+	if a == nil || key == nil {
+		return false
+	}
+	return a.rootFor(key) == a.Root
+}
+
+// MatchesKeyV1 reports whether a pays to key, as Daedalus-style addresses do.
+func (a *ByronAddress) MatchesKeyV1(key *XPubV1) bool {
+	if key == nil {
+		return false
+	}
+	return a.MatchesKey(key.p)
+}
+
+// Bytes returns the CBOR form of a, whose Base58 is its text.
+func (a *ByronAddress) Bytes() ([]byte, error) {
+	if a == nil {
+		return nil, ErrInvalidAddress
+	}
+	payload := appendCBORHead(nil, cborArray, 3)
+	payload = appendCBORBytes(payload, a.Root[:])
+	payload = a.appendAttributes(payload)
+	payload = appendCBORHead(payload, cborUint, byronSpendingPubKey)
+
+	out := appendCBORHead(nil, cborArray, 2)
+	out = appendCBORHead(out, cborTag, 24)
+	out = appendCBORBytes(out, payload)
+	return appendCBORHead(out, cborUint, uint64(crc32.ChecksumIEEE(payload))), nil
+}
+
+// Encode returns the Base58 text of a, such as Ae2… or DdzFF….
+func (a *ByronAddress) Encode() (string, error) {
+	raw, err := a.Bytes()
+	if err != nil {
+		return "", err
+	}
+	return base58.Encode(raw), nil
+}
+
+// ParseByronAddress parses the Base58 text of a Byron address that pays to a
+// public key. Script and redeem addresses return ErrInvalidAddress.
+func ParseByronAddress(text string) (*ByronAddress, error) {
+	raw, ok := base58.Decode(text)
+	if !ok {
+		return nil, fmt.Errorf("%w: malformed base58 string", ErrInvalidAddress)
+	}
+	return NewByronAddressFromBytes(raw)
+}
+
+// NewByronAddressFromBytes parses the CBOR form of a Byron address, checking
+// its CRC-32. Non-canonical encodings and unknown attributes are rejected.
+func NewByronAddressFromBytes(b []byte) (*ByronAddress, error) {
+	malformed := fmt.Errorf("%w: malformed Byron address", ErrInvalidAddress)
+	r := cborReader{b: b}
+	if n, ok := r.expect(cborArray); !ok || n != 2 {
+		return nil, malformed
+	}
+	if tag, ok := r.expect(cborTag); !ok || tag != 24 {
+		return nil, malformed
+	}
+	payload, ok := r.bytes()
+	if !ok {
+		return nil, malformed
+	}
+	crc, ok := r.expect(cborUint)
+	if !ok || !r.done() {
+		return nil, malformed
+	}
+	if crc != uint64(crc32.ChecksumIEEE(payload)) {
+		return nil, fmt.Errorf("%w: CRC-32 mismatch", ErrInvalidAddress)
+	}
+
+	p := cborReader{b: payload}
+	a := &ByronAddress{}
+	if n, ok := p.expect(cborArray); !ok || n != 3 {
+		return nil, malformed
+	}
+	root, ok := p.bytes()
+	if !ok || len(root) != KeyHashSize {
+		return nil, malformed
+	}
+	a.Root = [KeyHashSize]byte(root)
+	attributes, ok := p.expect(cborMap)
+	if !ok {
+		return nil, malformed
+	}
+	for range attributes {
+		key, ok := p.expect(cborUint)
+		if !ok {
+			return nil, malformed
+		}
+		value, ok := p.bytes()
+		if !ok {
+			return nil, malformed
+		}
+		v := cborReader{b: value}
+		switch key {
+		case byronAttrHDPayload:
+			hdPayload, ok := v.bytes()
+			if !ok || !v.done() {
+				return nil, malformed
+			}
+			a.HDPayload = append([]byte{}, hdPayload...)
+		case byronAttrProtocolMagic:
+			magic, ok := v.expect(cborUint)
+			if !ok || !v.done() || magic == 0 || magic > 0xffffffff {
+				return nil, malformed
+			}
+			a.ProtocolMagic = uint32(magic)
+		default:
+			return nil, fmt.Errorf("%w: unknown attribute %d", ErrInvalidAddress, key)
+		}
+	}
+	addressType, ok := p.expect(cborUint)
+	if !ok || !p.done() {
+		return nil, malformed
+	}
+	if addressType != byronSpendingPubKey {
+		return nil, fmt.Errorf("%w: unsupported Byron address type %d", ErrInvalidAddress, addressType)
+	}
+
+	// Root commits to the attributes as encoded, so only canonical encodings,
+	// which Bytes reproduces, can be matched against keys.
+	if again, _ := a.Bytes(); string(again) != string(b) {
+		return nil, fmt.Errorf("%w: non-canonical encoding", ErrInvalidAddress)
+	}
+	return a, nil
+}
+
+// rootFor returns the address root of key with the attributes of a.
+func (a *ByronAddress) rootFor(key *XPub) [KeyHashSize]byte {
+	var xpub [XPubSize]byte
+	copy(xpub[0:32], key.pub[:])
+	copy(xpub[32:64], key.cc[:])
+
+	data := appendCBORHead(nil, cborArray, 3)
+	data = appendCBORHead(data, cborUint, byronSpendingPubKey)
+	data = appendCBORHead(data, cborArray, 2)
+	data = appendCBORHead(data, cborUint, byronSpendingPubKey)
+	data = appendCBORBytes(data, xpub[:])
+	data = a.appendAttributes(data)
+
+	digest := sha3.Sum256(data)
+	h, _ := blake2b.New(KeyHashSize, nil)
+	_, _ = h.Write(digest[:])
+	return [KeyHashSize]byte(h.Sum(nil))
+}
+
+// appendAttributes appends the attribute map. Each value is itself CBOR,
+// wrapped in a byte string.
+func (a *ByronAddress) appendAttributes(out []byte) []byte {
+	var n uint64
+	if a.HDPayload != nil {
+		n++
+	}
+	if a.ProtocolMagic != 0 {
+		n++
+	}
+	out = appendCBORHead(out, cborMap, n)
+	if a.HDPayload != nil {
+		out = appendCBORHead(out, cborUint, byronAttrHDPayload)
+		out = appendCBORBytes(out, appendCBORBytes(nil, a.HDPayload))
+	}
+	if a.ProtocolMagic != 0 {
+		out = appendCBORHead(out, cborUint, byronAttrProtocolMagic)
+		out = appendCBORBytes(out, appendCBORHead(nil, cborUint, uint64(a.ProtocolMagic)))
+	}
+	return out
+}
+
+// hdPayloadAEAD returns the cipher that seals HD payloads for root.
+func hdPayloadAEAD(root *XPub) cipher.AEAD {
+	var xpub [XPubSize]byte
+	copy(xpub[0:32], root.pub[:])
+	copy(xpub[32:64], root.cc[:])
+	key := pbkdf2.Key(xpub[:], hdPayloadSalt, hdPayloadIterations, chacha20poly1305.KeySize, sha512.New)
+	aead, err := chacha20poly1305.New(key)
+	if err != nil {
+		panic(err) // The key size is fixed.
+	}
+	return aead
+}
+
+func byronMagic(protocolMagic uint32) uint32 {
+	if protocolMagic == ByronMainnetProtocolMagic {
+		return 0
+	}
+	return protocolMagic
+}
@@ -0,0 +1,237 @@
+package bip32ed25519
+
+import (
+	"encoding/hex"
+	"errors"
+	"hash/crc32"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/islishude/bip32/v2/internal/base58"
+)
+
+func TestByronAddressIcarus(t *testing.T) {
+	// cardano-serialization-lib: "art forum devote street sure rather head
+	// chuckle guard poverty release quote oak craft enemy" at m/44'/1815'/0'/0/0.
+	const want = "Ae2tdPwUPEZHtBmjZBF4YpMkK9tMSPTE2ADEZTPN97saNkhG78TvXdp3GDk"
+	root, err := NewMasterKeyIcarus(mustDecodeHex(t, "0ccb74f36b7da1649a8144675522d4d8097c6412"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key, err := root.DerivePath("m/44'/1815'/0'/0/0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	xpub, err := key.XPub()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, magic := range []uint32{0, ByronMainnetProtocolMagic} {
+		addr, err := NewByronAddress(xpub, magic)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, err := addr.Encode(); err != nil || got != want {
+			t.Fatalf("NewByronAddress(%d) = %s, %v, want %s", magic, got, err, want)
+		}
+	}
+
+	parsed, err := ParseByronAddress(want)
+	if err != nil {
+		t.Fatalf("ParseByronAddress: %v", err)
+	}
+	if parsed.HDPayload != nil || parsed.ProtocolMagic != 0 || !parsed.MatchesKey(xpub) {
+		t.Fatalf("ParseByronAddress = %+v", parsed)
+	}
+	rootPub, _ := root.XPub()
+	if parsed.MatchesKey(rootPub) {
+		t.Fatal("MatchesKey accepted another key")
+	}
+	if _, err := parsed.DecryptPath(testByronXPub(t)); !errors.Is(err, ErrInvalidAddress) {
+		t.Fatalf("DecryptPath without payload error = %v", err)
+	}
+
+	testnet, err := NewByronAddress(xpub, 1097911063)
+	if err != nil {
+		t.Fatal(err)
+	}
+	text, _ := testnet.Encode()
+	if again, err := ParseByronAddress(text); err != nil || again.ProtocolMagic != 1097911063 || !again.MatchesKey(xpub) {
+		t.Fatalf("testnet address %s: %+v, %v", text, again, err)
+	}
+}
+
+func TestByronAddressHDPayload(t *testing.T) {
+	root := testByronRoot(t)
+	rootPub, err := root.XPub()
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := []uint32{HardenedOffset, HardenedOffset + 1}
+	key, err := root.DerivePath("m/0'/1'")
+	if err != nil {
+		t.Fatal(err)
+	}
+	xpub, err := key.XPub()
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr, err := NewByronAddressWithPath(rootPub, xpub, path, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	text, err := addr.Encode()
+	if err != nil || !strings.HasPrefix(text, "DdzFF") {
+		t.Fatalf("Daedalus address = %s, %v", text, err)
+	}
+
+	parsed, err := ParseByronAddress(text)
+	if err != nil {
+		t.Fatalf("ParseByronAddress: %v", err)
+	}
+	got, err := parsed.DecryptPath(rootPub)
+	if err != nil || !slices.Equal(got, path) {
+		t.Fatalf("DecryptPath = %v, %v, want %v", got, err, path)
+	}
+	if !parsed.MatchesKeyV1(xpub) || !parsed.MatchesKey(xpub.p) {
+		t.Fatal("MatchesKeyV1 rejected the address key")
+	}
+
+	icarus, _ := testIcarusRoot(t).XPub()
+	other, _ := NewXPubV1FromBytes(icarus.Bytes())
+	if _, err := parsed.DecryptPath(other); !errors.Is(err, ErrDecryption) {
+		t.Fatalf("DecryptPath with another root error = %v", err)
+	}
+}
+
+func TestByronAddressHDPayloadKnownAnswer(t *testing.T) {
+	// The V1 test wallet's address at m/0'/1'. No published DdzFF address
+	// comes with its root XPub, so this payload and address were computed by
+	// a separate Python implementation of RFC 8439 ChaCha20-Poly1305, checked
+	// against RFC 8439's AEAD test vector, with PBKDF2 and CBOR written from
+	// the cardano-sl address format.
+	const (
+		rootXPub    = "44970c2673791302d8ae062568d386078e8fc9c85cdf7795dc95a3a144b7518f918019cda35f1df96dd5a798da4c40a2f382358496e6468e4e276db5ec35235f"
+		keyXPub     = "8c1fce4c15a2f629c0f1ca08ea6620530f8b22f52d6f8f6d902cc2f31f61f16e4fd543b338943010f54335c323bc8439f53bd3fea66b3ef2482f62bb38d1f364"
+		wantPayload = "7fa337aec4239efd0d329ac7408d98db99243d619bd2d55231797ed3"
+		wantAddress = "DdzFFzCqrhsngSgJ9tcjtEJpjz6a5fZTz5wHftASNhm42bhZh5dkvpA51XR6JfMVdQtwRaFWsdbSVx2eYFjqnZ4xmcfrWAEk88pjaEw1"
+	)
+	root, err := NewXPubV1FromBytes(mustDecodeHex(t, rootXPub))
+	if err != nil {
+		t.Fatal(err)
+	}
+	key, err := NewXPubV1FromBytes(mustDecodeHex(t, keyXPub))
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := []uint32{HardenedOffset, HardenedOffset + 1}
+
+	addr, err := NewByronAddressWithPath(root, key, path, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := hex.EncodeToString(addr.HDPayload); got != wantPayload {
+		t.Fatalf("HD payload = %s, want %s", got, wantPayload)
+	}
+	if got, _ := addr.Encode(); got != wantAddress {
+		t.Fatalf("address = %s, want %s", got, wantAddress)
+	}
+
+	parsed, err := ParseByronAddress(wantAddress)
+	if err != nil {
+		t.Fatalf("ParseByronAddress: %v", err)
+	}
+	got, err := parsed.DecryptPath(root)
+	if err != nil || !slices.Equal(got, path) {
+		t.Fatalf("DecryptPath = %v, %v, want %v", got, err, path)
+	}
+	derived, err := testByronRoot(t).DerivePath("m/0'/1'")
+	if err != nil {
+		t.Fatal(err)
+	}
+	derivedPub, _ := derived.XPub()
+	if !parsed.MatchesKeyV1(derivedPub) {
+		t.Fatal("MatchesKeyV1 rejected the key derived at the decrypted path")
+	}
+}
+
+func TestParseByronAddressDaedalusMainnet(t *testing.T) {
+	// A Daedalus address from mainnet. Its owner's root XPub is unknown, so
+	// only the structure is checked: the CRC, the canonical encoding, and a
+	// 28-byte HD payload holding a two-level path.
+	const text = "DdzFFzCqrhsfdzUZxvuBkhV8Lpm9p43p9ubh79GCTkxJikAjKh51qhtCFMqUniC5tv5ZExyvSmAte2Du2tGimavSo6qSgXbjiy8qZRTg"
+	addr, err := ParseByronAddress(text)
+	if err != nil {
+		t.Fatalf("ParseByronAddress: %v", err)
+	}
+	if got := hex.EncodeToString(addr.Root[:]); got != "0e33c1c7f46b7f3d60dc182fc64dd31cf36929281575aac785496008" {
+		t.Fatalf("root = %s", got)
+	}
+	if got := hex.EncodeToString(addr.HDPayload); got != "ca3e553c9c63c5824b875443ad791663276d20456ec6eee99fd4db23" || addr.ProtocolMagic != 0 {
+		t.Fatalf("HD payload = %s, magic %d", got, addr.ProtocolMagic)
+	}
+	if again, _ := addr.Encode(); again != text {
+		t.Fatalf("re-encoded = %s", again)
+	}
+	other, _ := testByronRoot(t).XPub()
+	if _, err := addr.DecryptPath(other); !errors.Is(err, ErrDecryption) {
+		t.Fatalf("DecryptPath with another wallet error = %v", err)
+	}
+}
+
+func testByronXPub(t *testing.T) *XPubV1 {
+	t.Helper()
+	xpub, err := testByronRoot(t).XPub()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return xpub
+}
+
+func TestParseByronAddressErrors(t *testing.T) {
+	const valid = "Ae2tdPwUPEZFRbyhz3cpfC2CumGzNkFBN2L42rcUc2yjQpEkxDbkPodpMAi"
+	raw, _ := base58.Decode(valid)
+	payload := raw[5 : len(raw)-5]
+
+	wrap := func(payload []byte) string {
+		out := appendCBORHead(nil, cborArray, 2)
+		out = appendCBORHead(out, cborTag, 24)
+		out = appendCBORBytes(out, payload)
+		return base58.Encode(appendCBORHead(out, cborUint, uint64(crc32.ChecksumIEEE(payload))))
+	}
+	badCRC := slices.Clone(raw)
+	badCRC[len(badCRC)-1] ^= 1
+	withAttribute := func(key uint64, value []byte) []byte {
+		out := slices.Clone(payload[:31])
+		out = appendCBORHead(out, cborMap, 1)
+		out = appendCBORHead(out, cborUint, key)
+		out = appendCBORBytes(out, value)
+		return appendCBORHead(out, cborUint, 0)
+	}
+	script := slices.Clone(payload)
+	script[len(script)-1] = 1
+	nonCanonical := append(slices.Clone(payload[:31]), 0xb8, 0x00, 0x00)
+
+	for _, tc := range []struct {
+		name, text string
+	}{
+		{"base58", "0OIl"},
+		{"crc", base58.Encode(badCRC)},
+		{"unknown attribute", wrap(withAttribute(3, []byte{0x00}))},
+		{"magic zero", wrap(withAttribute(2, []byte{0x00}))},
+		{"script address", wrap(script)},
+		{"non-canonical", wrap(nonCanonical)},
+		{"truncated", base58.Encode(raw[:len(raw)-2])},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := ParseByronAddress(tc.text); !errors.Is(err, ErrInvalidAddress) {
+				t.Fatalf("ParseByronAddress error = %v", err)
+			}
+		})
+	}
+
+	if a, err := ParseByronAddress(wrap(payload)); err != nil || a.Root != [KeyHashSize]byte(payload[3:31]) {
+		t.Fatalf("rewrapped address = %+v, %v", a, err)
+	}
+}
@@ -0,0 +1,102 @@
+package bip32ed25519
+
+import (
+	"sync"
+
+	"filippo.io/edwards25519"
+)
+
+// keyCache memoizes values computed from an extended key: its decoded public
+// point and encoding, and the HMAC states for its chain code. It is created
+// with the key and filled lazily. An XPrv never shares its cache with another
+// key, so that Wipe can clear it; the XPub of an XPrv gets its own.
+type keyCache struct {
+	pointOnce sync.Once
+	point     edwards25519.Point
+	pub       [32]byte
+	ok        bool
+
+	macOnce sync.Once
+	mac     *hmacKey
+}
+
+func newKeyCache() *keyCache {
+	return new(keyCache)
+}
+
+// newKeyCacheWithPoint returns a cache pre-filled with an already known point,
+// such as a child point produced by public derivation.
+func newKeyCacheWithPoint(point *edwards25519.Point) *keyCache {
+	c := new(keyCache)
+	c.pointOnce.Do(func() {
+		c.point.Set(point)
+		copy(c.pub[:], point.Bytes())
+		c.ok = true
+	})
+	return c
+}
+
+// resolvePoint returns the cached point, running compute on first use. A nil
+// cache, as found on zero-value keys, computes without memoizing.
+func (c *keyCache) resolvePoint(compute func(*edwards25519.Point) bool) (*edwards25519.Point, [32]byte, bool) {
+	if c == nil {
+		point := new(edwards25519.Point)
+		if !compute(point) {
+			return nil, [32]byte{}, false
+		}
+		var pub [32]byte
+		copy(pub[:], point.Bytes())
+		return point, pub, true
+	}
+	c.pointOnce.Do(func() {
+		if compute(&c.point) {
+			copy(c.pub[:], c.point.Bytes())
+			c.ok = true
+		}
+	})
+	if !c.ok {
+		return nil, [32]byte{}, false
+	}
+	return &c.point, c.pub, true
+}
+
+// resolveMAC returns the HMAC states for chainCode.
+func (c *keyCache) resolveMAC(chainCode *[32]byte) *hmacKey {
+	if c == nil {
+		return newHMACKey(chainCode[:])
+	}
+	c.macOnce.Do(func() {
+		c.mac = newHMACKey(chainCode[:])
+	})
+	return c.mac
+}
+
+// wipe clears the HMAC states, which are derived from the chain code.
+func (c *keyCache) wipe() {
+	if c == nil || c.mac == nil {
+		return
+	}
+	clear(c.mac.inner)
+	clear(c.mac.outer)
+}
+
+// publicPoint returns A = [kL]B, computing it at most once per key.
+func (k *XPrv) publicPoint() (*edwards25519.Point, [32]byte) {
+	point, pub, _ := k.cache.resolvePoint(func(point *edwards25519.Point) bool {
+		s, _ := scalarFromLE32ModL(k.kL)
+		// kL is already an expanded scalar value, not a 32-byte Ed25519 seed.
+		point.ScalarBaseMult(s)
+		return true
+	})
+	return point, pub
+}
+
+// publicPoint returns the decoded public point, decompressing it at most once
+// per key.
+func (p *XPub) publicPoint() (*edwards25519.Point, bool) {
+	point, _, ok := p.cache.resolvePoint(func(point *edwards25519.Point) bool {
+		_, err := point.SetBytes(p.pub[:])
+		return err == nil
+	})
+	return point, ok
+}
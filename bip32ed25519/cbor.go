@@ -0,0 +1,90 @@
+package bip32ed25519
+
+// The CBOR subset that Byron roots and addresses use: unsigned integers, byte
+// strings, arrays, maps, and tags, with definite lengths unless noted.
+const (
+	cborUint  byte = 0
+	cborBytes byte = 2
+	cborArray byte = 4
+	cborMap   byte = 5
+	cborTag   byte = 6
+
+	cborIndefiniteArray byte = 0x9f
+	cborBreak           byte = 0xff
+)
+
+// appendCBORHead appends the shortest head of a major type and argument.
+func appendCBORHead(out []byte, major byte, n uint64) []byte {
+	major <<= 5
+	switch {
+	case n < 24:
+		return append(out, major|byte(n))
+	case n <= 0xff:
+		return append(out, major|24, byte(n))
+	case n <= 0xffff:
+		return append(out, major|25, byte(n>>8), byte(n))
+	case n <= 0xffffffff:
+		return append(out, major|26, byte(n>>24), byte(n>>16), byte(n>>8), byte(n))
+	default:
+		return append(out, major|27, byte(n>>56), byte(n>>48), byte(n>>40), byte(n>>32),
+			byte(n>>24), byte(n>>16), byte(n>>8), byte(n))
+	}
+}
+
+// appendCBORBytes appends b as a byte string.
+func appendCBORBytes(out, b []byte) []byte {
+	return append(appendCBORHead(out, cborBytes, uint64(len(b))), b...)
+}
+
+// cborReader reads CBOR items from the front of b. Its methods report false
+// for truncated input, indefinite lengths, and unexpected major types.
+type cborReader struct {
+	b []byte
+}
+
+// head reads an item head and returns its major type and argument.
+func (r *cborReader) head() (byte, uint64, bool) {
+	if len(r.b) == 0 {
+		return 0, 0, false
+	}
+	major, info := r.b[0]>>5, r.b[0]&0x1f
+	r.b = r.b[1:]
+	if info < 24 {
+		return major, uint64(info), true
+	}
+	if info > 27 {
+		return 0, 0, false
+	}
+	size := 1 << (info - 24)
+	if len(r.b) < size {
+		return 0, 0, false
+	}
+	var n uint64
+	for _, c := range r.b[:size] {
+		n = n<<8 | uint64(c)
+	}
+	r.b = r.b[size:]
+	return major, n, true
+}
+
+// expect reads an item head of the given major type and returns its argument.
+func (r *cborReader) expect(major byte) (uint64, bool) {
+	got, n, ok := r.head()
+	return n, ok && got == major
+}
+
+// bytes reads a byte string.
+func (r *cborReader) bytes() ([]byte, bool) {
+	n, ok := r.expect(cborBytes)
+	if !ok || uint64(len(r.b)) < n {
+		return nil, false
+	}
+	out := r.b[:n]
+	r.b = r.b[n:]
+	return out, true
+}
+
+// done reports whether all input was read.
+func (r *cborReader) done() bool {
+	return len(r.b) == 0
+}
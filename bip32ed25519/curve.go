@@ -0,0 +1,47 @@
+package bip32ed25519
+
+import bip32 "github.com/islishude/bip32/v2"
+
+var (
+	_ bip32.ExtendedPrivateKey[*XPrv, *XPub] = (*XPrv)(nil)
+	_ bip32.ExtendedPublicKey[*XPub]         = (*XPub)(nil)
+)
+
+// Curve returns bip32.CurveEd25519.
+func (k *XPrv) Curve() bip32.Curve {
+	return bip32.CurveEd25519
+}
+
+// Curve returns bip32.CurveEd25519.
+func (p *XPub) Curve() bip32.Curve {
+	return bip32.CurveEd25519
+}
+
+// PublicKeyBytes returns the 32-byte Ed25519 public key as a slice.
+func (k *XPrv) PublicKeyBytes() ([]byte, error) {
+	pub, err := k.PublicKey()
+	if err != nil {
+		return nil, err
+	}
+	return pub[:], nil
+}
+
+// PublicKeyBytes returns the 32-byte Ed25519 public key as a slice.
+func (p *XPub) PublicKeyBytes() []byte {
+	if p == nil {
+		return nil
+	}
+	return append([]byte(nil), p.pub[:]...)
+}
+
+// PathDepth returns Depth. It exists so that both curve packages satisfy
+// bip32.ExtendedPrivateKey and bip32.ExtendedPublicKey.
+func (k *XPrv) PathDepth() uint32 {
+	return k.Depth()
+}
+
+// PathDepth returns Depth. It exists so that both curve packages satisfy
+// bip32.ExtendedPrivateKey and bip32.ExtendedPublicKey.
+func (p *XPub) PathDepth() uint32 {
+	return p.Depth()
+}
@@ -9,57 +9,60 @@ func (k *XPrv) Derive(index uint32) (*XPrv, error) {
 		return nil, ErrDepthOverflow
 	}
 
-	parentPub, err := k.PublicKey()
-	if err != nil {
-		return nil, err
-	}
-
-	indexLE := ser32LE(index)
-
-	var zInput []byte
-	var ccInput []byte
+	indexBytes := k.scheme.ser32(index)
+	mac := k.cache.resolveMAC(&k.cc)
 
+	var z, i [64]byte
+	defer clear(z[:])
+	defer clear(i[:])
 	if IsHardened(index) {
-		zInput = make([]byte, 0, 1+64+4)
-		zInput = append(zInput, 0x00) // Z domain: hardened private derivation.
-		zInput = append(zInput, k.kL[:]...)
-		zInput = append(zInput, k.kR[:]...)
-		zInput = append(zInput, indexLE[:]...) // ED25519-BIP32 uses little-endian ser32.
-
-		ccInput = make([]byte, 0, 1+64+4)
-		ccInput = append(ccInput, 0x01) // I domain: hardened child chain code.
-		ccInput = append(ccInput, k.kL[:]...)
-		ccInput = append(ccInput, k.kR[:]...)
-		ccInput = append(ccInput, indexLE[:]...)
+		// Hardened children depend only on kL || kR, so the parent public key,
+		// a full base-point multiplication, is never needed here.
+		var data [1 + 64 + 4]byte
+		defer clear(data[:])
+		data[0] = 0x00 // Z domain: hardened private derivation.
+		copy(data[1:33], k.kL[:])
+		copy(data[33:65], k.kR[:])
+		copy(data[65:], indexBytes[:])
+		z = mac.sum(data[:])
+
+		data[0] = 0x01 // I domain: hardened child chain code.
+		i = mac.sum(data[:])
 	} else {
-		zInput = make([]byte, 0, 1+32+4)
-		zInput = append(zInput, 0x02) // Z domain: soft public-compatible derivation.
-		zInput = append(zInput, parentPub[:]...)
-		zInput = append(zInput, indexLE[:]...)
-
-		ccInput = make([]byte, 0, 1+32+4)
-		ccInput = append(ccInput, 0x03) // I domain: soft child chain code.
-		ccInput = append(ccInput, parentPub[:]...)
-		ccInput = append(ccInput, indexLE[:]...)
+		_, parentPub := k.publicPoint()
+		var data [1 + 32 + 4]byte
+		data[0] = 0x02 // Z domain: soft public-compatible derivation.
+		copy(data[1:33], parentPub[:])
+		copy(data[33:], indexBytes[:])
+		z = mac.sum(data[:])
+
+		data[0] = 0x03 // I domain: soft child chain code.
+		i = mac.sum(data[:])
 	}
 
-	z := hmacSHA512(k.cc[:], zInput)
-	i := hmacSHA512(k.cc[:], ccInput)
-
 	child := &XPrv{
 		depth:       k.depth + 1,
 		childNumber: index,
-		path:        append(append([]uint32(nil), k.path...), index),
+		path:        append(append(make([]uint32, 0, len(k.path)+1), k.path...), index),
+		variant:     k.variant,
+		scheme:      k.scheme,
+		cache:       newKeyCache(),
 	}
 
 	copy(child.kL[:], k.kL[:])
-	// Only Z[0:28] is used for kL, and the protocol multiplies it by 8.
-	if overflow := add28Mul8LE(&child.kL, z[0:28]); overflow {
+	if k.scheme == schemeV1 {
+		addZLMul8V1(&child.kL, z[0:32])
+	} else if overflow := add28Mul8LE(&child.kL, z[0:28]); overflow {
+		// Only Z[0:28] is used for kL, and the protocol multiplies it by 8.
 		return nil, ErrInvalidChild
 	}
 
 	copy(child.kR[:], k.kR[:])
-	addMod256LE(&child.kR, z[32:64]) // kR addition is modulo 2^256.
+	if k.scheme == schemeV1 {
+		addBytewiseV1(&child.kR, z[32:64])
+	} else {
+		addMod256LE(&child.kR, z[32:64]) // kR addition is modulo 2^256.
+	}
 
 	copy(child.cc[:], i[32:64]) // Chain code comes from the right half of I.
 
@@ -80,13 +83,31 @@ func (k *XPrv) DerivePath(path string) (*XPrv, error) {
 	if err != nil {
 		return nil, err
 	}
+	return k.deriveIndexes(indexes)
+}
+
+// DeriveRelativePath derives a path relative to this XPrv, such as 0'/0.
+func (k *XPrv) DeriveRelativePath(path string) (*XPrv, error) {
+	if k == nil {
+		return nil, ErrNilKey
+	}
+
+	indexes, err := ParseRelativePath(path)
+	if err != nil {
+		return nil, err
+	}
+	return k.deriveIndexes(indexes)
+}
 
+func (k *XPrv) deriveIndexes(indexes []uint32) (*XPrv, error) {
 	child := k.clone()
 	for _, index := range indexes {
-		child, err = child.Derive(index)
+		next, err := child.Derive(index)
+		child.Wipe()
 		if err != nil {
 			return nil, err
 		}
+		child = next
 	}
 	return child, nil
 }
@@ -16,44 +16,51 @@ func (p *XPub) Derive(index uint32) (*XPub, error) {
 		return nil, ErrDepthOverflow
 	}
 
-	indexLE := ser32LE(index)
+	indexBytes := p.scheme.ser32(index)
+	mac := p.cache.resolveMAC(&p.cc)
 
-	zInput := make([]byte, 0, 1+32+4)
-	zInput = append(zInput, 0x02) // Same Z domain used by soft private derivation.
-	zInput = append(zInput, p.pub[:]...)
-	zInput = append(zInput, indexLE[:]...)
+	var data [1 + 32 + 4]byte
+	data[0] = 0x02 // Same Z domain used by soft private derivation.
+	copy(data[1:33], p.pub[:])
+	copy(data[33:], indexBytes[:])
+	z := mac.sum(data[:])
 
-	z := hmacSHA512(p.cc[:], zInput)
-
-	// Public derivation adds [8 * Z[0:28]]B to the parent public point.
-	tweak, err := scalarFromZL28Times8(z[0:28])
+	// Public derivation adds [8 * Z[0:28]]B to the parent public point, or in
+	// V1 the scalar that addZLMul8V1 adds to kL.
+	var tweak *edwards25519.Scalar
+	var err error
+	if p.scheme == schemeV1 {
+		tweak, err = scalarFromLE32ModL(mul8V1(z[0:32]))
+	} else {
+		tweak, err = scalarFromZL28Times8(z[0:28])
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	parentPoint, err := new(edwards25519.Point).SetBytes(p.pub[:])
-	if err != nil {
+	parentPoint, ok := p.publicPoint()
+	if !ok {
 		return nil, ErrInvalidXPub
 	}
 
-	tweakPoint := new(edwards25519.Point).ScalarBaseMult(tweak)
-	childPoint := new(edwards25519.Point).Add(parentPoint, tweakPoint)
+	var childPoint edwards25519.Point
+	childPoint.ScalarBaseMult(tweak)
+	childPoint.Add(parentPoint, &childPoint)
 	if childPoint.Equal(edwards25519.NewIdentityPoint()) == 1 {
 		return nil, ErrInvalidChild
 	}
 
-	ccInput := make([]byte, 0, 1+32+4)
-	ccInput = append(ccInput, 0x03) // I domain: soft child chain code.
-	ccInput = append(ccInput, p.pub[:]...)
-	ccInput = append(ccInput, indexLE[:]...)
-
-	i := hmacSHA512(p.cc[:], ccInput)
+	data[0] = 0x03 // I domain: soft child chain code.
+	i := mac.sum(data[:])
 
 	child := &XPub{
 		depth:       p.depth + 1,
 		childNumber: index,
+		variant:     p.variant,
+		scheme:      p.scheme,
+		cache:       newKeyCacheWithPoint(&childPoint),
 	}
-	copy(child.pub[:], childPoint.Bytes())
+	child.pub = child.cache.pub
 	copy(child.cc[:], i[32:64]) // Chain code comes from the right half of I.
 
 	return child, nil
@@ -62,6 +62,17 @@ func TestCIP1852PathAndAccountXPub(t *testing.T) {
 	if !bytes.Equal(fromXPub.Bytes(), fromXPrvPub.Bytes()) {
 		t.Fatal("account xpub soft derivation does not match private path")
 	}
+
+	relative, err := account.DeriveRelativePath("0/0")
+	if err != nil {
+		t.Fatalf("account relative path: %v", err)
+	}
+	if !bytes.Equal(relative.Bytes(), fromXPrv.Bytes()) || relative.Depth() != 5 {
+		t.Fatal("account relative path does not match absolute path")
+	}
+	if _, err := account.DeriveRelativePath("m/0"); !errors.Is(err, ErrInvalidPath) {
+		t.Fatalf("DeriveRelativePath(m/0) error = %v", err)
+	}
 }
 
 func TestHardenedFromXPubFails(t *testing.T) {
@@ -115,3 +126,95 @@ func TestPrivateDerivationChainCodeUsesSecondHMACRightHalf(t *testing.T) {
 		t.Fatal("child chain code did not use right half of second HMAC")
 	}
 }
+
+func TestPrecomputedHMACMatchesHMAC(t *testing.T) {
+	root := testIcarusRoot(t)
+	mac := newHMACKey(root.cc[:])
+	for _, n := range []int{0, 1, 37, 69, 111, 112, 128, 200} {
+		data := bytes.Repeat([]byte{byte(n)}, n)
+		if mac.sum(data) != hmacSHA512(root.cc[:], data) {
+			t.Fatalf("precomputed HMAC mismatch for %d-byte input", n)
+		}
+	}
+}
+
+func TestCachedDerivationMatchesUncached(t *testing.T) {
+	root := testIcarusRoot(t)
+	rootPub, err := root.XPub()
+	if err != nil {
+		t.Fatalf("XPub: %v", err)
+	}
+	// Keys built without a cache take the uncached fallback path.
+	bare := &XPrv{kL: root.kL, kR: root.kR, cc: root.cc}
+	barePub := &XPub{pub: rootPub.pub, cc: rootPub.cc}
+
+	for _, index := range []uint32{0, 5, HardenedOffset, HardenedOffset + 5} {
+		for range 2 {
+			want, err := bare.Derive(index)
+			if err != nil {
+				t.Fatalf("uncached Derive(%d): %v", index, err)
+			}
+			got, err := root.Derive(index)
+			if err != nil {
+				t.Fatalf("cached Derive(%d): %v", index, err)
+			}
+			if !bytes.Equal(got.Bytes(), want.Bytes()) {
+				t.Fatalf("cached private child %d differs", index)
+			}
+			if IsHardened(index) {
+				continue
+			}
+			wantPub, err := barePub.Derive(index)
+			if err != nil {
+				t.Fatalf("uncached XPub.Derive(%d): %v", index, err)
+			}
+			gotPub, err := rootPub.Derive(index)
+			if err != nil {
+				t.Fatalf("cached XPub.Derive(%d): %v", index, err)
+			}
+			if !bytes.Equal(gotPub.Bytes(), wantPub.Bytes()) {
+				t.Fatalf("cached public child %d differs", index)
+			}
+		}
+	}
+}
+
+func BenchmarkXPrvDerive(b *testing.B) {
+	root, err := NewMasterKeyIcarus(bytes.Repeat([]byte{1}, 32), nil)
+	if err != nil {
+		b.Fatal(err)
+	}
+	for _, bench := range []struct {
+		name  string
+		index uint32
+	}{
+		{"Hardened", HardenedOffset},
+		{"Soft", 0},
+	} {
+		b.Run(bench.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for b.Loop() {
+				if _, err := root.Derive(bench.index); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkXPubDerive(b *testing.B) {
+	root, err := NewMasterKeyIcarus(bytes.Repeat([]byte{1}, 32), nil)
+	if err != nil {
+		b.Fatal(err)
+	}
+	xpub, err := root.XPub()
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	for b.Loop() {
+		if _, err := xpub.Derive(0); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
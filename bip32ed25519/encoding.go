@@ -0,0 +1,167 @@
+package bip32ed25519
+
+import (
+	"database/sql/driver"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+
+	"golang.org/x/crypto/blake2b"
+)
+
+// MarshalText returns the lowercase hex of the 64-byte Bytes serialization.
+// Like Bytes, it omits depth and child-number metadata.
+func (p *XPub) MarshalText() ([]byte, error) {
+	if p == nil {
+		return nil, ErrNilKey
+	}
+	out := make([]byte, hex.EncodedLen(XPubSize))
+	hex.Encode(out, p.Bytes())
+	return out, nil
+}
+
+// UnmarshalText parses a hex-encoded 64-byte publicKey || chainCode value
+// into p.
+func (p *XPub) UnmarshalText(text []byte) error {
+	if p == nil {
+		return ErrNilKey
+	}
+	if len(text) != hex.EncodedLen(XPubSize) {
+		return ErrInvalidXPub
+	}
+	var raw [XPubSize]byte
+	if _, err := hex.Decode(raw[:], text); err != nil {
+		return ErrInvalidXPub
+	}
+	return p.UnmarshalBinary(raw[:])
+}
+
+// MarshalJSON returns the MarshalText form as a JSON string.
+func (p *XPub) MarshalJSON() ([]byte, error) {
+	text, err := p.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(string(text))
+}
+
+// UnmarshalJSON parses a JSON string in the MarshalText form. A JSON null
+// leaves p unchanged.
+func (p *XPub) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var text string
+	if err := json.Unmarshal(data, &text); err != nil {
+		return ErrInvalidXPub
+	}
+	return p.UnmarshalText([]byte(text))
+}
+
+// MarshalBinary returns the 64-byte Bytes serialization.
+func (p *XPub) MarshalBinary() ([]byte, error) {
+	if p == nil {
+		return nil, ErrNilKey
+	}
+	return p.Bytes(), nil
+}
+
+// UnmarshalBinary parses a 64-byte publicKey || chainCode value into p.
+func (p *XPub) UnmarshalBinary(data []byte) error {
+	if p == nil {
+		return ErrNilKey
+	}
+	parsed, err := NewXPubFromBytes(data)
+	if err != nil {
+		return err
+	}
+	*p = *parsed
+	return nil
+}
+
+// Value stores p in its hex text form. A nil p is stored as SQL NULL.
+func (p *XPub) Value() (driver.Value, error) {
+	if p == nil {
+		return nil, nil
+	}
+	text, err := p.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return string(text), nil
+}
+
+// Scan reads a hex string or a 64-byte binary value from a database column.
+// Use sql.Null[XPub] for nullable columns.
+func (p *XPub) Scan(src any) error {
+	switch src := src.(type) {
+	case string:
+		return p.UnmarshalText([]byte(src))
+	case []byte:
+		if len(src) == XPubSize {
+			return p.UnmarshalBinary(src)
+		}
+		return p.UnmarshalText(src)
+	default:
+		return fmt.Errorf("%w: cannot scan %T", ErrInvalidXPub, src)
+	}
+}
+
+// Format writes a redacted placeholder showing only the key fingerprint and
+// depth, whatever the verb. The fingerprint is the first four bytes of the
+// BLAKE2b-224 key hash that Cardano addresses commit to. Format, GoString,
+// and LogValue have value receivers so that XPrv values and structs holding
+// them are covered as well as pointers.
+func (k XPrv) Format(f fmt.State, _ rune) {
+	_, _ = f.Write([]byte(k.redacted("XPrv")))
+}
+
+// GoString returns the same redacted placeholder as Format.
+func (k XPrv) GoString() string {
+	return k.redacted("XPrv")
+}
+
+// LogValue returns the same redacted placeholder as Format for log/slog.
+func (k XPrv) LogValue() slog.Value {
+	return slog.StringValue(k.redacted("XPrv"))
+}
+
+// Format writes a redacted placeholder, as XPrv.Format does.
+func (k XPrvV1) Format(f fmt.State, _ rune) {
+	_, _ = f.Write([]byte(k.k.redacted("XPrvV1")))
+}
+
+// GoString returns the same redacted placeholder as Format.
+func (k XPrvV1) GoString() string {
+	return k.k.redacted("XPrvV1")
+}
+
+// LogValue returns the same redacted placeholder as Format for log/slog.
+func (k XPrvV1) LogValue() slog.Value {
+	return slog.StringValue(k.k.redacted("XPrvV1"))
+}
+
+// redacted returns the placeholder for a key of the named type.
+func (k *XPrv) redacted(name string) string {
+	if k == nil {
+		return "<nil>"
+	}
+	fingerprint, err := k.fingerprint()
+	if err != nil {
+		return "bip32ed25519." + name + "{invalid}"
+	}
+	return fmt.Sprintf("bip32ed25519.%s{fingerprint: %s, depth: %d, key: REDACTED}", name, hex.EncodeToString(fingerprint[:]), k.depth)
+}
+
+// fingerprint returns the first four bytes of the BLAKE2b-224 key hash.
+func (k *XPrv) fingerprint() ([4]byte, error) {
+	pub, err := k.PublicKey()
+	if err != nil {
+		return [4]byte{}, err
+	}
+	h, _ := blake2b.New(28, nil)
+	_, _ = h.Write(pub[:])
+	keyHash := h.Sum(nil)
+	return [4]byte(keyHash), nil
+}
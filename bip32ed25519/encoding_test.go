@@ -0,0 +1,160 @@
+package bip32ed25519
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"reflect"
+	"strings"
+	"testing"
+
+	"golang.org/x/crypto/blake2b"
+)
+
+var (
+	_ encoding.TextMarshaler     = (*XPub)(nil)
+	_ encoding.TextUnmarshaler   = (*XPub)(nil)
+	_ encoding.BinaryMarshaler   = (*XPub)(nil)
+	_ encoding.BinaryUnmarshaler = (*XPub)(nil)
+	_ json.Marshaler             = (*XPub)(nil)
+	_ json.Unmarshaler           = (*XPub)(nil)
+	_ driver.Valuer              = (*XPub)(nil)
+	_ sql.Scanner                = (*XPub)(nil)
+	_ fmt.Formatter              = XPrv{}
+	_ fmt.GoStringer             = XPrv{}
+	_ slog.LogValuer             = XPrv{}
+	_ fmt.Formatter              = XPrvV1{}
+	_ slog.LogValuer             = XPrvV1{}
+)
+
+func TestXPubMarshaling(t *testing.T) {
+	account, err := testIcarusRoot(t).DerivePath("m/1852'/1815'/0'")
+	if err != nil {
+		t.Fatalf("DerivePath: %v", err)
+	}
+	xpub, err := account.XPub()
+	if err != nil {
+		t.Fatalf("XPub: %v", err)
+	}
+	raw := xpub.Bytes()
+	text := hex.EncodeToString(raw)
+
+	data, err := json.Marshal(map[string]*XPub{"key": xpub})
+	if err != nil || string(data) != `{"key":"`+text+`"}` {
+		t.Fatalf("json.Marshal = %s, %v", data, err)
+	}
+	var decoded map[string]*XPub
+	if err := json.Unmarshal(data, &decoded); err != nil || !bytes.Equal(decoded["key"].Bytes(), raw) {
+		t.Fatalf("json.Unmarshal = %v", err)
+	}
+	want, _ := xpub.Derive(3)
+	got, err := decoded["key"].Derive(3)
+	if err != nil || !bytes.Equal(got.Bytes(), want.Bytes()) {
+		t.Fatalf("decoded Derive = %v", err)
+	}
+
+	if binary, err := xpub.MarshalBinary(); err != nil || !bytes.Equal(binary, raw) {
+		t.Fatalf("MarshalBinary = %x, %v", binary, err)
+	}
+	if value, err := xpub.Value(); err != nil || value != text {
+		t.Fatalf("Value = %v, %v", value, err)
+	}
+	var nilXPub *XPub
+	if value, err := nilXPub.Value(); value != nil || err != nil {
+		t.Fatalf("nil Value = %v, %v", value, err)
+	}
+	for _, src := range []any{text, strings.ToUpper(text), []byte(text), raw} {
+		var scanned XPub
+		if err := scanned.Scan(src); err != nil || !bytes.Equal(scanned.Bytes(), raw) {
+			t.Fatalf("Scan(%T) = %v", src, err)
+		}
+	}
+
+	var target XPub
+	notOnCurve := make([]byte, XPubSize)
+	copy(notOnCurve, mustDecodeHex(t, "efffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff7f"))
+	for _, tc := range []struct {
+		name string
+		err  error
+		want error
+	}{
+		{"short text", target.UnmarshalText([]byte(text[2:])), ErrInvalidXPub},
+		{"bad hex", target.UnmarshalText([]byte("zz" + text[2:])), ErrInvalidXPub},
+		{"json number", target.UnmarshalJSON([]byte("1")), ErrInvalidXPub},
+		{"not on curve", target.UnmarshalBinary(notOnCurve), ErrInvalidXPub},
+		{"scan int", target.Scan(int64(1)), ErrInvalidXPub},
+		{"nil receiver", nilXPub.UnmarshalText([]byte(text)), ErrNilKey},
+	} {
+		if !errors.Is(tc.err, tc.want) {
+			t.Fatalf("%s: error = %v, want %v", tc.name, tc.err, tc.want)
+		}
+	}
+	if target != (XPub{}) {
+		t.Fatal("failed unmarshal modified the receiver")
+	}
+}
+
+func TestXPrvRedactedFormatting(t *testing.T) {
+	account, err := testIcarusRoot(t).DerivePath("m/1852'/1815'/0'")
+	if err != nil {
+		t.Fatalf("DerivePath: %v", err)
+	}
+	pub, err := account.PublicKey()
+	if err != nil {
+		t.Fatalf("PublicKey: %v", err)
+	}
+	h, _ := blake2b.New(28, nil)
+	h.Write(pub[:])
+	want := "bip32ed25519.XPrv{fingerprint: " + hex.EncodeToString(h.Sum(nil)[:4]) + ", depth: 3, key: REDACTED}"
+
+	type holder struct {
+		Name string
+		XPrv
+	}
+	embedded := holder{Name: "account", XPrv: *account}
+	for _, verb := range []string{"%v", "%+v", "%#v", "%s", "%x", "%q"} {
+		if got := fmt.Sprintf(verb, account); got != want {
+			t.Fatalf("Sprintf(%s) = %s, want %s", verb, got, want)
+		}
+		if got := fmt.Sprintf(verb, *account); got != want {
+			t.Fatalf("Sprintf(%s) of a value = %s, want %s", verb, got, want)
+		}
+		if got := fmt.Sprintf(verb, embedded); !strings.Contains(got, want) {
+			t.Fatalf("Sprintf(%s) of an embedding struct = %s", verb, got)
+		}
+	}
+	var logs bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&logs, nil))
+	logger.Info("derived", "key", account)
+	logger.Info("derived", "key", *account)
+	logger.Info("derived", "holder", embedded)
+	if strings.Count(logs.String(), want) != 3 || strings.Contains(logs.String(), hex.EncodeToString(account.Bytes()[:32])) {
+		t.Fatalf("slog output = %s", logs.String())
+	}
+
+	byron, err := testByronRoot(t).DerivePath("m/0'")
+	if err != nil {
+		t.Fatalf("DerivePath: %v", err)
+	}
+	for _, v := range []any{byron, *byron} {
+		if got := fmt.Sprintf("%+v", v); !strings.HasPrefix(got, "bip32ed25519.XPrvV1{fingerprint: ") || strings.Contains(got, hex.EncodeToString(byron.Bytes()[:32])) {
+			t.Fatalf("Sprintf(%%+v) of %T = %s", v, got)
+		}
+	}
+
+	var nilKey *XPrv
+	if got := fmt.Sprint(nilKey); got != "<nil>" {
+		t.Fatalf("nil XPrv = %s", got)
+	}
+	stringer := reflect.TypeFor[fmt.Stringer]()
+	textMarshaler := reflect.TypeFor[encoding.TextMarshaler]()
+	if typ := reflect.TypeOf(account); typ.Implements(stringer) || typ.Implements(textMarshaler) {
+		t.Fatalf("%v unexpectedly supports implicit text serialization", typ)
+	}
+}
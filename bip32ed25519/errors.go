@@ -5,16 +5,34 @@ import "errors"
 var (
 	// ErrInvalidSeed reports an empty or incorrectly sized master seed input.
 	ErrInvalidSeed = errors.New("bip32ed25519: invalid seed")
+	// ErrInvalidMnemonic reports a mnemonic that is not valid English BIP-39,
+	// or a passphrase outside ASCII.
+	ErrInvalidMnemonic = errors.New("bip32ed25519: invalid mnemonic")
 	// ErrInvalidXPrv reports malformed 96-byte extended private key material.
 	ErrInvalidXPrv = errors.New("bip32ed25519: invalid extended private key")
 	// ErrInvalidXPub reports malformed 64-byte extended public key material.
 	ErrInvalidXPub = errors.New("bip32ed25519: invalid extended public key")
+	// ErrInvalidBech32 reports a malformed CIP-5 bech32 string, including one
+	// with a bad checksum or the Bech32m checksum.
+	ErrInvalidBech32 = errors.New("bip32ed25519: invalid bech32 key")
+	// ErrBech32Prefix reports a CIP-5 prefix with an unknown role or for
+	// another kind of key.
+	ErrBech32Prefix = errors.New("bip32ed25519: unexpected bech32 prefix")
+	// ErrInvalidAddress reports a malformed or unsupported Shelley or Byron
+	// address.
+	ErrInvalidAddress = errors.New("bip32ed25519: invalid address")
 	// ErrInvalidPath reports a malformed absolute or relative derivation path.
 	ErrInvalidPath = errors.New("bip32ed25519: invalid derivation path")
 	// ErrInvalidChild reports the rare invalid child-key discard condition.
 	ErrInvalidChild = errors.New("bip32ed25519: invalid child key")
 	// ErrInvalidTweak reports malformed derivation tweak input.
 	ErrInvalidTweak = errors.New("bip32ed25519: invalid derivation tweak")
+	// ErrRootVariantMismatch reports keys or options from different root
+	// algorithms used together.
+	ErrRootVariantMismatch = errors.New("bip32ed25519: root variant mismatch")
+	// ErrNoRootVariant reports that no root algorithm matched in
+	// DetectRootVariant.
+	ErrNoRootVariant = errors.New("bip32ed25519: no root variant matches")
 	// ErrNilKey reports method calls made on a nil key receiver.
 	ErrNilKey = errors.New("bip32ed25519: nil key")
 	// ErrDepthOverflow reports derivation past MaxDepth.
@@ -23,4 +41,23 @@ var (
 	ErrHardenedFromXPub = errors.New("bip32ed25519: cannot derive hardened child from xpub")
 	// ErrRejectedMasterSecret reports raw Khovratovich root rejection.
 	ErrRejectedMasterSecret = errors.New("bip32ed25519: rejected master secret")
+	// ErrHardenedChild reports parent recovery from a hardened child.
+	ErrHardenedChild = errors.New("bip32ed25519: cannot recover parent from hardened child")
+	// ErrNotChild reports parent recovery from a key that is not its child.
+	ErrNotChild = errors.New("bip32ed25519: key is not a child of parent")
+	// ErrInvalidCapacity reports a non-positive Keychain cache capacity.
+	ErrInvalidCapacity = errors.New("bip32ed25519: invalid keychain capacity")
+	// ErrInvalidKeystore reports a malformed keystore, one for another curve,
+	// or one whose metadata does not match its key.
+	ErrInvalidKeystore = errors.New("bip32ed25519: invalid keystore")
+	// ErrUnsupportedKeystore reports an unknown keystore version, KDF, cipher
+	// or root variant, or KDF costs outside the accepted bounds.
+	ErrUnsupportedKeystore = errors.New("bip32ed25519: unsupported keystore")
+	// ErrUnsupportedSignerOpts reports Signer options other than Ed25519,
+	// Ed25519ctx and Ed25519ph, or a prehashed message that is not a SHA-512
+	// digest.
+	ErrUnsupportedSignerOpts = errors.New("bip32ed25519: unsupported signer options")
+	// ErrDecryption reports a keystore, or a Byron address HD payload, that
+	// failed to authenticate.
+	ErrDecryption = errors.New("bip32ed25519: message authentication failed")
 )
@@ -3,6 +3,7 @@ package bip32ed25519
 import (
 	"crypto/hmac"
 	"crypto/sha512"
+	"encoding"
 )
 
 func hmacSHA512(key, data []byte) (out [64]byte) {
@@ -11,3 +12,63 @@ func hmacSHA512(key, data []byte) (out [64]byte) {
 	mac.Sum(out[:0])
 	return
 }
+
+// hmacKey is an HMAC-SHA512 key whose padded inner and outer blocks have
+// already been absorbed. Each MAC under it skips two of the four SHA-512
+// compressions that hmacSHA512 spends, which matters because every derivation
+// runs two MACs under the parent chain code.
+type hmacKey struct {
+	inner []byte
+	outer []byte
+}
+
+// newHMACKey precomputes the HMAC states for key, which must not be longer
+// than the SHA-512 block size. Chain codes are always 32 bytes.
+func newHMACKey(key []byte) *hmacKey {
+	if len(key) > sha512.BlockSize {
+		panic("bip32ed25519: HMAC key longer than the SHA-512 block")
+	}
+
+	var pad [sha512.BlockSize]byte
+	defer clear(pad[:])
+	h := sha512.New()
+
+	copy(pad[:], key)
+	for i := range pad {
+		pad[i] ^= 0x36
+	}
+	_, _ = h.Write(pad[:])
+	inner, err := h.(encoding.BinaryMarshaler).MarshalBinary()
+	if err != nil {
+		panic("bip32ed25519: " + err.Error())
+	}
+
+	h.Reset()
+	for i := range pad {
+		pad[i] ^= 0x36 ^ 0x5c
+	}
+	_, _ = h.Write(pad[:])
+	outer, err := h.(encoding.BinaryMarshaler).MarshalBinary()
+	if err != nil {
+		panic("bip32ed25519: " + err.Error())
+	}
+
+	return &hmacKey{inner: inner, outer: outer}
+}
+
+// sum returns HMAC-SHA512(key, data). The digest is not pooled: Reset does not
+// clear its block buffer, which holds the tail of data, often a private key.
+func (k *hmacKey) sum(data []byte) (out [64]byte) {
+	h := sha512.New()
+	state := h.(encoding.BinaryUnmarshaler)
+
+	// Restoring a state produced by MarshalBinary on the same hash cannot fail.
+	_ = state.UnmarshalBinary(k.inner)
+	_, _ = h.Write(data)
+	h.Sum(out[:0])
+
+	_ = state.UnmarshalBinary(k.outer)
+	_, _ = h.Write(out[:])
+	h.Sum(out[:0])
+	return
+}
@@ -14,6 +14,10 @@ type XPrv struct {
 	depth       uint32
 	childNumber uint32
 	path        []uint32
+	variant     RootVariant
+	scheme      derivationScheme
+
+	cache *keyCache
 }
 
 // XPub is a Cardano/Khovratovich-Law extended public key.
@@ -26,6 +30,10 @@ type XPub struct {
 
 	depth       uint32
 	childNumber uint32
+	variant     RootVariant
+	scheme      derivationScheme
+
+	cache *keyCache
 }
 
 // NewXPrvFromBytes imports a 96-byte kL || kR || chainCode value.
@@ -34,7 +42,7 @@ func NewXPrvFromBytes(b []byte) (*XPrv, error) {
 		return nil, ErrInvalidXPrv
 	}
 
-	var k XPrv
+	k := XPrv{cache: newKeyCache()}
 	copy(k.kL[:], b[0:32])
 	copy(k.kR[:], b[32:64])
 	copy(k.cc[:], b[64:96])
@@ -53,11 +61,12 @@ func NewXPubFromBytes(b []byte) (*XPub, error) {
 		return nil, ErrInvalidXPub
 	}
 	// Validate the compressed public key before storing the chain code.
-	if _, err := new(edwards25519.Point).SetBytes(b[0:32]); err != nil {
+	point, err := new(edwards25519.Point).SetBytes(b[0:32])
+	if err != nil {
 		return nil, ErrInvalidXPub
 	}
 
-	var p XPub
+	p := XPub{cache: newKeyCacheWithPoint(point)}
 	copy(p.pub[:], b[0:32])
 	copy(p.cc[:], b[32:64])
 	return &p, nil
@@ -134,6 +143,10 @@ func (k *XPrv) Wipe() {
 	k.path = nil
 	k.depth = 0
 	k.childNumber = 0
+	k.variant = 0
+	k.scheme = 0
+	k.cache.wipe()
+	k.cache = nil
 }
 
 // Bytes returns a copy of the 64-byte CIP-16 binary XPub serialization.
@@ -213,6 +226,7 @@ func (k *XPrv) clone() *XPrv {
 	}
 	out := *k
 	out.path = append([]uint32(nil), k.path...)
+	out.cache = newKeyCache()
 	return &out
 }
 
@@ -114,6 +114,36 @@ func TestWipe(t *testing.T) {
 	}
 }
 
+func TestWipeClearsHMACStates(t *testing.T) {
+	root := testIcarusRoot(t)
+	xpub, err := root.XPub()
+	if err != nil {
+		t.Fatalf("XPub: %v", err)
+	}
+	want, err := xpub.Derive(0)
+	if err != nil {
+		t.Fatalf("Derive: %v", err)
+	}
+	if _, err := root.Derive(0); err != nil {
+		t.Fatalf("Derive: %v", err)
+	}
+
+	mac := root.cache.mac
+	root.Wipe()
+	if !bytes.Equal(mac.inner, make([]byte, len(mac.inner))) || !bytes.Equal(mac.outer, make([]byte, len(mac.outer))) {
+		t.Fatal("Wipe did not clear the cached HMAC states")
+	}
+
+	// The XPub has its own cache, so wiping the XPrv does not disturb it.
+	got, err := xpub.Derive(0)
+	if err != nil {
+		t.Fatalf("Derive after Wipe: %v", err)
+	}
+	if !bytes.Equal(got.Bytes(), want.Bytes()) {
+		t.Fatal("XPub derivation changed after wiping its XPrv")
+	}
+}
+
 func TestXPrvMetadataAndPublicKey(t *testing.T) {
 	root := testIcarusRoot(t)
 
@@ -0,0 +1,246 @@
+package bip32ed25519
+
+// XPrvV1 is an extended private key of a Byron-era Daedalus "random" wallet,
+// which derives with the legacy V1 scheme: indexes are serialized big-endian,
+// all 32 bytes of ZL are multiplied by 8 without carrying between bytes and
+// added to kL modulo the group order, and ZR is added to kR byte by byte,
+// again without carries.
+//
+// It is a separate type from XPrv because no key format records the scheme,
+// and V1 key bytes loaded as an XPrv derive different children. Its Bytes
+// form has the XPrv layout, so only code that records the scheme alongside
+// it should store it, and load it again with NewXPrvV1FromBytes.
+type XPrvV1 struct {
+	k *XPrv
+}
+
+// XPubV1 is the extended public key of an XPrvV1. It is a separate type from
+// XPub for the same reason.
+type XPubV1 struct {
+	p *XPub
+}
+
+// NewXPrvV1FromBytes imports a 96-byte kL || kR || chainCode value as a V1
+// key. V1 children reduce kL modulo the group order, so of the XPrv bit
+// checks only the clear top bit applies.
+func NewXPrvV1FromBytes(b []byte) (*XPrvV1, error) {
+	if len(b) != XPrvSize {
+		return nil, ErrInvalidXPrv
+	}
+	k := &XPrv{scheme: schemeV1, cache: newKeyCache()}
+	copy(k.kL[:], b[0:32])
+	copy(k.kR[:], b[32:64])
+	copy(k.cc[:], b[64:96])
+	if k.kL[31]&0b10000000 != 0 || k.isZeroScalar() {
+		k.Wipe()
+		return nil, ErrInvalidXPrv
+	}
+	return &XPrvV1{k: k}, nil
+}
+
+// NewXPubV1FromBytes imports a 64-byte publicKey || chainCode value as a V1
+// key.
+func NewXPubV1FromBytes(b []byte) (*XPubV1, error) {
+	p, err := NewXPubFromBytes(b)
+	if err != nil {
+		return nil, err
+	}
+	p.scheme = schemeV1
+	return &XPubV1{p: p}, nil
+}
+
+// wrapXPrvV1 returns k as an XPrvV1, passing errors through.
+func wrapXPrvV1(k *XPrv, err error) (*XPrvV1, error) {
+	if err != nil {
+		return nil, err
+	}
+	return &XPrvV1{k: k}, nil
+}
+
+// wrapXPubV1 returns p as an XPubV1, passing errors through.
+func wrapXPubV1(p *XPub, err error) (*XPubV1, error) {
+	if err != nil {
+		return nil, err
+	}
+	return &XPubV1{p: p}, nil
+}
+
+// Bytes returns a copy of the 96-byte kL || kR || chainCode serialization,
+// which does not record the V1 scheme.
+func (k *XPrvV1) Bytes() []byte {
+	if k == nil {
+		return nil
+	}
+	return k.k.Bytes()
+}
+
+// Derive derives a private child key for hardened and soft indexes.
+func (k *XPrvV1) Derive(index uint32) (*XPrvV1, error) {
+	if k == nil {
+		return nil, ErrNilKey
+	}
+	return wrapXPrvV1(k.k.Derive(index))
+}
+
+// DerivePath derives an absolute path such as m/0'/1'.
+func (k *XPrvV1) DerivePath(path string) (*XPrvV1, error) {
+	if k == nil {
+		return nil, ErrNilKey
+	}
+	return wrapXPrvV1(k.k.DerivePath(path))
+}
+
+// DeriveRelativePath derives a path relative to this key, such as 0'/1'.
+func (k *XPrvV1) DeriveRelativePath(path string) (*XPrvV1, error) {
+	if k == nil {
+		return nil, ErrNilKey
+	}
+	return wrapXPrvV1(k.k.DeriveRelativePath(path))
+}
+
+// PublicKey returns A = [kL]B as a compressed Ed25519 public key.
+func (k *XPrvV1) PublicKey() ([32]byte, error) {
+	if k == nil {
+		return [32]byte{}, ErrNilKey
+	}
+	return k.k.PublicKey()
+}
+
+// XPub returns the matching extended public key with the same chain code.
+func (k *XPrvV1) XPub() (*XPubV1, error) {
+	if k == nil {
+		return nil, ErrNilKey
+	}
+	return wrapXPubV1(k.k.XPub())
+}
+
+// Sign signs message as XPrv.Sign does.
+func (k *XPrvV1) Sign(message []byte) ([]byte, error) {
+	if k == nil {
+		return nil, ErrNilKey
+	}
+	return k.k.Sign(message)
+}
+
+// ChainCode returns a copy of the chain code.
+func (k *XPrvV1) ChainCode() []byte {
+	if k == nil {
+		return nil
+	}
+	return k.k.ChainCode()
+}
+
+// Path returns a copy of the in-memory derivation path metadata.
+func (k *XPrvV1) Path() []uint32 {
+	if k == nil {
+		return nil
+	}
+	return k.k.Path()
+}
+
+// Depth returns the in-memory derivation depth metadata.
+func (k *XPrvV1) Depth() uint32 {
+	if k == nil {
+		return 0
+	}
+	return k.k.Depth()
+}
+
+// ChildNumber returns the in-memory child-number metadata.
+func (k *XPrvV1) ChildNumber() uint32 {
+	if k == nil {
+		return 0
+	}
+	return k.k.ChildNumber()
+}
+
+// RootVariant returns RootByron for NewMasterKeyByron roots and their
+// descendants, and zero for keys imported from bytes.
+func (k *XPrvV1) RootVariant() RootVariant {
+	if k == nil {
+		return 0
+	}
+	return k.k.RootVariant()
+}
+
+// Wipe clears key material on a best-effort basis, as XPrv.Wipe does.
+func (k *XPrvV1) Wipe() {
+	if k == nil {
+		return
+	}
+	k.k.Wipe()
+}
+
+// Bytes returns a copy of the 64-byte publicKey || chainCode serialization,
+// which does not record the V1 scheme.
+func (p *XPubV1) Bytes() []byte {
+	if p == nil {
+		return nil
+	}
+	return p.p.Bytes()
+}
+
+// Derive derives a soft public child key. Hardened public derivation is
+// impossible and returns ErrHardenedFromXPub.
+func (p *XPubV1) Derive(index uint32) (*XPubV1, error) {
+	if p == nil {
+		return nil, ErrNilKey
+	}
+	return wrapXPubV1(p.p.Derive(index))
+}
+
+// DeriveRelativePath derives a soft relative path such as 0/0.
+func (p *XPubV1) DeriveRelativePath(path string) (*XPubV1, error) {
+	if p == nil {
+		return nil, ErrNilKey
+	}
+	return wrapXPubV1(p.p.DeriveRelativePath(path))
+}
+
+// PublicKey returns the compressed 32-byte Ed25519 public key.
+func (p *XPubV1) PublicKey() [32]byte {
+	if p == nil {
+		return [32]byte{}
+	}
+	return p.p.PublicKey()
+}
+
+// ChainCode returns a copy of the chain code.
+func (p *XPubV1) ChainCode() []byte {
+	if p == nil {
+		return nil
+	}
+	return p.p.ChainCode()
+}
+
+// Depth returns the in-memory derivation depth metadata.
+func (p *XPubV1) Depth() uint32 {
+	if p == nil {
+		return 0
+	}
+	return p.p.Depth()
+}
+
+// ChildNumber returns the in-memory child-number metadata.
+func (p *XPubV1) ChildNumber() uint32 {
+	if p == nil {
+		return 0
+	}
+	return p.p.ChildNumber()
+}
+
+// RootVariant returns the root algorithm p descends from, or zero if unknown.
+func (p *XPubV1) RootVariant() RootVariant {
+	if p == nil {
+		return 0
+	}
+	return p.p.RootVariant()
+}
+
+// Verify reports whether sig is a valid Ed25519 signature of message by p.
+func (p *XPubV1) Verify(message, sig []byte) bool {
+	if p == nil {
+		return false
+	}
+	return p.p.Verify(message, sig)
+}
@@ -0,0 +1,123 @@
+package bip32ed25519
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"testing"
+)
+
+func testByronRoot(t *testing.T) *XPrvV1 {
+	t.Helper()
+	root, err := NewMasterKeyByron(byronVectorMnemonic)
+	if err != nil {
+		t.Fatalf("NewMasterKeyByron: %v", err)
+	}
+	return root
+}
+
+func TestXPrvV1Derivation(t *testing.T) {
+	root := testByronRoot(t)
+	child, err := root.DerivePath("m/0'/1'")
+	if err != nil {
+		t.Fatalf("DerivePath: %v", err)
+	}
+	if got := hex.EncodeToString(child.Bytes()); got != byronVectorChild {
+		t.Fatalf("m/0'/1' = %s, want %s", got, byronVectorChild)
+	}
+	if child.RootVariant() != RootByron {
+		t.Fatalf("child variant %v", child.RootVariant())
+	}
+
+	// The same key material loaded as an XPrv has different children.
+	v2, err := NewXPrvFromBytes(root.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	v2Child, err := v2.DerivePath("m/0'/1'")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Equal(v2Child.Bytes(), child.Bytes()) {
+		t.Fatal("V1 and V2 derivation agree")
+	}
+
+	childPub, err := child.XPub()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, index := range []uint32{0, 1, 42, 0x7fffffff} {
+		prv, err := child.Derive(index)
+		if err != nil {
+			t.Fatalf("XPrvV1.Derive(%d): %v", index, err)
+		}
+		fromPrv, err := prv.XPub()
+		if err != nil {
+			t.Fatal(err)
+		}
+		fromPub, err := childPub.Derive(index)
+		if err != nil {
+			t.Fatalf("XPubV1.Derive(%d): %v", index, err)
+		}
+		if !bytes.Equal(fromPrv.Bytes(), fromPub.Bytes()) {
+			t.Fatalf("soft V1 child %d: private and public derivation disagree", index)
+		}
+	}
+
+	sig, err := child.Sign([]byte("byron"))
+	if err != nil || !childPub.Verify([]byte("byron"), sig) {
+		t.Fatalf("V1 signature does not verify: %v", err)
+	}
+}
+
+func TestXPrvV1Bytes(t *testing.T) {
+	root := testByronRoot(t)
+	raw := root.Bytes()
+	if hex.EncodeToString(raw) != byronVectorRoot {
+		t.Fatalf("Bytes = %x", raw)
+	}
+	loaded, err := NewXPrvV1FromBytes(raw)
+	if err != nil {
+		t.Fatalf("NewXPrvV1FromBytes: %v", err)
+	}
+	if loaded.RootVariant() != 0 {
+		t.Fatalf("imported variant = %v, want zero", loaded.RootVariant())
+	}
+	child, err := loaded.DerivePath("m/0'/1'")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := hex.EncodeToString(child.Bytes()); got != byronVectorChild {
+		t.Fatalf("reloaded m/0'/1' = %s, want %s", got, byronVectorChild)
+	}
+	// V1 children are reduced modulo the group order and need not pass the
+	// XPrv bit checks.
+	if _, err := NewXPrvV1FromBytes(child.Bytes()); err != nil {
+		t.Fatalf("NewXPrvV1FromBytes(child): %v", err)
+	}
+	if _, err := NewXPrvFromBytes(child.Bytes()); !errors.Is(err, ErrInvalidXPrv) {
+		t.Fatalf("V1 child accepted by the XPrv checks: %v", err)
+	}
+	if _, err := NewXPrvV1FromBytes(raw[:32]); !errors.Is(err, ErrInvalidXPrv) {
+		t.Fatalf("short V1 key error = %v", err)
+	}
+
+	xpub, _ := root.XPub()
+	loadedPub, err := NewXPubV1FromBytes(xpub.Bytes())
+	if err != nil {
+		t.Fatalf("NewXPubV1FromBytes: %v", err)
+	}
+	want, _ := root.Derive(7)
+	wantPub, _ := want.XPub()
+	if got, err := loadedPub.Derive(7); err != nil || !bytes.Equal(got.Bytes(), wantPub.Bytes()) {
+		t.Fatalf("reloaded XPubV1 child = %v, %v", got, err)
+	}
+	if _, err := NewXPubV1FromBytes(raw); !errors.Is(err, ErrInvalidXPub) {
+		t.Fatalf("long V1 public key error = %v", err)
+	}
+
+	root.Wipe()
+	if !bytes.Equal(root.Bytes(), make([]byte, XPrvSize)) {
+		t.Fatal("Wipe did not clear the V1 key")
+	}
+}
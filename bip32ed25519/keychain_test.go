@@ -0,0 +1,84 @@
+package bip32ed25519
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func TestKeychainMatchesDerivePath(t *testing.T) {
+	root := testIcarusRoot(t)
+	chain, err := NewKeychain(root, 4)
+	if err != nil {
+		t.Fatalf("NewKeychain: %v", err)
+	}
+
+	for _, path := range []string{"m/1852'/1815'/0'", "m/1852'/1815'/0'/0/0", "m/1852'/1815'/0'/0/1", "m", "m/1852'/1815'/0'/0/0"} {
+		want, err := root.DerivePath(path)
+		if err != nil {
+			t.Fatalf("DerivePath(%q): %v", path, err)
+		}
+		got, err := chain.DerivePath(path)
+		if err != nil {
+			t.Fatalf("Keychain.DerivePath(%q): %v", path, err)
+		}
+		if !bytes.Equal(got.Bytes(), want.Bytes()) || !reflect.DeepEqual(got.Path(), want.Path()) {
+			t.Fatalf("Keychain.DerivePath(%q) mismatch", path)
+		}
+		got.Wipe()
+	}
+	if s := chain.Stats(); s.Hits != 2 || s.Misses != 3 || s.Len != 4 || s.Evictions != 2 {
+		t.Fatalf("stats = %+v", s)
+	}
+
+	if _, err := NewKeychain(nil, 1); !errors.Is(err, ErrNilKey) {
+		t.Fatalf("nil root error = %v", err)
+	}
+	if _, err := NewKeychain(root, 0); !errors.Is(err, ErrInvalidCapacity) {
+		t.Fatalf("zero capacity error = %v", err)
+	}
+}
+
+func TestKeychainConcurrentUse(t *testing.T) {
+	root := testIcarusRoot(t)
+	chain, err := NewKeychain(root, 2)
+	if err != nil {
+		t.Fatalf("NewKeychain: %v", err)
+	}
+	paths := []string{"m/1852'/1815'/0'", "m/1852'/1815'/0'/0/0", "m/1852'/1815'/1'/2/0"}
+	want := make(map[string][]byte)
+	for _, path := range paths {
+		key, err := root.DerivePath(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want[path] = key.Bytes()
+	}
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 4)
+	for worker := range 4 {
+		wg.Go(func() {
+			for i := range 20 {
+				path := paths[(worker+i)%len(paths)]
+				key, err := chain.DerivePath(path)
+				if err != nil {
+					errs <- err
+					return
+				}
+				if !bytes.Equal(key.Bytes(), want[path]) {
+					errs <- errors.New("mismatch at " + path)
+					return
+				}
+				key.Wipe()
+			}
+		})
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Fatal(err)
+	}
+}
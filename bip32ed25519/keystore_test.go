@@ -0,0 +1,119 @@
+package bip32ed25519
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestKeystoreRoundTrip(t *testing.T) {
+	root := testIcarusRoot(t)
+	created := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	password := []byte("correct horse battery staple")
+	wantFingerprint, err := root.fingerprint()
+	if err != nil {
+		t.Fatalf("fingerprint: %v", err)
+	}
+
+	sealed, err := root.SealKeystore(password, &KeystoreOptions{Variant: RootIcarus, Cipher: KeystoreXChaCha20Poly1305, Created: created})
+	if err != nil {
+		t.Fatalf("SealKeystore: %v", err)
+	}
+	var doc map[string]any
+	if err := json.Unmarshal(sealed, &doc); err != nil {
+		t.Fatalf("keystore is not JSON: %v", err)
+	}
+	if meta := doc["metadata"].(map[string]any); meta["curve"] != "ed25519-bip32" || meta["variant"] != "icarus" {
+		t.Fatalf("metadata = %v", meta)
+	}
+
+	key, meta, err := OpenKeystore(sealed, password)
+	if err != nil {
+		t.Fatalf("OpenKeystore: %v", err)
+	}
+	if !bytes.Equal(key.Bytes(), root.Bytes()) || key.RootVariant() != RootIcarus {
+		t.Fatal("OpenKeystore returned a different key")
+	}
+	if meta.Variant != RootIcarus || meta.Fingerprint != wantFingerprint || !meta.Created.Equal(created) {
+		t.Fatalf("metadata = %+v", meta)
+	}
+
+	changed, err := ChangeKeystorePassword(sealed, password, []byte("new password"))
+	if err != nil {
+		t.Fatalf("ChangeKeystorePassword: %v", err)
+	}
+	if _, _, err := OpenKeystore(changed, password); !errors.Is(err, ErrDecryption) {
+		t.Fatalf("old password error = %v", err)
+	}
+	key, meta, err = OpenKeystore(changed, []byte("new password"))
+	if err != nil || !bytes.Equal(key.Bytes(), root.Bytes()) || meta.Variant != RootIcarus {
+		t.Fatalf("OpenKeystore after password change = %+v, %v", meta, err)
+	}
+
+	doc["metadata"].(map[string]any)["variant"] = "khovratovich"
+	edited, _ := json.Marshal(doc)
+	if _, _, err := OpenKeystore(edited, password); !errors.Is(err, ErrDecryption) {
+		t.Fatalf("edited variant error = %v", err)
+	}
+	doc["metadata"].(map[string]any)["curve"] = "secp256k1"
+	edited, _ = json.Marshal(doc)
+	if _, _, err := OpenKeystore(edited, password); !errors.Is(err, ErrInvalidKeystore) {
+		t.Fatalf("other curve error = %v", err)
+	}
+}
+
+func TestKeystoreRejectsInvalidOptions(t *testing.T) {
+	root := testIcarusRoot(t)
+	for _, opts := range []*KeystoreOptions{{KDF: 9}, {Cipher: 9}, {Variant: 9}} {
+		if _, err := root.SealKeystore(nil, opts); !errors.Is(err, ErrUnsupportedKeystore) {
+			t.Fatalf("SealKeystore(%+v) error = %v", opts, err)
+		}
+	}
+	if _, err := root.SealKeystore(nil, &KeystoreOptions{Variant: RootLedger}); !errors.Is(err, ErrRootVariantMismatch) {
+		t.Fatalf("SealKeystore with another root variant error = %v", err)
+	}
+	imported, err := NewXPrvFromBytes(root.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := imported.SealKeystore(nil, &KeystoreOptions{Variant: RootByron}); !errors.Is(err, ErrRootVariantMismatch) {
+		t.Fatalf("SealKeystore as a Byron key error = %v", err)
+	}
+	var nilKey *XPrv
+	if _, err := nilKey.SealKeystore(nil, nil); !errors.Is(err, ErrNilKey) {
+		t.Fatalf("nil key error = %v", err)
+	}
+}
+
+func TestKeystoreRecordsRootVariant(t *testing.T) {
+	root := testIcarusRoot(t)
+	opts := &KeystoreOptions{KDF: KeystoreScrypt}
+	sealed, err := root.SealKeystore(nil, opts)
+	if err != nil {
+		t.Fatalf("SealKeystore: %v", err)
+	}
+	if _, meta, err := OpenKeystore(sealed, nil); err != nil || meta.Variant != RootIcarus {
+		t.Fatalf("key variant recorded as %+v, %v", meta, err)
+	}
+
+	// An imported key has no variant until the options supply one.
+	imported, err := NewXPrvFromBytes(root.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sealed, err = imported.SealKeystore(nil, opts); err != nil {
+		t.Fatalf("SealKeystore: %v", err)
+	}
+	if key, meta, err := OpenKeystore(sealed, nil); err != nil || meta.Variant != 0 || key.RootVariant() != 0 {
+		t.Fatalf("imported key recorded as %+v, %v", meta, err)
+	}
+	opts.Variant = RootLedger
+	if sealed, err = imported.SealKeystore(nil, opts); err != nil {
+		t.Fatalf("SealKeystore: %v", err)
+	}
+	if key, meta, err := OpenKeystore(sealed, nil); err != nil || meta.Variant != RootLedger || key.RootVariant() != RootLedger {
+		t.Fatalf("option variant recorded as %+v, %v", meta, err)
+	}
+}
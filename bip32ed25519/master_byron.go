@@ -0,0 +1,60 @@
+package bip32ed25519
+
+import (
+	"crypto/sha512"
+	"strconv"
+
+	"github.com/islishude/bip32/v2/internal/bip39"
+	"golang.org/x/crypto/blake2b"
+)
+
+// byronMaxIterations bounds the root search as the original implementation
+// does. Each attempt succeeds with probability 1/2.
+const byronMaxIterations = 1000
+
+// NewMasterKeyByron creates the root XPrvV1 of a legacy Daedalus "random"
+// wallet, whose addresses use two hardened levels such as m/0'/1'.
+//
+// The seed is the CBOR byte string of BLAKE2b-256 over the CBOR byte string of
+// the mnemonic entropy. For n = 1, 2, ..., HMAC-SHA512 keyed by the seed over
+// "Root Seed Chain n" gives IL || IR; the first n for which SHA-512(IL) has
+// bit 5 of byte 31 clear yields kL || kR = SHA-512(IL), tweaked as for
+// Icarus, and chain code IR. Daedalus spending passwords only encrypted the
+// stored key, so there is no passphrase input.
+func NewMasterKeyByron(mnemonic string) (*XPrvV1, error) {
+	entropy, err := bip39.Entropy(mnemonic)
+	if err != nil {
+		return nil, mnemonicError(err)
+	}
+	defer clear(entropy)
+
+	inner := appendCBORBytes(nil, entropy)
+	defer clear(inner)
+	digest := blake2b.Sum256(inner)
+	defer clear(digest[:])
+	seed := appendCBORBytes(nil, digest[:])
+	defer clear(seed)
+
+	for n := 1; n <= byronMaxIterations; n++ {
+		i := hmacSHA512(seed, []byte("Root Seed Chain "+strconv.Itoa(n)))
+		ext := sha512.Sum512(i[0:32])
+		if ext[31]&0b00100000 != 0 {
+			clear(i[:])
+			clear(ext[:])
+			continue
+		}
+		tweakRootBits(ext[0:32])
+
+		out := XPrv{variant: RootByron, scheme: schemeV1, cache: newKeyCache()}
+		copy(out.kL[:], ext[0:32])
+		copy(out.kR[:], ext[32:64])
+		copy(out.cc[:], i[32:64])
+		clear(i[:])
+		clear(ext[:])
+		if out.isZeroScalar() {
+			return nil, ErrInvalidXPrv
+		}
+		return &XPrvV1{k: &out}, nil
+	}
+	return nil, ErrRejectedMasterSecret
+}
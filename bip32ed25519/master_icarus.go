@@ -20,7 +20,7 @@ func NewMasterKeyIcarus(seed, password []byte) (*XPrv, error) {
 	data := pbkdf2.Key(password, seed, 4096, XPrvSize, sha512.New)
 	tweakRootBits(data[0:32])
 
-	var out XPrv
+	out := XPrv{variant: RootIcarus, cache: newKeyCache()}
 	copy(out.kL[:], data[0:32])
 	copy(out.kR[:], data[32:64])
 	copy(out.cc[:], data[64:96])
@@ -0,0 +1,60 @@
+package bip32ed25519
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"fmt"
+	"strings"
+
+	"github.com/islishude/bip32/v2/internal/bip39"
+)
+
+// ledgerHMACKey is the SLIP-0010 Ed25519 key that the Ledger root algorithm
+// reuses.
+var ledgerHMACKey = []byte("ed25519 seed")
+
+// NewMasterKeyLedger creates a root XPrv using the CIP-0003 Ledger algorithm,
+// which Cardano apps on Ledger hardware wallets use.
+//
+// Unlike Icarus, it starts from the 64-byte BIP-39 seed of the English
+// mnemonic and optional passphrase, and hashes it with HMAC-SHA512 under
+// "ed25519 seed" until the third-highest bit of kL is clear. The same
+// mnemonic therefore gives unrelated keys here and in NewMasterKeyIcarus.
+// Passphrases must be ASCII, since NFKD normalization is not implemented.
+func NewMasterKeyLedger(mnemonic string, passphrase []byte) (*XPrv, error) {
+	seed, err := bip39.Seed(mnemonic, passphrase)
+	if err != nil {
+		return nil, mnemonicError(err)
+	}
+	defer clear(seed)
+
+	// cc = HMAC-SHA256("ed25519 seed", 0x01 || seed).
+	mac := hmac.New(sha256.New, ledgerHMACKey)
+	_, _ = mac.Write([]byte{0x01})
+	_, _ = mac.Write(seed)
+	cc := mac.Sum(nil)
+	defer clear(cc)
+
+	// Rehash until bit 5 of kL[31] is clear, as NewMasterKeyRawKhovratovich
+	// requires but by iteration rather than rejection.
+	i := hmacSHA512(ledgerHMACKey, seed)
+	for i[31]&0b00100000 != 0 {
+		i = hmacSHA512(ledgerHMACKey, i[:])
+	}
+	defer clear(i[:])
+	tweakRootBits(i[0:32])
+
+	out := XPrv{variant: RootLedger, cache: newKeyCache()}
+	copy(out.kL[:], i[0:32])
+	copy(out.kR[:], i[32:64])
+	copy(out.cc[:], cc)
+	if out.isZeroScalar() {
+		return nil, ErrInvalidXPrv
+	}
+	return &out, nil
+}
+
+// mnemonicError reports a bip39 failure under ErrInvalidMnemonic.
+func mnemonicError(err error) error {
+	return fmt.Errorf("%w: %s", ErrInvalidMnemonic, strings.TrimPrefix(err.Error(), "bip39: "))
+}
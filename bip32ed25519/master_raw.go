@@ -28,7 +28,7 @@ func NewMasterKeyRawKhovratovich(secret32 []byte) (*XPrv, error) {
 	chainInput = append(chainInput, secret32...)
 	cc := sha256.Sum256(chainInput)
 
-	var out XPrv
+	out := XPrv{variant: RootKhovratovich, cache: newKeyCache()}
 	copy(out.kL[:], secret[0:32])
 	copy(out.kR[:], secret[32:64])
 	copy(out.cc[:], cc[:])
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/hex"
 	"errors"
+	"strings"
 	"testing"
 )
 
@@ -11,6 +12,16 @@ const (
 	icarusVectorEntropy = "46e62370a138a182a498b8e2885bc032379ddf38"
 	icarusMasterNoPass  = "c065afd2832cd8b087c4d9ab7011f481ee1e0721e78ea5dd609f3ab3f156d245d176bd8fd4ec60b4731c3918a2a72a0226c0cd119ec35b47e4d55884667f552a23f7fdcd4a10c6cd2c7393ac61d877873e248f417634aa3d812af327ffe9d620"
 	icarusMasterFooPass = "70531039904019351e1afb361cd1b312a4d0565d4ff9f8062d38acf4b15cce41d7b5738d9c893feea55512a3004acb0d222c35d3e3d5cde943a15a9824cbac59443cf67e589614076ba01e354b1a432e0e6db3b59e37fc56b5fb0222970a010e"
+
+	// Byron root and its m/0'/1' child. These are not upstream vectors: no
+	// rust-cardano, cardano-crypto, or cardano-addresses V1 test data was
+	// available, so they come from a separate Python transcription of
+	// cardano-sl's Byron key generation and cardano-crypto's DERIVATION_V1
+	// (cbits/encrypted_sign.c). They guard against regressions, not against
+	// a shared misreading of those sources; replace them with upstream data.
+	byronVectorMnemonic = "roast crime bounce convince core happy pitch safe brush exit basic among"
+	byronVectorRoot     = "60f6e2b12f4c51ed2a42163935fd95a6c39126e88571fe5ffd0332a4924e5e5e9ceda72e3e526a625ea86d16151957d45747fff0f8fcd00e394b132155dfdfc2918019cda35f1df96dd5a798da4c40a2f382358496e6468e4e276db5ec35235f"
+	byronVectorChild    = "e8c7ce0ac3325c1d8655edda9c47f7b91a835f711e920f4906c5b2ec1b10f70fdfc4e97565a49f9a117974de373fe6b6ca93628d275f089170ca2f2bbbfa8a844fd543b338943010f54335c323bc8439f53bd3fea66b3ef2482f62bb38d1f364"
 )
 
 func mustDecodeHex(t *testing.T, raw string) []byte {
@@ -56,6 +67,94 @@ func TestNewMasterKeyIcarusCIP3Vectors(t *testing.T) {
 	}
 }
 
+func TestNewMasterKeyLedgerCIP3Vectors(t *testing.T) {
+	tests := []struct {
+		name       string
+		mnemonic   string
+		passphrase []byte
+		wantHex    string
+	}{
+		{
+			name:     "24 words",
+			mnemonic: "recall grace sport punch exhibit mad harbor stand obey short width stem awkward used stairs wool ugly trap season stove worth toward congress jaguar",
+			wantHex:  "a08cf85b564ecf3b947d8d4321fb96d70ee7bb760877e371899b14e2ccf88658104b884682b57efd97decbb318a45c05a527b9cc5c2f64f7352935a049ceea60680d52308194ccef2a18e6812b452a5815fbd7f5babc083856919aaf668fe7e4",
+		},
+		{
+			// Bit 5 of kL[31] is set in the first three HMAC outputs.
+			name:     "iterated",
+			mnemonic: "correct cherry mammal bubble want mandate polar hazard crater better craft exotic choice fun tourist census gap lottery neglect address glow carry old business",
+			wantHex:  "587c6774357ecbf840d4db6404ff7af016dace0400769751ad2abfc77b9a3844cc71702520ef1a4d1b68b91187787a9b8faab0a9bb6b160de541b6ee62469901fc0beda0975fe4763beabd83b7051a5fd5cbce5b88e82c4bbaca265014e524bd",
+		},
+		{
+			name:       "with passphrase",
+			mnemonic:   strings.Repeat("abandon ", 23) + "art",
+			passphrase: []byte("foo"),
+			wantHex:    "f053a1e752de5c26197b60f032a4809f08bb3e5d90484fe42024be31efcba7578d914d3ff992e21652fee6a4d99f6091006938fac2c0c0f9d2de0ba64b754e92a4f3723f23472077aa4cd4dd8a8a175dba07ea1852dad1cf268c61a2679c3890",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root, err := NewMasterKeyLedger(tt.mnemonic, tt.passphrase)
+			if err != nil {
+				t.Fatalf("NewMasterKeyLedger: %v", err)
+			}
+			if got, want := root.Bytes(), mustDecodeHex(t, tt.wantHex); !bytes.Equal(got, want) {
+				t.Fatalf("master key = %x, want %x", got, want)
+			}
+			if root.RootVariant() != RootLedger {
+				t.Fatalf("root variant = %v", root.RootVariant())
+			}
+		})
+	}
+
+	for _, mnemonic := range []string{"", strings.Repeat("abandon ", 24)} {
+		if _, err := NewMasterKeyLedger(mnemonic, nil); !errors.Is(err, ErrInvalidMnemonic) {
+			t.Fatalf("NewMasterKeyLedger(%q) error = %v, want %v", mnemonic, err, ErrInvalidMnemonic)
+		}
+	}
+	if _, err := NewMasterKeyLedger(tests[0].mnemonic, []byte("café")); !errors.Is(err, ErrInvalidMnemonic) {
+		t.Fatalf("non-ASCII passphrase error = %v", err)
+	}
+}
+
+func TestRootVariantFollowsDerivation(t *testing.T) {
+	root := testIcarusRoot(t)
+	child, err := root.DerivePath("m/1852'/1815'/0'/0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	xpub, err := child.XPub()
+	if err != nil {
+		t.Fatal(err)
+	}
+	grandchild, err := xpub.Derive(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, got := range map[string]RootVariant{
+		"root":       root.RootVariant(),
+		"child":      child.RootVariant(),
+		"xpub":       xpub.RootVariant(),
+		"grandchild": grandchild.RootVariant(),
+	} {
+		if got != RootIcarus {
+			t.Fatalf("%s variant = %v, want %v", name, got, RootIcarus)
+		}
+	}
+
+	imported, err := NewXPrvFromBytes(root.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if imported.RootVariant() != 0 {
+		t.Fatalf("imported variant = %v", imported.RootVariant())
+	}
+	if RootLedger.String() != "ledger" || RootVariant(9).String() != "RootVariant(9)" {
+		t.Fatalf("String = %q, %q", RootLedger, RootVariant(9))
+	}
+}
+
 func TestNewMasterKeyIcarusInvalidSeed(t *testing.T) {
 	if _, err := NewMasterKeyIcarus(nil, nil); !errors.Is(err, ErrInvalidSeed) {
 		t.Fatalf("NewMasterKeyIcarus(nil) error = %v, want %v", err, ErrInvalidSeed)
@@ -107,3 +206,155 @@ func TestGenerateMasterKeyRawKhovratovichReturnsReaderError(t *testing.T) {
 		t.Fatal("GenerateMasterKeyRawKhovratovich: expected reader exhaustion error")
 	}
 }
+
+func TestNewMasterKeyTrezor(t *testing.T) {
+	// 24 words: Trezor feeds the 32 entropy bytes and the checksum byte 0x66
+	// to the Icarus algorithm. No trezor-firmware vector for this case was
+	// available, so the root was computed directly from the CIP-3 description
+	// with Python's hashlib: PBKDF2-HMAC-SHA512 of "foo" salted with 32 zero
+	// bytes and 0x66, 4096 iterations, with the Icarus bit tweaks.
+	mnemonic := strings.Repeat("abandon ", 23) + "art"
+	trezor, err := NewMasterKeyTrezor(mnemonic, []byte("foo"))
+	if err != nil {
+		t.Fatalf("NewMasterKeyTrezor: %v", err)
+	}
+	const want = "084e15b71c8567ddba4dcd7a4e21f47f693e388b54356e2c5040b3591fd5a45f3f89cc9becf28e098796b91be936ec2c60c22e735c15c0e2d1000d0401cb515a35186594dbb7a31acf41b08a706be4f82f42e32ed7d028364e9273b0d5ee536f"
+	if got := hex.EncodeToString(trezor.Bytes()); got != want {
+		t.Fatalf("24-word Trezor root = %s, want %s", got, want)
+	}
+	if trezor.RootVariant() != RootTrezor {
+		t.Fatalf("root variant = %v", trezor.RootVariant())
+	}
+
+	// The CIP-3 Icarus vector's mnemonic has 15 words, so Trezor agrees with
+	// Icarus.
+	trezor, err = NewMasterKeyTrezor("eight country switch draw meat scout mystery blade tip drift useless good keep usage title", nil)
+	if err != nil {
+		t.Fatalf("NewMasterKeyTrezor: %v", err)
+	}
+	if got := trezor.Bytes(); !bytes.Equal(got, mustDecodeHex(t, icarusMasterNoPass)) {
+		t.Fatalf("15-word Trezor root = %x", got)
+	}
+
+	if _, err := NewMasterKeyTrezor(strings.Repeat("abandon ", 24), nil); !errors.Is(err, ErrInvalidMnemonic) {
+		t.Fatalf("bad checksum error = %v", err)
+	}
+}
+
+func TestDetectRootVariant(t *testing.T) {
+	const path = "m/1852'/1815'/0'"
+	mnemonic := strings.Repeat("abandon ", 23) + "art"
+	for _, newRoot := range []func(string, []byte) (*XPrv, error){
+		func(_ string, p []byte) (*XPrv, error) {
+			// The mnemonic's entropy is 32 zero bytes.
+			return NewMasterKeyIcarus(make([]byte, 32), p)
+		},
+		NewMasterKeyLedger,
+		NewMasterKeyTrezor,
+	} {
+		root, err := newRoot(mnemonic, []byte("foo"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		account, err := root.DerivePath(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want, err := account.XPub()
+		if err != nil {
+			t.Fatal(err)
+		}
+		found, err := DetectRootVariant(mnemonic, []byte("foo"), MatchXPub(path, want))
+		if err != nil {
+			t.Fatalf("DetectRootVariant(%v): %v", root.RootVariant(), err)
+		}
+		if found.RootVariant() != root.RootVariant() || !bytes.Equal(found.Bytes(), root.Bytes()) {
+			t.Fatalf("DetectRootVariant = %v, want %v", found.RootVariant(), root.RootVariant())
+		}
+	}
+
+	other, err := testIcarusRoot(t).DerivePath(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	xpub, err := other.XPub()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := DetectRootVariant(mnemonic, []byte("foo"), MatchXPub(path, xpub)); !errors.Is(err, ErrNoRootVariant) {
+		t.Fatalf("unrelated xpub error = %v, want %v", err, ErrNoRootVariant)
+	}
+	if _, err := DetectRootVariant("abandon", nil, MatchXPub(path, xpub)); !errors.Is(err, ErrInvalidMnemonic) {
+		t.Fatalf("invalid mnemonic error = %v", err)
+	}
+}
+
+func TestMatchAddress(t *testing.T) {
+	// The cardano-serialization-lib wallet of the Byron address tests, whose
+	// base address at m/1852'/1815'/0'/0/0 and m/1852'/1815'/0'/2/0 is from
+	// that library's bip32_15_base test.
+	const mnemonic = "art forum devote street sure rather head chuckle guard poverty release quote oak craft enemy"
+	for _, tc := range []struct {
+		path, address string
+	}{
+		{"m/1852'/1815'/0'/0/0", "addr_test1qpu5vlrf4xkxv2qpwngf6cjhtw542ayty80v8dyr49rf5ewvxwdrt70qlcpeeagscasafhffqsxy36t90ldv06wqrk2qum8x5w"},
+		{"m/44'/1815'/0'/0/0", "Ae2tdPwUPEZHtBmjZBF4YpMkK9tMSPTE2ADEZTPN97saNkhG78TvXdp3GDk"},
+	} {
+		match, err := MatchAddress(tc.path, tc.address)
+		if err != nil {
+			t.Fatalf("MatchAddress(%s): %v", tc.address, err)
+		}
+		root, err := DetectRootVariant(mnemonic, nil, match)
+		if err != nil || root.RootVariant() != RootIcarus {
+			t.Fatalf("DetectRootVariant(%s) = %v, %v", tc.address, root.RootVariant(), err)
+		}
+		if match, _ := MatchAddress("m/1852'/1815'/0'/0/1", tc.address); match(root) {
+			t.Fatalf("%s matched the wrong path", tc.address)
+		}
+	}
+
+	// A 24-word Trezor wallet is told apart by its reward address.
+	trezorMnemonic := strings.Repeat("abandon ", 23) + "art"
+	trezor, err := NewMasterKeyTrezor(trezorMnemonic, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stake, err := trezor.DerivePath("m/1852'/1815'/0'/2/0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	stakePub, _ := stake.XPub()
+	reward, _ := NewRewardAddress(Mainnet, stakePub)
+	text, _ := reward.Encode()
+	match, err := MatchAddress("m/1852'/1815'/0'/2/0", text)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if root, err := DetectRootVariant(trezorMnemonic, nil, match); err != nil || root.RootVariant() != RootTrezor {
+		t.Fatalf("DetectRootVariant(reward) = %v, %v", root.RootVariant(), err)
+	}
+
+	if _, err := MatchAddress("m/0", "addr1qqqq"); !errors.Is(err, ErrInvalidAddress) {
+		t.Fatalf("invalid address error = %v", err)
+	}
+}
+
+func TestNewMasterKeyByron(t *testing.T) {
+	root, err := NewMasterKeyByron(byronVectorMnemonic)
+	if err != nil {
+		t.Fatalf("NewMasterKeyByron: %v", err)
+	}
+	if got := hex.EncodeToString(root.Bytes()); got != byronVectorRoot {
+		t.Fatalf("Byron root = %s, want %s", got, byronVectorRoot)
+	}
+	if root.RootVariant() != RootByron {
+		t.Fatalf("Byron root variant %v", root.RootVariant())
+	}
+	if kL := root.k.kL; !validExpandedScalarBits(kL) || kL[31]&0b00100000 != 0 {
+		t.Fatalf("Byron root kL bits = %x", kL)
+	}
+
+	if _, err := NewMasterKeyByron("roast crime bounce"); !errors.Is(err, ErrInvalidMnemonic) {
+		t.Fatalf("invalid mnemonic error = %v", err)
+	}
+}
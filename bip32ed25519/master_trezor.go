@@ -0,0 +1,117 @@
+package bip32ed25519
+
+import (
+	"strings"
+
+	"github.com/islishude/bip32/v2/internal/bip39"
+)
+
+// NewMasterKeyTrezor creates a root XPrv as Cardano apps on Trezor hardware
+// wallets do, following CIP-0003.
+//
+// Trezor uses the Icarus algorithm on the mnemonic's entropy, except for 24
+// words, where its firmware takes the entropy together with the checksum
+// byte: 33 bytes instead of 32. For other lengths the key equals the Icarus
+// root of the same mnemonic, though it reports RootTrezor.
+func NewMasterKeyTrezor(mnemonic string, passphrase []byte) (*XPrv, error) {
+	decode := bip39.Entropy
+	if len(strings.Fields(mnemonic)) == 24 {
+		decode = bip39.EntropyWithChecksum
+	}
+	entropy, err := decode(mnemonic)
+	if err != nil {
+		return nil, mnemonicError(err)
+	}
+	defer clear(entropy)
+	root, err := NewMasterKeyIcarus(entropy, passphrase)
+	if err != nil {
+		return nil, err
+	}
+	root.variant = RootTrezor
+	return root, nil
+}
+
+// DetectRootVariant finds which CIP-0003 root algorithm a wallet used. It
+// derives the Icarus, Ledger, and Trezor roots of mnemonic and passphrase, in
+// that order, and returns the first for which match reports true, wiping the
+// others. Its RootVariant names the algorithm. Below 24 words the Trezor root
+// equals the Icarus root and is not tried again, so Icarus is reported.
+// DetectRootVariant returns ErrNoRootVariant if none matches.
+//
+// MatchXPub builds a match function from a known extended public key, and
+// MatchAddress from a known address.
+func DetectRootVariant(mnemonic string, passphrase []byte, match func(root *XPrv) bool) (*XPrv, error) {
+	entropy, err := bip39.Entropy(mnemonic)
+	if err != nil {
+		return nil, mnemonicError(err)
+	}
+	defer clear(entropy)
+
+	candidates := []func() (*XPrv, error){
+		func() (*XPrv, error) { return NewMasterKeyIcarus(entropy, passphrase) },
+		func() (*XPrv, error) { return NewMasterKeyLedger(mnemonic, passphrase) },
+	}
+	if len(entropy) == 32 {
+		candidates = append(candidates, func() (*XPrv, error) { return NewMasterKeyTrezor(mnemonic, passphrase) })
+	}
+	for _, candidate := range candidates {
+		root, err := candidate()
+		if err != nil {
+			return nil, err
+		}
+		if match(root) {
+			return root, nil
+		}
+		root.Wipe()
+	}
+	return nil, ErrNoRootVariant
+}
+
+// MatchXPub returns a DetectRootVariant match function that derives path,
+// such as m/1852'/1815'/0', from each root and compares the result with want.
+func MatchXPub(path string, want *XPub) func(root *XPrv) bool {
+	return func(root *XPrv) bool {
+		if want == nil {
+			return false
+		}
+		derived, err := root.DerivePath(path)
+		if err != nil {
+			return false
+		}
+		defer derived.Wipe()
+		xpub, err := derived.XPub()
+		return err == nil && xpub.pub == want.pub && xpub.cc == want.cc
+	}
+}
+
+// MatchAddress returns a DetectRootVariant match function that derives the key
+// at path, such as m/1852'/1815'/0'/0/0, from each root and reports whether
+// address pays to it. address is a bech32 Shelley address, matched on its
+// payment credential or, for reward addresses, its stake credential, or a
+// Base58 Byron address. An unparsable address returns ErrInvalidAddress.
+func MatchAddress(path, address string) (func(root *XPrv) bool, error) {
+	var matches func(key *XPub) bool
+	if shelley, err := ParseAddress(address); err == nil {
+		credential := shelley.Payment
+		if shelley.Type == RewardAddress {
+			credential = shelley.Stake
+		}
+		matches = func(key *XPub) bool {
+			hash, err := key.KeyHash()
+			return err == nil && !credential.Script && hash == credential.Hash
+		}
+	} else if byron, byronErr := ParseByronAddress(address); byronErr == nil {
+		matches = byron.MatchesKey
+	} else {
+		return nil, err
+	}
+	return func(root *XPrv) bool {
+		derived, err := root.DerivePath(path)
+		if err != nil {
+			return false
+		}
+		defer derived.Wipe()
+		xpub, err := derived.XPub()
+		return err == nil && matches(xpub)
+	}, nil
+}
@@ -0,0 +1,110 @@
+package bip32ed25519
+
+import (
+	"cmp"
+	"crypto/subtle"
+	"slices"
+)
+
+// RecoverParent recovers the parent XPrv from the parent XPub and the private
+// key of its soft child at index.
+//
+// Soft derivation adds 8 * ZL to kL and ZR to kR, where Z depends only on the
+// parent public key and chain code, so anyone holding both keys can subtract Z
+// back out. The index must be supplied because the 96-byte binary form does
+// not carry it. RecoverParent returns ErrHardenedChild for a hardened index,
+// ErrNotChild when child is not the soft child of parent at index, and
+// ErrRootVariantMismatch when the keys report different root variants. A key
+// without a variant, such as one imported from bytes, takes the other's.
+func RecoverParent(parent *XPub, child *XPrv, index uint32) (*XPrv, error) {
+	if parent == nil || child == nil {
+		return nil, ErrNilKey
+	}
+	if IsHardened(index) {
+		return nil, ErrHardenedChild
+	}
+	if child.variant != 0 && parent.variant != 0 && child.variant != parent.variant {
+		return nil, ErrRootVariantMismatch
+	}
+
+	mac := parent.cache.resolveMAC(&parent.cc)
+	indexLE := ser32LE(index)
+	var data [1 + 32 + 4]byte
+	data[0] = 0x03 // I domain: soft child chain code.
+	copy(data[1:33], parent.pub[:])
+	copy(data[33:], indexLE[:])
+	i := mac.sum(data[:])
+	if subtle.ConstantTimeCompare(i[32:64], child.cc[:]) != 1 {
+		return nil, ErrNotChild
+	}
+
+	data[0] = 0x02 // Z domain: soft public-compatible derivation.
+	z := mac.sum(data[:])
+	defer clear(z[:])
+
+	recovered := &XPrv{
+		kL:          child.kL,
+		kR:          child.kR,
+		cc:          parent.cc,
+		depth:       parent.depth,
+		childNumber: parent.childNumber,
+		variant:     cmp.Or(child.variant, parent.variant),
+		cache:       newKeyCache(),
+	}
+	// kL addition is plain integer addition, so a borrow means no parent kL
+	// could have produced this child.
+	if borrow := sub28Mul8LE(&recovered.kL, z[0:28]); borrow {
+		recovered.Wipe()
+		return nil, ErrNotChild
+	}
+	subMod256LE(&recovered.kR, z[32:64])
+	if n := len(child.path); n > 0 && child.path[n-1] == index {
+		recovered.path = slices.Clone(child.path[:n-1])
+	}
+
+	if pub, _ := recovered.PublicKey(); pub != parent.pub || recovered.isZeroScalar() {
+		recovered.Wipe()
+		return nil, ErrNotChild
+	}
+	return recovered, nil
+}
+
+// ExposesParent reports whether child is the soft child of parent at index, in
+// which case holding both discloses the parent private key through
+// RecoverParent.
+func ExposesParent(parent *XPub, child *XPrv, index uint32) bool {
+	recovered, err := RecoverParent(parent, child, index)
+	if err != nil {
+		return false
+	}
+	recovered.Wipe()
+	return true
+}
+
+// ParentExposure identifies an XPub and an XPrv that together disclose the
+// parent private key. The fields index the slices passed to
+// FindParentExposures.
+type ParentExposure struct {
+	Parent int
+	Child  int
+}
+
+// FindParentExposures scans a key store for pairs of a parent XPub and a soft
+// child XPrv from which RecoverParent would succeed. Each XPrv is checked at
+// its ChildNumber, so keys imported from bytes, which have no derivation
+// metadata, are only checked at index 0. Nil entries are skipped. Exposures
+// are returned in child order.
+func FindParentExposures(xpubs []*XPub, xprvs []*XPrv) []ParentExposure {
+	var exposures []ParentExposure
+	for c, xprv := range xprvs {
+		if xprv == nil || IsHardened(xprv.childNumber) {
+			continue
+		}
+		for p, xpub := range xpubs {
+			if xpub != nil && ExposesParent(xpub, xprv, xprv.childNumber) {
+				exposures = append(exposures, ParentExposure{Parent: p, Child: c})
+			}
+		}
+	}
+	return exposures
+}
@@ -0,0 +1,130 @@
+package bip32ed25519
+
+import (
+	"bytes"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/islishude/bip32/v2/internal/bip39"
+)
+
+func TestRecoverParent(t *testing.T) {
+	root := testIcarusRoot(t)
+	account, err := root.DerivePath("m/1852'/1815'/0'")
+	if err != nil {
+		t.Fatalf("DerivePath: %v", err)
+	}
+	accountPub, err := account.XPub()
+	if err != nil {
+		t.Fatalf("XPub: %v", err)
+	}
+	child, err := account.Derive(3)
+	if err != nil {
+		t.Fatalf("Derive: %v", err)
+	}
+
+	recovered, err := RecoverParent(accountPub, child, 3)
+	if err != nil {
+		t.Fatalf("RecoverParent: %v", err)
+	}
+	if !bytes.Equal(recovered.Bytes(), account.Bytes()) {
+		t.Fatal("recovered parent differs from the account key")
+	}
+	if !slices.Equal(recovered.Path(), account.Path()) {
+		t.Fatalf("recovered path = %v, want %v", recovered.Path(), account.Path())
+	}
+
+	// Binary imports drop metadata, so recovery relies on the explicit index.
+	imported, err := NewXPrvFromBytes(child.Bytes())
+	if err != nil {
+		t.Fatalf("NewXPrvFromBytes: %v", err)
+	}
+	if _, err := RecoverParent(accountPub, imported, 3); err != nil {
+		t.Fatalf("RecoverParent from imported child: %v", err)
+	}
+	if _, err := RecoverParent(accountPub, imported, 4); !errors.Is(err, ErrNotChild) {
+		t.Fatalf("wrong index error = %v", err)
+	}
+	if _, err := RecoverParent(accountPub, child, HardenedOffset+3); !errors.Is(err, ErrHardenedChild) {
+		t.Fatalf("hardened index error = %v", err)
+	}
+	if _, err := RecoverParent(accountPub, nil, 3); !errors.Is(err, ErrNilKey) {
+		t.Fatalf("nil child error = %v", err)
+	}
+}
+
+func TestRecoverParentVariantMismatch(t *testing.T) {
+	// Below 24 words the Trezor and Icarus roots are the same key, so only the
+	// reported variants tell the pair apart.
+	entropy, err := bip39.Entropy(byronVectorMnemonic)
+	if err != nil {
+		t.Fatalf("Entropy: %v", err)
+	}
+	icarus, err := NewMasterKeyIcarus(entropy, nil)
+	if err != nil {
+		t.Fatalf("NewMasterKeyIcarus: %v", err)
+	}
+	trezor, err := NewMasterKeyTrezor(byronVectorMnemonic, nil)
+	if err != nil {
+		t.Fatalf("NewMasterKeyTrezor: %v", err)
+	}
+	icarusPub, err := icarus.XPub()
+	if err != nil {
+		t.Fatalf("XPub: %v", err)
+	}
+	trezorChild, err := trezor.Derive(0)
+	if err != nil {
+		t.Fatalf("Derive: %v", err)
+	}
+	if _, err := RecoverParent(icarusPub, trezorChild, 0); !errors.Is(err, ErrRootVariantMismatch) {
+		t.Fatalf("mixed variant error = %v, want %v", err, ErrRootVariantMismatch)
+	}
+
+	// An imported child has no variant and takes the parent's.
+	imported, err := NewXPrvFromBytes(trezorChild.Bytes())
+	if err != nil {
+		t.Fatalf("NewXPrvFromBytes: %v", err)
+	}
+	trezorPub, err := trezor.XPub()
+	if err != nil {
+		t.Fatalf("XPub: %v", err)
+	}
+	recovered, err := RecoverParent(trezorPub, imported, 0)
+	if err != nil {
+		t.Fatalf("RecoverParent: %v", err)
+	}
+	if got := recovered.RootVariant(); got != RootTrezor {
+		t.Fatalf("recovered variant = %v, want %v", got, RootTrezor)
+	}
+}
+
+func TestFindParentExposures(t *testing.T) {
+	root := testIcarusRoot(t)
+	rootPub, err := root.XPub()
+	if err != nil {
+		t.Fatalf("XPub: %v", err)
+	}
+	account, err := root.DerivePath("m/1852'/1815'/0'")
+	if err != nil {
+		t.Fatalf("DerivePath: %v", err)
+	}
+	accountPub, err := account.XPub()
+	if err != nil {
+		t.Fatalf("XPub: %v", err)
+	}
+	leaked, err := account.Derive(5)
+	if err != nil {
+		t.Fatalf("Derive: %v", err)
+	}
+	safe, err := account.Derive(HardenedOffset + 5)
+	if err != nil {
+		t.Fatalf("Derive: %v", err)
+	}
+
+	got := FindParentExposures([]*XPub{rootPub, nil, accountPub}, []*XPrv{safe, account, nil, leaked})
+	want := []ParentExposure{{Parent: 2, Child: 3}}
+	if !slices.Equal(got, want) {
+		t.Fatalf("FindParentExposures = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,28 @@
+package bip32ed25519
+
+import "encoding/binary"
+
+// derivationScheme selects how a key derives its children. Keys carry their
+// scheme, set by the root algorithm and inherited by every descendant. Only
+// XPrvV1 and XPubV1 wrap keys of schemeV1.
+type derivationScheme uint8
+
+const (
+	// schemeV2 is the Khovratovich-Law scheme used by Icarus, Shelley,
+	// Ledger, and Trezor wallets. It is the zero value.
+	schemeV2 derivationScheme = iota
+	// schemeV1 is the legacy scheme of Byron-era Daedalus wallets; see
+	// XPrvV1.
+	schemeV1
+)
+
+// ser32 serializes a child index for the HMAC input: little-endian in V2
+// and big-endian in V1.
+func (s derivationScheme) ser32(i uint32) [4]byte {
+	if s == schemeV1 {
+		var out [4]byte
+		binary.BigEndian.PutUint32(out[:], i)
+		return out
+	}
+	return ser32LE(i)
+}
@@ -15,7 +15,12 @@ func (k *XPrv) Sign(message []byte) ([]byte, error) {
 	if k == nil {
 		return nil, ErrNilKey
 	}
+	return k.sign(nil, message)
+}
 
+// sign signs message with the RFC 8032 dom2 prefix dom hashed ahead of both
+// hash inputs, as Ed25519ctx and Ed25519ph require. A nil dom is pure Ed25519.
+func (k *XPrv) sign(dom, message []byte) ([]byte, error) {
 	A, err := k.PublicKey()
 	if err != nil {
 		return nil, err
@@ -27,7 +32,8 @@ func (k *XPrv) Sign(message []byte) ([]byte, error) {
 	}
 
 	rh := sha512.New()
-	_, _ = rh.Write(k.kR[:]) // r = H(kR || message) mod L.
+	_, _ = rh.Write(dom)
+	_, _ = rh.Write(k.kR[:]) // r = H(dom || kR || message) mod L.
 	_, _ = rh.Write(message)
 
 	var rDigest [64]byte
@@ -42,7 +48,8 @@ func (k *XPrv) Sign(message []byte) ([]byte, error) {
 	RBytes := R.Bytes()
 
 	hh := sha512.New()
-	_, _ = hh.Write(RBytes) // h = H(R || A || message) mod L.
+	_, _ = hh.Write(dom)
+	_, _ = hh.Write(RBytes) // h = H(dom || R || A || message) mod L.
 	_, _ = hh.Write(A[:])
 	_, _ = hh.Write(message)
 
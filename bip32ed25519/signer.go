@@ -0,0 +1,97 @@
+package bip32ed25519
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"io"
+)
+
+// dom2Prefix starts the RFC 8032 dom2 string of Ed25519ctx and Ed25519ph.
+const dom2Prefix = "SigEd25519 no Ed25519 collisions"
+
+// Signer adapts an XPrv to crypto.Signer, so derived keys can be used with
+// golang.org/x/crypto/ssh, JOSE libraries and other code written against the
+// standard interface. XPrv.Sign predates the interface and keeps its simpler
+// signature.
+type Signer struct {
+	key *XPrv
+	pub ed25519.PublicKey
+}
+
+var _ crypto.Signer = (*Signer)(nil)
+
+// Signer returns a crypto.Signer for a copy of k. The public key is computed
+// once here rather than on every signature. Wiping k does not affect the
+// Signer; call Signer.Wipe when it is no longer needed.
+func (k *XPrv) Signer() (*Signer, error) {
+	if k == nil {
+		return nil, ErrNilKey
+	}
+	pub, err := k.PublicKey()
+	if err != nil {
+		return nil, err
+	}
+	return &Signer{key: k.clone(), pub: ed25519.PublicKey(pub[:])}, nil
+}
+
+// Public returns the ed25519.PublicKey of the signer.
+func (s *Signer) Public() crypto.PublicKey {
+	if s == nil {
+		return nil
+	}
+	return append(ed25519.PublicKey(nil), s.pub...)
+}
+
+// Sign signs message with the expanded key, as ed25519.PrivateKey.Sign does
+// with a seed-derived one. rand is ignored because signing is deterministic.
+//
+// opts may be nil for Ed25519. Otherwise opts.HashFunc() must be zero for
+// Ed25519, in which case message is the full unhashed message, or
+// crypto.SHA512 for Ed25519ph, in which case message is its 64-byte SHA-512
+// digest. An *ed25519.Options with a non-empty Context selects Ed25519ctx or
+// adds the context to Ed25519ph.
+func (s *Signer) Sign(_ io.Reader, message []byte, opts crypto.SignerOpts) ([]byte, error) {
+	if s == nil || s.key == nil {
+		return nil, ErrNilKey
+	}
+	var context string
+	if o, ok := opts.(*ed25519.Options); ok {
+		context = o.Context
+	}
+	if len(context) > 255 {
+		return nil, ErrUnsupportedSignerOpts
+	}
+
+	var hash crypto.Hash
+	if opts != nil {
+		hash = opts.HashFunc()
+	}
+	var phflag byte
+	switch hash {
+	case crypto.Hash(0):
+		if context == "" {
+			return s.key.sign(nil, message)
+		}
+	case crypto.SHA512:
+		if len(message) != 64 {
+			return nil, ErrUnsupportedSignerOpts
+		}
+		phflag = 1
+	default:
+		return nil, ErrUnsupportedSignerOpts
+	}
+	dom := make([]byte, 0, len(dom2Prefix)+2+len(context))
+	dom = append(dom, dom2Prefix...)
+	dom = append(dom, phflag, byte(len(context)))
+	dom = append(dom, context...)
+	return s.key.sign(dom, message)
+}
+
+// Wipe clears the signer's copy of the key on a best-effort basis.
+func (s *Signer) Wipe() {
+	if s == nil {
+		return
+	}
+	s.key.Wipe()
+	s.key = nil
+}
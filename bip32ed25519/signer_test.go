@@ -0,0 +1,103 @@
+package bip32ed25519
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"crypto/sha512"
+	"errors"
+	"strings"
+	"testing"
+
+	"golang.org/x/crypto/ssh"
+)
+
+func TestSignerStandardInterfaces(t *testing.T) {
+	key, err := testIcarusRoot(t).DerivePath("m/1852'/1815'/0'/0/0")
+	if err != nil {
+		t.Fatalf("DerivePath: %v", err)
+	}
+	signer, err := key.Signer()
+	if err != nil {
+		t.Fatalf("Signer: %v", err)
+	}
+	pub, ok := signer.Public().(ed25519.PublicKey)
+	if !ok {
+		t.Fatalf("Public() = %T, want ed25519.PublicKey", signer.Public())
+	}
+	want, _ := key.PublicKey()
+	if !bytes.Equal(pub, want[:]) {
+		t.Fatal("Public() does not match XPrv.PublicKey")
+	}
+
+	message := []byte("hello ed25519-bip32")
+	digest := sha512.Sum512(message)
+	for _, tc := range []struct {
+		name    string
+		message []byte
+		opts    crypto.SignerOpts
+	}{
+		{"nil opts", message, nil},
+		{"Ed25519", message, crypto.Hash(0)},
+		{"Ed25519ctx", message, &ed25519.Options{Context: "bip32"}},
+		{"Ed25519ph", digest[:], &ed25519.Options{Hash: crypto.SHA512}},
+		{"Ed25519ph with context", digest[:], &ed25519.Options{Hash: crypto.SHA512, Context: "bip32"}},
+	} {
+		sig, err := signer.Sign(nil, tc.message, tc.opts)
+		if err != nil {
+			t.Fatalf("%s: Sign: %v", tc.name, err)
+		}
+		verifyOpts := &ed25519.Options{}
+		if o, ok := tc.opts.(*ed25519.Options); ok {
+			verifyOpts = o
+		}
+		if err := ed25519.VerifyWithOptions(pub, tc.message, sig, verifyOpts); err != nil {
+			t.Fatalf("%s: VerifyWithOptions: %v", tc.name, err)
+		}
+	}
+	pure, err := signer.Sign(nil, message, crypto.Hash(0))
+	if err != nil {
+		t.Fatalf("Sign: %v", err)
+	}
+	if direct, _ := key.Sign(message); !bytes.Equal(pure, direct) {
+		t.Fatal("Signer and XPrv.Sign produced different Ed25519 signatures")
+	}
+
+	sshSigner, err := ssh.NewSignerFromSigner(signer)
+	if err != nil {
+		t.Fatalf("ssh.NewSignerFromSigner: %v", err)
+	}
+	sshSig, err := sshSigner.Sign(nil, message)
+	if err != nil {
+		t.Fatalf("ssh Sign: %v", err)
+	}
+	if err := sshSigner.PublicKey().Verify(message, sshSig); err != nil {
+		t.Fatalf("ssh Verify: %v", err)
+	}
+
+	shortDigest := sha256.Sum256(message)
+	for _, tc := range []struct {
+		name    string
+		message []byte
+		opts    crypto.SignerOpts
+	}{
+		{"SHA-256", shortDigest[:], crypto.SHA256},
+		{"short prehash", shortDigest[:], crypto.SHA512},
+		{"long context", message, &ed25519.Options{Context: strings.Repeat("x", 256)}},
+	} {
+		if _, err := signer.Sign(nil, tc.message, tc.opts); !errors.Is(err, ErrUnsupportedSignerOpts) {
+			t.Fatalf("%s: error = %v", tc.name, err)
+		}
+	}
+
+	// The signer keeps its own copy of the key.
+	key.Wipe()
+	if sig, err := signer.Sign(nil, message, nil); err != nil || !ed25519.Verify(pub, message, sig) {
+		t.Fatalf("Sign after XPrv.Wipe = %v", err)
+	}
+	signer.Wipe()
+	if _, err := signer.Sign(nil, message, nil); !errors.Is(err, ErrNilKey) {
+		t.Fatalf("Sign after Wipe error = %v", err)
+	}
+}
@@ -0,0 +1,56 @@
+package bip32ed25519
+
+import "fmt"
+
+// RootVariant records which root algorithm produced a key. The same mnemonic
+// gives unrelated keys under each variant, and the binary key format cannot
+// tell them apart, so keys carry it in memory and keystores carry it as
+// metadata.
+type RootVariant uint8
+
+const (
+	// RootIcarus marks a NewMasterKeyIcarus root or one of its descendants.
+	RootIcarus RootVariant = iota + 1
+	// RootKhovratovich marks a NewMasterKeyRawKhovratovich root or one of its
+	// descendants.
+	RootKhovratovich
+	// RootLedger marks a NewMasterKeyLedger root or one of its descendants.
+	RootLedger
+	// RootTrezor marks a NewMasterKeyTrezor root or one of its descendants.
+	RootTrezor
+	// RootByron marks a NewMasterKeyByron root or one of its descendants.
+	RootByron
+)
+
+var rootVariantNames = map[RootVariant]string{
+	RootIcarus:       "icarus",
+	RootKhovratovich: "khovratovich",
+	RootLedger:       "ledger",
+	RootTrezor:       "trezor",
+	RootByron:        "byron",
+}
+
+// String returns the variant's keystore name, such as "icarus".
+func (v RootVariant) String() string {
+	if name, ok := rootVariantNames[v]; ok {
+		return name
+	}
+	return fmt.Sprintf("RootVariant(%d)", uint8(v))
+}
+
+// RootVariant returns the root algorithm k descends from. It is zero for keys
+// imported with NewXPrvFromBytes, whose origin is unknown.
+func (k *XPrv) RootVariant() RootVariant {
+	if k == nil {
+		return 0
+	}
+	return k.variant
+}
+
+// RootVariant returns the root algorithm p descends from, or zero if unknown.
+func (p *XPub) RootVariant() RootVariant {
+	if p == nil {
+		return 0
+	}
+	return p.variant
+}
@@ -0,0 +1,10 @@
+// Package bip32inspect recognizes extended keys of either curve from their
+// text form and reports what they are.
+//
+// ParseExtendedKey accepts standard and SLIP-132 Base58Check keys for
+// bip32secp256k1 (xprv, xpub, tprv, tpub, zpub, Ypub, and so on), CIP-5
+// bech32 keys for bip32ed25519 (root_xsk, acct_xvk, addr_xvk, and so on), and
+// hex CIP-16 bip32ed25519 keys of 96 or 64 bytes. Each failure wraps one of
+// the package's errors, so a bad checksum, an unknown version, a wrong
+// length, and an invalid key can be told apart.
+package bip32inspect
@@ -0,0 +1,24 @@
+package bip32inspect
+
+import "errors"
+
+var (
+	// ErrUnrecognized reports text that is not Base58, bech32, or hex.
+	ErrUnrecognized = errors.New("bip32inspect: not a Base58Check, bech32, or hex extended key")
+	// ErrChecksum reports a Base58Check or bech32 checksum mismatch, usually a
+	// mistyped or truncated key.
+	ErrChecksum = errors.New("bip32inspect: checksum mismatch")
+	// ErrUnknownVersion reports Base58Check version bytes outside SLIP-132 or
+	// a bech32 prefix outside CIP-5.
+	ErrUnknownVersion = errors.New("bip32inspect: unknown version or prefix")
+	// ErrLength reports a payload of the wrong length for its encoding.
+	ErrLength = errors.New("bip32inspect: wrong key length")
+	// ErrInvalidPoint reports a public key that is not a point on its curve.
+	ErrInvalidPoint = errors.New("bip32inspect: public key is not a valid point")
+	// ErrInvalidPrivateKey reports a private key outside the curve's scalar
+	// range or, for bip32ed25519, without the expected bits set and cleared.
+	ErrInvalidPrivateKey = errors.New("bip32inspect: invalid private key")
+	// ErrInvalidMetadata reports a depth-zero key with a parent fingerprint or
+	// child number.
+	ErrInvalidMetadata = errors.New("bip32inspect: invalid depth, parent fingerprint, or child number")
+)
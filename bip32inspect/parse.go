@@ -0,0 +1,284 @@
+package bip32inspect
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/islishude/bip32/v2"
+	"github.com/islishude/bip32/v2/bip32ed25519"
+	"github.com/islishude/bip32/v2/bip32secp256k1"
+	"github.com/islishude/bip32/v2/internal/base58"
+	"github.com/islishude/bip32/v2/internal/bech32"
+	internalsecp "github.com/islishude/bip32/v2/internal/secp256k1"
+	"github.com/islishude/bip32/v2/internal/slip132"
+	"golang.org/x/crypto/blake2b"
+)
+
+// Format is the text encoding a key was read from.
+type Format uint8
+
+const (
+	// Base58Check is the BIP-32 serialization, in any SLIP-132 version.
+	Base58Check Format = iota + 1
+	// Bech32 is a CIP-5 bech32 string.
+	Bech32
+	// Hex is hex-encoded CIP-16 bytes.
+	Hex
+)
+
+// String returns "base58check", "bech32", or "hex".
+func (f Format) String() string {
+	switch f {
+	case Base58Check:
+		return "base58check"
+	case Bech32:
+		return "bech32"
+	case Hex:
+		return "hex"
+	default:
+		return fmt.Sprintf("Format(%d)", uint8(f))
+	}
+}
+
+// ExtendedKey describes a parsed extended key.
+type ExtendedKey struct {
+	// Format is the encoding the key was read from.
+	Format Format
+	// Curve is bip32.CurveSecp256k1 or bip32.CurveEd25519.
+	Curve bip32.Curve
+	// Private reports whether the key holds private key material.
+	Private bool
+	// Version names the key's version: the SLIP-132 name, such as "zpub",
+	// for Base58Check and the CIP-5 prefix, such as "acct_xvk", for bech32.
+	// Hex keys carry none.
+	Version string
+	// Network is the secp256k1 network. It is zero for bip32ed25519 keys,
+	// whose serializations record no network.
+	Network bip32secp256k1.Network
+	// HasMetadata reports whether the serialization records Depth,
+	// ParentFingerprint, and ChildNumber. Only Base58Check does; the other
+	// formats leave the three zero.
+	HasMetadata       bool
+	Depth             uint32
+	ParentFingerprint [4]byte
+	ChildNumber       uint32
+	// Fingerprint is the first four bytes of the key identifier: HASH160 of
+	// the public key for secp256k1 and BLAKE2b-224 for Ed25519.
+	Fingerprint [4]byte
+	// PublicKey is the encoded public key: 33 compressed bytes for secp256k1
+	// and 32 bytes for Ed25519.
+	PublicKey []byte
+	// Key is the parsed key: a *bip32secp256k1.XPrv, *bip32secp256k1.XPub,
+	// *bip32ed25519.XPrv, or *bip32ed25519.XPub. SLIP-132 keys are parsed as
+	// the standard version of the same network, which is what
+	// bip32secp256k1 accepts.
+	Key any
+}
+
+// Wipe clears a private Key on a best-effort basis.
+func (k *ExtendedKey) Wipe() {
+	if k == nil {
+		return
+	}
+	switch key := k.Key.(type) {
+	case *bip32secp256k1.XPrv:
+		key.Wipe()
+	case *bip32ed25519.XPrv:
+		key.Wipe()
+	}
+}
+
+// ParseExtendedKey detects the format of text, which may be surrounded by
+// whitespace, and parses it. Hex is tried first, then bech32 for text with a
+// CIP-5 underscore, then Base58Check.
+//
+// Errors wrap ErrUnrecognized, ErrChecksum, ErrUnknownVersion, ErrLength,
+// ErrInvalidPoint, ErrInvalidPrivateKey, or ErrInvalidMetadata.
+func ParseExtendedKey(text string) (*ExtendedKey, error) {
+	text = strings.TrimSpace(text)
+	switch {
+	case text == "":
+		return nil, ErrUnrecognized
+	case isHex(text):
+		raw, _ := hex.DecodeString(text)
+		defer clear(raw)
+		return parseEd25519(raw, Hex, "")
+	case strings.Contains(text, "_"):
+		return parseBech32(text)
+	default:
+		return parseBase58Check(text)
+	}
+}
+
+func isHex(text string) bool {
+	if len(text)%2 != 0 {
+		return false
+	}
+	for i := range len(text) {
+		c := text[i]
+		if !('0' <= c && c <= '9' || 'a' <= c && c <= 'f' || 'A' <= c && c <= 'F') {
+			return false
+		}
+	}
+	return true
+}
+
+func parseBech32(text string) (*ExtendedKey, error) {
+	lower := strings.ToLower(text)
+	sep := strings.LastIndexByte(lower, '1')
+	if sep < 0 {
+		return nil, ErrUnrecognized
+	}
+	hrp := lower[:sep]
+	private := strings.HasSuffix(hrp, "_xsk")
+	if !private && !strings.HasSuffix(hrp, "_xvk") || !bip32ed25519.Role(hrp[:len(hrp)-len("_xsk")]).Valid() {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownVersion, hrp)
+	}
+	const charset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+	for i := sep + 1; i < len(lower); i++ {
+		if strings.IndexByte(charset, lower[i]) < 0 {
+			return nil, fmt.Errorf("%w: invalid bech32 character %q", ErrUnrecognized, text[i])
+		}
+	}
+
+	_, data, encoding, ok := bech32.Decode(text, bech32.MaxLengthCIP5)
+	if !ok {
+		if len(text) > bech32.MaxLengthCIP5 || text != lower && text != strings.ToUpper(text) {
+			return nil, fmt.Errorf("%w: malformed bech32 string", ErrUnrecognized)
+		}
+		return nil, ErrChecksum
+	}
+	if encoding != bech32.Bech32 {
+		return nil, fmt.Errorf("%w: bech32m is not used by CIP-5", ErrChecksum)
+	}
+	raw, ok := bech32.ConvertBits(data, 5, 8, false)
+	if !ok {
+		return nil, fmt.Errorf("%w: bech32 data is not a whole number of bytes", ErrLength)
+	}
+	defer clear(raw)
+	want := bip32ed25519.XPubSize
+	if private {
+		want = bip32ed25519.XPrvSize
+	}
+	if len(raw) != want {
+		return nil, fmt.Errorf("%w: %s has %d bytes, want %d", ErrLength, hrp, len(raw), want)
+	}
+	return parseEd25519(raw, Bech32, hrp)
+}
+
+// parseEd25519 parses kL || kR || chainCode or publicKey || chainCode.
+func parseEd25519(raw []byte, format Format, version string) (*ExtendedKey, error) {
+	out := &ExtendedKey{Format: format, Curve: bip32.CurveEd25519, Version: version}
+	var pub [32]byte
+	switch len(raw) {
+	case bip32ed25519.XPrvSize:
+		xprv, err := bip32ed25519.NewXPrvFromBytes(raw)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidPrivateKey, err)
+		}
+		if pub, err = xprv.PublicKey(); err != nil {
+			xprv.Wipe()
+			return nil, fmt.Errorf("%w: %w", ErrInvalidPrivateKey, err)
+		}
+		out.Private, out.Key = true, xprv
+	case bip32ed25519.XPubSize:
+		xpub, err := bip32ed25519.NewXPubFromBytes(raw)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidPoint, err)
+		}
+		pub = xpub.PublicKey()
+		out.Key = xpub
+	default:
+		return nil, fmt.Errorf("%w: %d bytes, want %d or %d", ErrLength, len(raw), bip32ed25519.XPrvSize, bip32ed25519.XPubSize)
+	}
+	h, _ := blake2b.New(28, nil)
+	_, _ = h.Write(pub[:])
+	copy(out.Fingerprint[:], h.Sum(nil))
+	out.PublicKey = pub[:]
+	return out, nil
+}
+
+func parseBase58Check(text string) (*ExtendedKey, error) {
+	raw, ok := base58.Decode(text)
+	if !ok {
+		return nil, ErrUnrecognized
+	}
+	clear(raw)
+	if len(raw) <= base58.ChecksumSize {
+		return nil, fmt.Errorf("%w: %d bytes", ErrLength, len(raw))
+	}
+	payload, err := base58.CheckDecode(text, len(raw)-base58.ChecksumSize, ErrUnrecognized, ErrChecksum)
+	if err != nil {
+		return nil, err
+	}
+	defer clear(payload)
+	if len(payload) != bip32secp256k1.SerializedKeySize {
+		return nil, fmt.Errorf("%w: %d bytes, want %d", ErrLength, len(payload), bip32secp256k1.SerializedKeySize)
+	}
+	v, ok := slip132.ByBytes([4]byte(payload[:4]))
+	if !ok {
+		return nil, fmt.Errorf("%w: version bytes %x", ErrUnknownVersion, payload[:4])
+	}
+
+	// Offsets of the BIP-32 serialization: version, depth, parent
+	// fingerprint, child number, chain code, key data.
+	const (
+		depthOffset       = 4
+		fingerprintOffset = 5
+		childOffset       = 9
+		keyDataOffset     = 45
+	)
+	out := &ExtendedKey{
+		Format:      Base58Check,
+		Curve:       bip32.CurveSecp256k1,
+		Private:     v.Private,
+		Version:     v.Name,
+		Network:     v.Network,
+		HasMetadata: true,
+		Depth:       uint32(payload[depthOffset]),
+		ChildNumber: binary.BigEndian.Uint32(payload[childOffset:]),
+	}
+	copy(out.ParentFingerprint[:], payload[fingerprintOffset:childOffset])
+
+	if v.Private {
+		scalar := [bip32secp256k1.PrivateKeySize]byte(payload[keyDataOffset+1:])
+		valid := payload[keyDataOffset] == 0 && internalsecp.ValidPrivateScalar(&scalar)
+		clear(scalar[:])
+		if !valid {
+			return nil, ErrInvalidPrivateKey
+		}
+	} else {
+		pub := [bip32secp256k1.PublicKeySize]byte(payload[keyDataOffset:])
+		if _, ok := internalsecp.ParsePublicKey(&pub); !ok {
+			return nil, ErrInvalidPoint
+		}
+	}
+	if out.Depth == 0 && (out.ParentFingerprint != [4]byte{} || out.ChildNumber != 0) {
+		return nil, ErrInvalidMetadata
+	}
+
+	std := slip132.Standard(v.Network, v.Private)
+	copy(payload[:4], std.Bytes[:])
+	var xpub *bip32secp256k1.XPub
+	if v.Private {
+		xprv, err := bip32secp256k1.NewXPrvFromBytes(payload)
+		if err != nil {
+			return nil, err
+		}
+		if xpub, err = xprv.XPub(); err != nil {
+			xprv.Wipe()
+			return nil, err
+		}
+		out.Key = xprv
+	} else {
+		if xpub, err = bip32secp256k1.NewXPubFromBytes(payload); err != nil {
+			return nil, err
+		}
+		out.Key = xpub
+	}
+	out.Fingerprint = xpub.Fingerprint()
+	out.PublicKey = xpub.PublicKeyBytes()
+	return out, nil
+}
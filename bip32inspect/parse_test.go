@@ -0,0 +1,189 @@
+package bip32inspect
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/islishude/bip32/v2"
+	"github.com/islishude/bip32/v2/bip32ed25519"
+	"github.com/islishude/bip32/v2/bip32secp256k1"
+	"github.com/islishude/bip32/v2/internal/base58"
+	"github.com/islishude/bip32/v2/internal/bech32"
+	"golang.org/x/crypto/blake2b"
+)
+
+// BIP-32 test vector 1.
+const (
+	vector1Master = "xprv9s21ZrQH143K3QTDL4LXw2F7HEK3wJUD2nW2nRk4stbPy6cq3jPPqjiChkVvvNKmPGJxWUtg6LnF5kejMRNNU3TGtRBeJgk33yuGBxrMPHi"
+	vector1Child  = "xpub68Gmy5EdvgibQVfPdqkBBCHxA5htiqg55crXYuXoQRKfDBFA1WEjWgP6LHhwBZeNK1VTsfTFUHCdrfp1bgwQ9xv5ski8PX9rL2dZXvgGDnw"
+)
+
+// The CIP-3 Icarus master key for entropy 46e62370…ddf38 and no passphrase.
+const icarusMaster = "c065afd2832cd8b087c4d9ab7011f481ee1e0721e78ea5dd609f3ab3f156d245d176bd8fd4ec60b4731c3918a2a72a0226c0cd119ec35b47e4d55884667f552a23f7fdcd4a10c6cd2c7393ac61d877873e248f417634aa3d812af327ffe9d620"
+
+func mustParse(t *testing.T, text string) *ExtendedKey {
+	t.Helper()
+	key, err := ParseExtendedKey(text)
+	if err != nil {
+		t.Fatalf("ParseExtendedKey(%q): %v", text, err)
+	}
+	return key
+}
+
+func mustDecodeBase58Check(t *testing.T, text string) []byte {
+	t.Helper()
+	payload, err := base58.CheckDecode(text, bip32secp256k1.SerializedKeySize, ErrUnrecognized, ErrChecksum)
+	if err != nil {
+		t.Fatalf("decode %q: %v", text, err)
+	}
+	return payload
+}
+
+func TestParseBase58Check(t *testing.T) {
+	master := mustParse(t, " "+vector1Master+"\n")
+	if master.Format != Base58Check || master.Curve != bip32.CurveSecp256k1 || !master.Private ||
+		master.Version != "xprv" || master.Network != bip32secp256k1.Mainnet || !master.HasMetadata ||
+		master.Depth != 0 || master.ParentFingerprint != [4]byte{} || master.ChildNumber != 0 {
+		t.Fatalf("master = %+v", master)
+	}
+	if got := hex.EncodeToString(master.Fingerprint[:]); got != "3442193e" {
+		t.Fatalf("master fingerprint = %s", got)
+	}
+	if got := hex.EncodeToString(master.PublicKey); got != "0339a36013301597daef41fbe593a02cc513d0b55527ec2df1050e2e8ff49c85c2" {
+		t.Fatalf("master public key = %s", got)
+	}
+	xprv, ok := master.Key.(*bip32secp256k1.XPrv)
+	if !ok {
+		t.Fatalf("master key type = %T", master.Key)
+	}
+	if encoded, err := xprv.Encode(); err != nil || encoded != vector1Master {
+		t.Fatalf("master Encode = %q, %v", encoded, err)
+	}
+	master.Wipe()
+
+	child := mustParse(t, vector1Child)
+	if child.Private || child.Version != "xpub" || child.Depth != 1 || child.ChildNumber != bip32.HardenedOffset ||
+		hex.EncodeToString(child.ParentFingerprint[:]) != "3442193e" || hex.EncodeToString(child.Fingerprint[:]) != "5c1bd648" {
+		t.Fatalf("child = %+v", child)
+	}
+	if _, ok := child.Key.(*bip32secp256k1.XPub); !ok {
+		t.Fatalf("child key type = %T", child.Key)
+	}
+
+	// A SLIP-132 zpub parses to the standard xpub of the same network.
+	payload := mustDecodeBase58Check(t, vector1Child)
+	copy(payload[:4], []byte{0x04, 0xb2, 0x47, 0x46})
+	zpub := mustParse(t, base58.CheckEncode(payload))
+	if zpub.Version != "zpub" || zpub.Fingerprint != child.Fingerprint {
+		t.Fatalf("zpub = %+v", zpub)
+	}
+	if encoded, err := zpub.Key.(*bip32secp256k1.XPub).Encode(); err != nil || encoded != vector1Child {
+		t.Fatalf("zpub as xpub = %q, %v", encoded, err)
+	}
+	copy(payload[:4], []byte{0x04, 0x35, 0x87, 0xcf})
+	if tpub := mustParse(t, base58.CheckEncode(payload)); tpub.Version != "tpub" || tpub.Network != bip32secp256k1.Testnet {
+		t.Fatalf("tpub = %+v", tpub)
+	}
+}
+
+func TestParseEd25519(t *testing.T) {
+	root := mustParse(t, icarusMaster)
+	if root.Format != Hex || root.Curve != bip32.CurveEd25519 || !root.Private || root.Version != "" ||
+		root.Network != 0 || root.HasMetadata {
+		t.Fatalf("hex root = %+v", root)
+	}
+	xprv, ok := root.Key.(*bip32ed25519.XPrv)
+	if !ok || hex.EncodeToString(xprv.Bytes()) != icarusMaster {
+		t.Fatalf("hex root key = %T", root.Key)
+	}
+	h, _ := blake2b.New(28, nil)
+	h.Write(root.PublicKey)
+	if len(root.PublicKey) != 32 || !bytes.Equal(root.Fingerprint[:], h.Sum(nil)[:4]) {
+		t.Fatalf("hex root public key %x, fingerprint %x", root.PublicKey, root.Fingerprint)
+	}
+
+	xpub, err := xprv.XPub()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, _ := bech32.ConvertBits(xpub.Bytes(), 8, 5, true)
+	encoded, _ := bech32.Encode("acct_xvk", data, bech32.Bech32)
+	acct := mustParse(t, encoded)
+	if acct.Format != Bech32 || acct.Private || acct.Version != "acct_xvk" || acct.Fingerprint != root.Fingerprint {
+		t.Fatalf("bech32 xvk = %+v", acct)
+	}
+	if parsed, ok := acct.Key.(*bip32ed25519.XPub); !ok || !bytes.Equal(parsed.Bytes(), xpub.Bytes()) {
+		t.Fatalf("bech32 xvk key = %T", acct.Key)
+	}
+	if upper := mustParse(t, strings.ToUpper(encoded)); upper.Version != "acct_xvk" {
+		t.Fatalf("upper-case bech32 = %+v", upper)
+	}
+
+	data, _ = bech32.ConvertBits(xprv.Bytes(), 8, 5, true)
+	encoded, _ = bech32.Encode("root_xsk", data, bech32.Bech32)
+	if xsk := mustParse(t, encoded); !xsk.Private || xsk.Version != "root_xsk" || xsk.Fingerprint != root.Fingerprint {
+		t.Fatalf("bech32 xsk = %+v", xsk)
+	}
+	root.Wipe()
+}
+
+func TestParseErrors(t *testing.T) {
+	payload := mustDecodeBase58Check(t, vector1Child)
+	withVersion := func(version []byte) string {
+		out := bytes.Clone(payload)
+		copy(out, version)
+		return base58.CheckEncode(out)
+	}
+	badPoint := bytes.Clone(payload)
+	badPoint[45] = 0x04
+	badMetadata := mustDecodeBase58Check(t, vector1Master)
+	badMetadata[12] = 1
+	badScalar := mustDecodeBase58Check(t, vector1Master)
+	copy(badScalar[46:], bytes.Repeat([]byte{0xff}, 32))
+
+	last := vector1Child[len(vector1Child)-1]
+	mistyped := vector1Child[:len(vector1Child)-1] + string(last^1)
+
+	bech32Key := func(hrp string, raw []byte) string {
+		data, _ := bech32.ConvertBits(raw, 8, 5, true)
+		encoded, _ := bech32.Encode(hrp, data, bech32.Bech32)
+		return encoded
+	}
+	edPoint := make([]byte, bip32ed25519.XPubSize)
+	edPoint[0] = 2 // y = 2 has no matching x.
+	goodXVK := bech32Key("addr_xvk", append(mustParse(t, icarusMaster).PublicKey, make([]byte, 32)...))
+	mistypedXVK := goodXVK[:len(goodXVK)-1] + "q"
+	if mistypedXVK == goodXVK {
+		mistypedXVK = goodXVK[:len(goodXVK)-1] + "p"
+	}
+
+	for _, tc := range []struct {
+		name, text string
+		want       error
+	}{
+		{"empty", " ", ErrUnrecognized},
+		{"not base58", "xpub0OIl", ErrUnrecognized},
+		{"base58 checksum", mistyped, ErrChecksum},
+		{"ltub version", withVersion([]byte{0x01, 0x9d, 0xa4, 0x62}), ErrUnknownVersion},
+		{"short payload", base58.CheckEncode(payload[:77]), ErrLength},
+		{"secp256k1 point", base58.CheckEncode(badPoint), ErrInvalidPoint},
+		{"secp256k1 scalar", base58.CheckEncode(badScalar), ErrInvalidPrivateKey},
+		{"root child number", base58.CheckEncode(badMetadata), ErrInvalidMetadata},
+		{"hex length", strings.Repeat("00", 78), ErrLength},
+		{"ed25519 point", hex.EncodeToString(edPoint), ErrInvalidPoint},
+		{"ed25519 scalar", strings.Repeat("ff", bip32ed25519.XPrvSize), ErrInvalidPrivateKey},
+		{"bech32 prefix", bech32Key("addr_vk", make([]byte, 32)), ErrUnknownVersion},
+		{"bech32 checksum", mistypedXVK, ErrChecksum},
+		{"bech32 length", bech32Key("addr_xvk", make([]byte, 32)), ErrLength},
+		{"bech32 character", strings.Replace(goodXVK, "addr_xvk1", "addr_xvk1b", 1), ErrUnrecognized},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := ParseExtendedKey(tc.text); !errors.Is(err, tc.want) {
+				t.Fatalf("ParseExtendedKey error = %v, want %v", err, tc.want)
+			}
+		})
+	}
+}
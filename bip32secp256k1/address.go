@@ -0,0 +1,165 @@
+package bip32secp256k1
+
+import (
+	"github.com/islishude/bip32/v2/internal/bech32"
+	internalsecp "github.com/islishude/bip32/v2/internal/secp256k1"
+)
+
+// AddressType selects a Bitcoin output script. P2SHP2WSH and P2WSH are
+// script-hash types used only by Multisig; XPub.Address rejects them.
+type AddressType uint8
+
+const (
+	// P2PKH is a legacy pay-to-public-key-hash address (1... or m/n...).
+	P2PKH AddressType = iota + 1
+	// P2SHP2WPKH is a P2WPKH output nested in pay-to-script-hash (3... or
+	// 2...), as used by BIP-49.
+	P2SHP2WPKH
+	// P2WPKH is a native segwit v0 key-hash address (bc1q... or tb1q...), as
+	// used by BIP-84.
+	P2WPKH
+	// P2TR is a segwit v1 taproot address with a key-path-only output key, as
+	// used by BIP-86.
+	P2TR
+	// P2SHP2WSH is a P2WSH output nested in pay-to-script-hash, BIP-48 script
+	// type 1'.
+	P2SHP2WSH
+	// P2WSH is a native segwit v0 script-hash address, BIP-48 script type 2'.
+	P2WSH
+)
+
+var (
+	mainnetPubKeyHashVersion byte = 0x00
+	mainnetScriptHashVersion byte = 0x05
+	testnetPubKeyHashVersion byte = 0x6f
+	testnetScriptHashVersion byte = 0xc4
+)
+
+const (
+	mainnetHRP = "bc"
+	testnetHRP = "tb"
+
+	opReturn        = 0x6a
+	opDup           = 0x76
+	opHash160       = 0xa9
+	opEqual         = 0x87
+	opEqualVerify   = 0x88
+	opCheckSig      = 0xac
+	opCheckMultisig = 0xae
+	op1             = 0x51
+)
+
+// Address returns the address of the given type paying to this key on its
+// network.
+func (p *XPub) Address(addressType AddressType) (string, error) {
+	if p == nil {
+		return "", ErrNilKey
+	}
+	script, err := outputScript(p.pub, addressType)
+	if err != nil {
+		return "", err
+	}
+	return encodeAddress(script, p.network)
+}
+
+// outputScript returns the scriptPubKey of the given type paying to pub.
+func outputScript(pub [PublicKeySize]byte, addressType AddressType) ([]byte, error) {
+	switch addressType {
+	case P2PKH:
+		return p2pkhScript(hash160(pub[:])), nil
+	case P2SHP2WPKH:
+		return p2shScript(hash160(p2wpkhScript(hash160(pub[:])))), nil
+	case P2WPKH:
+		return p2wpkhScript(hash160(pub[:])), nil
+	case P2TR:
+		outputKey, _, ok := internalsecp.TaprootOutputKey((*[32]byte)(pub[1:]), nil)
+		if !ok {
+			return nil, ErrInvalidXPub
+		}
+		return append([]byte{op1, 32}, outputKey[:]...), nil
+	default:
+		return nil, ErrUnsupportedAddress
+	}
+}
+
+func p2pkhScript(hash [20]byte) []byte {
+	script := append([]byte{opDup, opHash160, 20}, hash[:]...)
+	return append(script, opEqualVerify, opCheckSig)
+}
+
+func p2shScript(hash [20]byte) []byte {
+	return append(append([]byte{opHash160, 20}, hash[:]...), opEqual)
+}
+
+func p2wpkhScript(hash [20]byte) []byte {
+	return append([]byte{0x00, 20}, hash[:]...)
+}
+
+// encodeAddress returns the address text of a standard scriptPubKey.
+func encodeAddress(script []byte, network Network) (string, error) {
+	if !validNetwork(network) {
+		return "", ErrInvalidNetwork
+	}
+	pubKeyHashVersion, scriptHashVersion, hrp := mainnetPubKeyHashVersion, mainnetScriptHashVersion, mainnetHRP
+	if network == Testnet {
+		pubKeyHashVersion, scriptHashVersion, hrp = testnetPubKeyHashVersion, testnetScriptHashVersion, testnetHRP
+	}
+
+	switch {
+	case len(script) == 25 && script[0] == opDup && script[1] == opHash160 && script[2] == 20 &&
+		script[23] == opEqualVerify && script[24] == opCheckSig:
+		return base58CheckEncode(append([]byte{pubKeyHashVersion}, script[3:23]...)), nil
+	case len(script) == 23 && script[0] == opHash160 && script[1] == 20 && script[22] == opEqual:
+		return base58CheckEncode(append([]byte{scriptHashVersion}, script[2:22]...)), nil
+	case len(script) >= 4 && (script[0] == 0 || script[0] >= op1 && script[0] <= op1+15) && int(script[1]) == len(script)-2:
+		version := script[0]
+		if version != 0 {
+			version -= op1 - 1
+		}
+		addr, ok := bech32.EncodeSegWit(hrp, version, script[2:])
+		if !ok {
+			return "", ErrUnsupportedAddress
+		}
+		return addr, nil
+	default:
+		return "", ErrUnsupportedAddress
+	}
+}
+
+// decodeAddress parses a Bitcoin address and returns its scriptPubKey and
+// network. Base58 and segwit addresses of any standard kind are accepted;
+// callers decide which kinds they support.
+func decodeAddress(addr string) ([]byte, Network, error) {
+	for _, candidate := range []struct {
+		hrp     string
+		network Network
+	}{{mainnetHRP, Mainnet}, {testnetHRP, Testnet}} {
+		version, program, ok := bech32.DecodeSegWit(candidate.hrp, addr)
+		if !ok {
+			continue
+		}
+		opcode := version
+		if version != 0 {
+			opcode += op1 - 1
+		}
+		return append([]byte{opcode, byte(len(program))}, program...), candidate.network, nil
+	}
+
+	payload, err := base58CheckDecode(addr, 21)
+	if err != nil {
+		return nil, 0, ErrInvalidAddress
+	}
+	hash := [20]byte(payload[1:])
+	switch payload[0] {
+	case mainnetPubKeyHashVersion:
+		return p2pkhScript(hash), Mainnet, nil
+	case testnetPubKeyHashVersion:
+		return p2pkhScript(hash), Testnet, nil
+	case mainnetScriptHashVersion:
+		return p2shScript(hash), Mainnet, nil
+	case testnetScriptHashVersion:
+		return p2shScript(hash), Testnet, nil
+	default:
+		return nil, 0, ErrInvalidAddress
+	}
+}
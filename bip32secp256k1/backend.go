@@ -0,0 +1,180 @@
+package bip32secp256k1
+
+import (
+	"crypto"
+	"io"
+)
+
+// Backend performs the private-key operations of a BIP-32 tree without
+// exposing its keys, so the root can stay in a hardware wallet, an HSM, or a
+// separate signer process. Paths are absolute and use the same syntax as
+// XPrv.DerivePath. Everything that needs only public keys, such as addresses,
+// multisig scripts, and further normal derivation, works on the returned
+// XPub.
+//
+// LocalBackend implements Backend over an in-memory root for tests and for
+// programs that do not need process isolation. Implementations must be safe
+// for concurrent use.
+type Backend interface {
+	// DeriveXPub returns the extended public key at path.
+	DeriveXPub(path string) (*XPub, error)
+	// SignECDSA signs a 32-byte digest with the private key at path and
+	// returns a DER-encoded low-S signature, as ECDSASigner.Sign does.
+	SignECDSA(path string, digest []byte) ([]byte, error)
+	// SignECDSARecoverable signs a 32-byte digest with the private key at
+	// path and returns MessageSignatureSize bytes: the recovery id, 0 to 3,
+	// followed by the low-S r || s signature. It backs BIP-137 message
+	// signatures.
+	SignECDSARecoverable(path string, digest []byte) ([]byte, error)
+	// SignTaproot signs a 32-byte digest with the BIP-86 output key of the
+	// key at path, which spends its P2TR address by key path, and returns the
+	// BIP-340 Schnorr signature of SchnorrSignatureSize bytes.
+	SignTaproot(path string, digest []byte) ([]byte, error)
+}
+
+// LocalBackend is a Backend that holds the root in memory. Derived nodes are
+// memoized in a Keychain, so repeated operations on a path reuse the cached
+// child and its ancestors instead of deriving again from the root.
+type LocalBackend struct {
+	keys *Keychain
+}
+
+// localBackendCapacity bounds the nodes cached by a LocalBackend.
+const localBackendCapacity = 1024
+
+var _ Backend = (*LocalBackend)(nil)
+
+// NewLocalBackend returns a LocalBackend over a copy of root, which must be a
+// master key.
+func NewLocalBackend(root *XPrv) (*LocalBackend, error) {
+	if root == nil {
+		return nil, ErrNilKey
+	}
+	keys, err := NewKeychain(root, localBackendCapacity)
+	if err != nil {
+		return nil, err
+	}
+	return &LocalBackend{keys: keys}, nil
+}
+
+// DeriveXPub returns the extended public key at path.
+func (b *LocalBackend) DeriveXPub(path string) (*XPub, error) {
+	if b == nil {
+		return nil, ErrNilKey
+	}
+	child, err := b.keys.DerivePath(path)
+	if err != nil {
+		return nil, err
+	}
+	defer child.Wipe()
+	return child.XPub()
+}
+
+// SignECDSA signs digest with the key at path. The caller's copy of the
+// child is wiped before returning.
+func (b *LocalBackend) SignECDSA(path string, digest []byte) ([]byte, error) {
+	if b == nil {
+		return nil, ErrNilKey
+	}
+	if len(digest) != 32 {
+		return nil, ErrInvalidDigest
+	}
+	child, err := b.keys.DerivePath(path)
+	if err != nil {
+		return nil, err
+	}
+	defer child.Wipe()
+	return signDigest(&child.key, digest)
+}
+
+// SignECDSARecoverable signs digest with the key at path, returning the
+// recovery id followed by r || s. The caller's copy of the child is wiped
+// before returning.
+func (b *LocalBackend) SignECDSARecoverable(path string, digest []byte) ([]byte, error) {
+	if b == nil {
+		return nil, ErrNilKey
+	}
+	if len(digest) != 32 {
+		return nil, ErrInvalidDigest
+	}
+	child, err := b.keys.DerivePath(path)
+	if err != nil {
+		return nil, err
+	}
+	defer child.Wipe()
+	return signRecoverable(&child.key, (*[32]byte)(digest))
+}
+
+// SignTaproot signs digest with the BIP-86 tweak of the key at path and fresh
+// auxiliary randomness. The caller's copy of the child is wiped before
+// returning.
+func (b *LocalBackend) SignTaproot(path string, digest []byte) ([]byte, error) {
+	if b == nil {
+		return nil, ErrNilKey
+	}
+	if len(digest) != 32 {
+		return nil, ErrInvalidDigest
+	}
+	child, err := b.keys.DerivePath(path)
+	if err != nil {
+		return nil, err
+	}
+	defer child.Wipe()
+	return signTaproot(&child.key, (*[32]byte)(digest))
+}
+
+// Wipe clears the root and every cached child on a best-effort basis. The
+// LocalBackend must not be used afterwards.
+func (b *LocalBackend) Wipe() {
+	if b == nil {
+		return
+	}
+	b.keys.Wipe()
+}
+
+// BackendSigner adapts one key of a Backend to crypto.Signer, in the same way
+// ECDSASigner adapts an XPrv. Its SignMessage and SignMessageBIP322 methods
+// produce the same message signatures as the XPrv methods of those names.
+type BackendSigner struct {
+	backend Backend
+	path    string
+	pub     PublicKey
+}
+
+var _ crypto.Signer = (*BackendSigner)(nil)
+
+// NewBackendSigner returns a crypto.Signer for the key at path. The public key
+// is fetched from the backend once here.
+func NewBackendSigner(backend Backend, path string) (*BackendSigner, error) {
+	if backend == nil {
+		return nil, ErrNilKey
+	}
+	xpub, err := backend.DeriveXPub(path)
+	if err != nil {
+		return nil, err
+	}
+	if xpub == nil {
+		return nil, ErrInvalidXPub
+	}
+	return &BackendSigner{backend: backend, path: path, pub: xpub.PublicKey()}, nil
+}
+
+// Public returns the signer's PublicKey.
+func (s *BackendSigner) Public() crypto.PublicKey {
+	if s == nil {
+		return nil
+	}
+	return s.pub
+}
+
+// Sign asks the backend to sign a 32-byte digest. opts is checked as in
+// ECDSASigner.Sign, and rand is ignored.
+func (s *BackendSigner) Sign(_ io.Reader, digest []byte, opts crypto.SignerOpts) ([]byte, error) {
+	if s == nil {
+		return nil, ErrNilKey
+	}
+	if err := checkDigestOpts(digest, opts); err != nil {
+		return nil, err
+	}
+	return s.backend.SignECDSA(s.path, digest)
+}
@@ -0,0 +1,183 @@
+package bip32secp256k1
+
+import (
+	"crypto"
+	"crypto/sha256"
+	"errors"
+	"testing"
+)
+
+// remoteBackend stands in for a signer in another process: it only ever hands
+// out public keys and signatures.
+type remoteBackend struct {
+	inner Backend
+	signs int
+}
+
+func (r *remoteBackend) DeriveXPub(path string) (*XPub, error) { return r.inner.DeriveXPub(path) }
+
+func (r *remoteBackend) SignECDSA(path string, digest []byte) ([]byte, error) {
+	r.signs++
+	return r.inner.SignECDSA(path, digest)
+}
+
+func (r *remoteBackend) SignECDSARecoverable(path string, digest []byte) ([]byte, error) {
+	r.signs++
+	return r.inner.SignECDSARecoverable(path, digest)
+}
+
+func (r *remoteBackend) SignTaproot(path string, digest []byte) ([]byte, error) {
+	r.signs++
+	return r.inner.SignTaproot(path, digest)
+}
+
+func TestLocalBackend(t *testing.T) {
+	master := mustMaster(t, Mainnet)
+	local, err := NewLocalBackend(master)
+	if err != nil {
+		t.Fatalf("NewLocalBackend: %v", err)
+	}
+	const path = "m/84'/0'/0'/0/1"
+	want, err := master.DerivePath(path)
+	if err != nil {
+		t.Fatalf("DerivePath: %v", err)
+	}
+	wantXPub, _ := want.XPub()
+
+	xpub, err := local.DeriveXPub(path)
+	if err != nil {
+		t.Fatalf("DeriveXPub: %v", err)
+	}
+	got, _ := xpub.Encode()
+	if wantText, _ := wantXPub.Encode(); got != wantText {
+		t.Fatal("DeriveXPub does not match XPrv.DerivePath")
+	}
+
+	digest := sha256.Sum256([]byte("backend"))
+	sig, err := local.SignECDSA(path, digest[:])
+	if err != nil {
+		t.Fatalf("SignECDSA: %v", err)
+	}
+	direct, _ := want.ECDSASigner()
+	if wantSig, _ := direct.Sign(nil, digest[:], nil); string(sig) != string(wantSig) {
+		t.Fatal("SignECDSA does not match ECDSASigner")
+	}
+
+	remote := &remoteBackend{inner: local}
+	signer, err := NewBackendSigner(remote, path)
+	if err != nil {
+		t.Fatalf("NewBackendSigner: %v", err)
+	}
+	if !signer.Public().(PublicKey).Equal(PublicKey(xpub.PublicKey())) {
+		t.Fatal("BackendSigner.Public does not match DeriveXPub")
+	}
+	sig, err = signer.Sign(nil, digest[:], crypto.SHA256)
+	if err != nil || remote.signs != 1 {
+		t.Fatalf("BackendSigner.Sign: %v after %d calls", err, remote.signs)
+	}
+	if !PublicKey(xpub.PublicKey()).VerifyASN1(digest[:], sig) {
+		t.Fatal("BackendSigner signature does not verify")
+	}
+	if _, err := signer.Sign(nil, digest[:], crypto.SHA512); !errors.Is(err, ErrInvalidDigest) || remote.signs != 1 {
+		t.Fatalf("Sign with mismatched hash error = %v", err)
+	}
+
+	if _, err := local.SignECDSA(path, digest[:16]); !errors.Is(err, ErrInvalidDigest) {
+		t.Fatalf("short digest error = %v", err)
+	}
+	if _, err := local.DeriveXPub("0/1"); !errors.Is(err, ErrInvalidPath) {
+		t.Fatalf("relative path error = %v", err)
+	}
+	child, _ := master.Derive(0)
+	if _, err := NewLocalBackend(child); !errors.Is(err, ErrNotRoot) {
+		t.Fatalf("NewLocalBackend(child) error = %v", err)
+	}
+	if _, err := NewBackendSigner(nil, path); !errors.Is(err, ErrNilKey) {
+		t.Fatalf("NewBackendSigner(nil) error = %v", err)
+	}
+
+	// Each signing method reuses the child cached by the first derivation.
+	before := local.keys.Stats()
+	if _, err := local.SignECDSARecoverable(path, digest[:]); err != nil {
+		t.Fatalf("SignECDSARecoverable: %v", err)
+	}
+	if _, err := local.SignTaproot(path, digest[:]); err != nil {
+		t.Fatalf("SignTaproot: %v", err)
+	}
+	if after := local.keys.Stats(); after.Hits != before.Hits+2 || after.Misses != before.Misses || after.Len != before.Len {
+		t.Fatalf("keychain stats went from %+v to %+v", before, after)
+	}
+
+	// The backend keeps its own copy of the root.
+	master.Wipe()
+	if _, err := local.SignECDSA(path, digest[:]); err != nil {
+		t.Fatalf("SignECDSA after XPrv.Wipe: %v", err)
+	}
+	local.Wipe()
+	if got := local.keys.Stats().Len; got != 0 {
+		t.Fatalf("%d cached children survived Wipe", got)
+	}
+	if _, err := local.SignECDSA(path, digest[:]); err == nil {
+		t.Fatal("SignECDSA succeeded after Wipe")
+	}
+}
+
+func TestBackendSignerMessages(t *testing.T) {
+	master := mustMaster(t, Mainnet)
+	local, err := NewLocalBackend(master)
+	if err != nil {
+		t.Fatalf("NewLocalBackend: %v", err)
+	}
+	const path = "m/86'/0'/0'/0/0"
+	key, err := master.DerivePath(path)
+	if err != nil {
+		t.Fatalf("DerivePath: %v", err)
+	}
+	xpub, _ := key.XPub()
+	remote := &remoteBackend{inner: local}
+	signer, err := NewBackendSigner(remote, path)
+	if err != nil {
+		t.Fatalf("NewBackendSigner: %v", err)
+	}
+	message := []byte("backend message")
+
+	for _, addressType := range []AddressType{P2PKH, P2SHP2WPKH, P2WPKH} {
+		sig, err := signer.SignMessage(message, addressType)
+		if err != nil {
+			t.Fatalf("SignMessage(%v): %v", addressType, err)
+		}
+		// RFC 6979 makes the legacy format deterministic.
+		if want, _ := key.SignMessage(message, addressType); sig != want {
+			t.Fatalf("SignMessage(%v) differs from XPrv.SignMessage", addressType)
+		}
+		address, _ := xpub.Address(addressType)
+		if err := VerifyMessage(address, message, sig); err != nil {
+			t.Fatalf("VerifyMessage(%v): %v", addressType, err)
+		}
+	}
+
+	for _, addressType := range []AddressType{P2WPKH, P2TR} {
+		sig, err := signer.SignMessageBIP322(message, addressType)
+		if err != nil {
+			t.Fatalf("SignMessageBIP322(%v): %v", addressType, err)
+		}
+		address, _ := xpub.Address(addressType)
+		if err := VerifyMessageBIP322(address, message, sig); err != nil {
+			t.Fatalf("VerifyMessageBIP322(%v): %v", addressType, err)
+		}
+	}
+	if remote.signs != 5 {
+		t.Fatalf("backend signed %d times, want 5", remote.signs)
+	}
+	if _, err := signer.SignMessageBIP322(message, P2PKH); !errors.Is(err, ErrUnsupportedAddress) || remote.signs != 5 {
+		t.Fatalf("SignMessageBIP322(P2PKH) error = %v", err)
+	}
+
+	digest := sha256.Sum256(message)
+	if _, err := local.SignTaproot(path, digest[:16]); !errors.Is(err, ErrInvalidDigest) {
+		t.Fatalf("short Taproot digest error = %v", err)
+	}
+	if _, err := local.SignECDSARecoverable(path, digest[:16]); !errors.Is(err, ErrInvalidDigest) {
+		t.Fatalf("short recoverable digest error = %v", err)
+	}
+}
@@ -0,0 +1,331 @@
+package bip32secp256k1
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+
+	internalsecp "github.com/islishude/bip32/v2/internal/secp256k1"
+)
+
+const (
+	bip322Tag = "BIP0322-signed-message"
+
+	sighashDefault = 0x00
+	sighashAll     = 0x01
+)
+
+// SignMessageBIP322 returns a BIP-322 "simple" signature of message for this
+// key's P2WPKH or P2TR address: the base64 witness stack of the virtual
+// to_sign transaction.
+//
+// P2WPKH signatures are deterministic ECDSA with Bitcoin Core's low-R
+// grinding, so they match Core's signmessage output byte for byte. P2TR
+// signatures are BIP-340 Schnorr signatures by the BIP-86 tweaked key with
+// fresh auxiliary randomness. Other address types need the "full" format and
+// return ErrUnsupportedAddress.
+func (k *XPrv) SignMessageBIP322(message []byte, addressType AddressType) (string, error) {
+	if k == nil {
+		return "", ErrNilKey
+	}
+	pub, err := k.PublicKey()
+	if err != nil {
+		return "", err
+	}
+	return signMessageBIP322(pub, message, addressType, func(digest *[32]byte) ([]byte, error) {
+		if addressType == P2TR {
+			return signTaproot(&k.key, digest)
+		}
+		sig, ok := signECDSALowR(&k.key, digest)
+		if !ok {
+			return nil, ErrInvalidXPrv
+		}
+		return encodeDER(&sig), nil
+	})
+}
+
+// SignMessageBIP322 is XPrv.SignMessageBIP322 for the backend key. P2WPKH
+// signatures come from Backend.SignECDSA, which need not grind for low R, so
+// they verify everywhere but may differ from Bitcoin Core's. P2TR signatures
+// come from Backend.SignTaproot.
+func (s *BackendSigner) SignMessageBIP322(message []byte, addressType AddressType) (string, error) {
+	if s == nil {
+		return "", ErrNilKey
+	}
+	return signMessageBIP322(s.pub, message, addressType, func(digest *[32]byte) ([]byte, error) {
+		if addressType == P2TR {
+			return s.backend.SignTaproot(s.path, digest[:])
+		}
+		return s.backend.SignECDSA(s.path, digest[:])
+	})
+}
+
+// signMessageBIP322 builds the witness for pub's address from sign, which
+// returns a DER ECDSA signature of the sighash for P2WPKH and a BIP-340
+// signature for P2TR.
+func signMessageBIP322(pub [PublicKeySize]byte, message []byte, addressType AddressType, sign func(digest *[32]byte) ([]byte, error)) (string, error) {
+	if addressType != P2WPKH && addressType != P2TR {
+		return "", ErrUnsupportedAddress
+	}
+	script, err := outputScript(pub, addressType)
+	if err != nil {
+		return "", err
+	}
+	toSpend := bip322ToSpendID(script, message)
+
+	var witness [][]byte
+	switch addressType {
+	case P2WPKH:
+		hash := bip322SegWitV0Sighash(&toSpend, hash160(pub[:]))
+		der, err := sign(&hash)
+		if err != nil {
+			return "", err
+		}
+		if _, ok := parseDER(der); !ok {
+			return "", ErrInvalidSignature
+		}
+		witness = [][]byte{append(der, sighashAll), pub[:]}
+	case P2TR:
+		hash := bip322TaprootSighash(&toSpend, script, sighashDefault)
+		sig, err := sign(&hash)
+		if err != nil {
+			return "", err
+		}
+		if len(sig) != SchnorrSignatureSize {
+			return "", ErrInvalidSignature
+		}
+		witness = [][]byte{sig}
+	}
+	return base64.StdEncoding.EncodeToString(encodeWitness(witness)), nil
+}
+
+// VerifyMessageBIP322 verifies a BIP-322 "simple" signature of message for a
+// P2WPKH or P2TR address on either network. Only the standard witness forms
+// are accepted: [DER signature || SIGHASH_ALL, compressed public key] for
+// P2WPKH, and a key-path Schnorr signature with SIGHASH_DEFAULT or
+// SIGHASH_ALL for P2TR. ECDSA signatures must be strict DER with low S.
+func VerifyMessageBIP322(address string, message []byte, signature string) error {
+	script, _, err := decodeAddress(address)
+	if err != nil {
+		return err
+	}
+	raw, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil {
+		return ErrInvalidSignature
+	}
+	witness, ok := decodeWitness(raw)
+	if !ok {
+		return ErrInvalidSignature
+	}
+	toSpend := bip322ToSpendID(script, message)
+
+	switch {
+	case len(script) == 22 && script[0] == 0x00:
+		if len(witness) != 2 || len(witness[1]) != PublicKeySize || len(witness[0]) == 0 {
+			return ErrInvalidSignature
+		}
+		pub := [PublicKeySize]byte(witness[1])
+		if hash160(pub[:]) != [20]byte(script[2:]) {
+			return ErrInvalidSignature
+		}
+		der, hashType := witness[0][:len(witness[0])-1], witness[0][len(witness[0])-1]
+		sig, ok := parseDER(der)
+		if !ok || hashType != sighashAll {
+			return ErrInvalidSignature
+		}
+		hash := bip322SegWitV0Sighash(&toSpend, hash160(pub[:]))
+		if !internalsecp.VerifyECDSA(&pub, &hash, &sig) {
+			return ErrInvalidSignature
+		}
+		return nil
+	case len(script) == 34 && script[0] == op1:
+		if len(witness) != 1 {
+			return ErrInvalidSignature
+		}
+		sig := witness[0]
+		switch {
+		case len(sig) == internalsecp.SignatureSize:
+		case len(sig) == internalsecp.SignatureSize+1 && sig[internalsecp.SignatureSize] == sighashAll:
+		default:
+			return ErrInvalidSignature
+		}
+		hashType := byte(sighashDefault)
+		if len(sig) > internalsecp.SignatureSize {
+			hashType = sighashAll
+		}
+		hash := bip322TaprootSighash(&toSpend, script, hashType)
+		if !internalsecp.VerifySchnorr((*[32]byte)(script[2:]), &hash, (*[internalsecp.SignatureSize]byte)(sig[:internalsecp.SignatureSize])) {
+			return ErrInvalidSignature
+		}
+		return nil
+	default:
+		return ErrUnsupportedAddress
+	}
+}
+
+// signECDSALowR grinds the RFC 6979 extra data the way Bitcoin Core does
+// until r fits in 32 DER bytes, which saves a byte in every witness.
+func signECDSALowR(key, hash *[32]byte) ([internalsecp.SignatureSize]byte, bool) {
+	sig, _, ok := internalsecp.SignECDSA(key, hash, nil)
+	var extra [32]byte
+	for counter := uint32(1); ok && sig[0] >= 0x80; counter++ {
+		binary.LittleEndian.PutUint32(extra[:], counter)
+		sig, _, ok = internalsecp.SignECDSA(key, hash, &extra)
+	}
+	return sig, ok
+}
+
+// signTaproot returns the BIP-340 signature of hash by the BIP-86 tweak of key,
+// with fresh auxiliary randomness.
+func signTaproot(key, hash *[32]byte) ([]byte, error) {
+	tweaked, ok := internalsecp.TaprootTweakPrivateKey(key, nil)
+	if !ok {
+		return nil, ErrInvalidXPrv
+	}
+	defer clear(tweaked[:])
+	var aux [32]byte
+	if _, err := rand.Read(aux[:]); err != nil {
+		return nil, err
+	}
+	sig, ok := internalsecp.SignSchnorr(&tweaked, hash, &aux)
+	if !ok {
+		return nil, ErrInvalidXPrv
+	}
+	return sig[:], nil
+}
+
+// bip322ToSpendID returns the internal-order txid of the virtual to_spend
+// transaction that commits to message and the signer's scriptPubKey.
+func bip322ToSpendID(script, message []byte) [32]byte {
+	messageHash := internalsecp.TaggedHash(bip322Tag, message)
+
+	tx := make([]byte, 0, 128+len(script))
+	tx = binary.LittleEndian.AppendUint32(tx, 0) // version
+	tx = append(tx, 1)                           // input count
+	tx = append(tx, make([]byte, 32)...)         // prevout hash
+	tx = binary.LittleEndian.AppendUint32(tx, 0xffffffff)
+	tx = append(tx, 34, 0x00, 32) // scriptSig: OP_0 PUSH32 messageHash
+	tx = append(tx, messageHash[:]...)
+	tx = binary.LittleEndian.AppendUint32(tx, 0) // sequence
+	tx = append(tx, 1)                           // output count
+	tx = binary.LittleEndian.AppendUint64(tx, 0) // value
+	tx = appendCompactSize(tx, uint64(len(script)))
+	tx = append(tx, script...)
+	tx = binary.LittleEndian.AppendUint32(tx, 0) // locktime
+	return sha256d(tx)
+}
+
+// The virtual to_sign transaction has version 0, one input spending
+// to_spend:0 with sequence 0, one zero-value OP_RETURN output and locktime
+// 0. These helpers serialize its parts for the signature hashes.
+
+func bip322Outpoint(toSpend *[32]byte) []byte {
+	return binary.LittleEndian.AppendUint32(append([]byte(nil), toSpend[:]...), 0)
+}
+
+func bip322Outputs() []byte {
+	return []byte{0, 0, 0, 0, 0, 0, 0, 0, 1, opReturn}
+}
+
+// bip322SegWitV0Sighash returns the BIP-143 SIGHASH_ALL digest of to_sign for
+// a P2WPKH input with the given key hash.
+func bip322SegWitV0Sighash(toSpend *[32]byte, keyHash [20]byte) [32]byte {
+	outpoint := bip322Outpoint(toSpend)
+	hashPrevouts := sha256d(outpoint)
+	hashSequence := sha256d([]byte{0, 0, 0, 0})
+	hashOutputs := sha256d(bip322Outputs())
+
+	preimage := make([]byte, 0, 4+32+32+36+26+8+4+32+4+4)
+	preimage = binary.LittleEndian.AppendUint32(preimage, 0) // version
+	preimage = append(preimage, hashPrevouts[:]...)
+	preimage = append(preimage, hashSequence[:]...)
+	preimage = append(preimage, outpoint...)
+	preimage = append(preimage, 25)
+	preimage = append(preimage, p2pkhScript(keyHash)...)     // scriptCode
+	preimage = binary.LittleEndian.AppendUint64(preimage, 0) // amount
+	preimage = binary.LittleEndian.AppendUint32(preimage, 0) // sequence
+	preimage = append(preimage, hashOutputs[:]...)
+	preimage = binary.LittleEndian.AppendUint32(preimage, 0) // locktime
+	preimage = binary.LittleEndian.AppendUint32(preimage, sighashAll)
+	return sha256d(preimage)
+}
+
+// bip322TaprootSighash returns the BIP-341 key-path digest of to_sign
+// spending an output with the given scriptPubKey. hashType is
+// SIGHASH_DEFAULT or SIGHASH_ALL, which commit to the same data.
+func bip322TaprootSighash(toSpend *[32]byte, script []byte, hashType byte) [32]byte {
+	shaPrevouts := sha256.Sum256(bip322Outpoint(toSpend))
+	shaAmounts := sha256.Sum256(make([]byte, 8))
+	shaScriptPubKeys := sha256.Sum256(append(appendCompactSize(nil, uint64(len(script))), script...))
+	shaSequences := sha256.Sum256([]byte{0, 0, 0, 0})
+	shaOutputs := sha256.Sum256(bip322Outputs())
+
+	msg := make([]byte, 0, 1+1+4+4+5*32+1+4)
+	msg = append(msg, 0x00, hashType)              // epoch, hash type
+	msg = binary.LittleEndian.AppendUint32(msg, 0) // version
+	msg = binary.LittleEndian.AppendUint32(msg, 0) // locktime
+	msg = append(msg, shaPrevouts[:]...)
+	msg = append(msg, shaAmounts[:]...)
+	msg = append(msg, shaScriptPubKeys[:]...)
+	msg = append(msg, shaSequences[:]...)
+	msg = append(msg, shaOutputs[:]...)
+	msg = append(msg, 0)                           // spend type: key path, no annex
+	msg = binary.LittleEndian.AppendUint32(msg, 0) // input index
+	return internalsecp.TaggedHash("TapSighash", msg)
+}
+
+func encodeWitness(items [][]byte) []byte {
+	out := appendCompactSize(nil, uint64(len(items)))
+	for _, item := range items {
+		out = appendCompactSize(out, uint64(len(item)))
+		out = append(out, item...)
+	}
+	return out
+}
+
+func decodeWitness(raw []byte) ([][]byte, bool) {
+	count, raw, ok := readCompactSize(raw)
+	if !ok || count > uint64(len(raw)) {
+		return nil, false
+	}
+	items := make([][]byte, 0, count)
+	for range count {
+		var size uint64
+		size, raw, ok = readCompactSize(raw)
+		if !ok || size > uint64(len(raw)) {
+			return nil, false
+		}
+		items = append(items, raw[:size])
+		raw = raw[size:]
+	}
+	return items, len(raw) == 0
+}
+
+// readCompactSize reads a canonically encoded variable-length integer.
+func readCompactSize(b []byte) (uint64, []byte, bool) {
+	if len(b) == 0 {
+		return 0, nil, false
+	}
+	var size int
+	switch b[0] {
+	case 0xfd:
+		size = 2
+	case 0xfe:
+		size = 4
+	case 0xff:
+		size = 8
+	default:
+		return uint64(b[0]), b[1:], true
+	}
+	if len(b) < 1+size {
+		return 0, nil, false
+	}
+	var buf [8]byte
+	copy(buf[:], b[1:1+size])
+	n := binary.LittleEndian.Uint64(buf[:])
+	if len(appendCompactSize(nil, n)) != 1+size {
+		return 0, nil, false
+	}
+	return n, b[1+size:], true
+}
@@ -0,0 +1,81 @@
+package bip32secp256k1
+
+import (
+	"sync"
+
+	internalsecp "github.com/islishude/bip32/v2/internal/secp256k1"
+)
+
+// keyCache memoizes the decoded public point, compressed public key, and
+// fingerprint of an extended key. It is created with the key, filled lazily,
+// and shared by copies of the key, all of which hold the same key material. It
+// holds only public values.
+type keyCache struct {
+	pointOnce sync.Once
+	point     internalsecp.PublicPoint
+	pub       [PublicKeySize]byte
+
+	fingerprintOnce sync.Once
+	fingerprint     [FingerprintSize]byte
+}
+
+func newKeyCache() *keyCache {
+	return new(keyCache)
+}
+
+// newKeyCacheWithPoint returns a cache pre-filled with an already known point,
+// such as a child point produced by public derivation.
+func newKeyCacheWithPoint(point internalsecp.PublicPoint) *keyCache {
+	c := new(keyCache)
+	c.pointOnce.Do(func() {
+		c.point = point
+		c.pub = point.Bytes()
+	})
+	return c
+}
+
+// resolvePoint returns the cached point, running compute on first use. A nil
+// cache, as found on zero-value keys, computes without memoizing.
+func (c *keyCache) resolvePoint(compute func() (internalsecp.PublicPoint, bool)) (*internalsecp.PublicPoint, [PublicKeySize]byte, bool) {
+	if c == nil {
+		point, ok := compute()
+		return &point, point.Bytes(), ok
+	}
+	c.pointOnce.Do(func() {
+		if point, ok := compute(); ok {
+			c.point = point
+			c.pub = point.Bytes()
+		}
+	})
+	return &c.point, c.pub, c.point.Valid()
+}
+
+func (c *keyCache) resolveFingerprint(pub [PublicKeySize]byte) [FingerprintSize]byte {
+	if c == nil {
+		return keyFingerprint(pub)
+	}
+	c.fingerprintOnce.Do(func() {
+		c.fingerprint = keyFingerprint(pub)
+	})
+	return c.fingerprint
+}
+
+// publicPoint returns the memoized public point of k.
+func (k *XPrv) publicPoint() (*internalsecp.PublicPoint, [PublicKeySize]byte, bool) {
+	return k.cache.resolvePoint(func() (internalsecp.PublicPoint, bool) {
+		return internalsecp.PublicPointFromScalar(&k.key)
+	})
+}
+
+// publicPoint returns the memoized decoded point of p.
+func (p *XPub) publicPoint() (*internalsecp.PublicPoint, bool) {
+	point, _, ok := p.cache.resolvePoint(func() (internalsecp.PublicPoint, bool) {
+		return internalsecp.ParsePublicKey(&p.pub)
+	})
+	return point, ok
+}
+
+// fingerprint returns the memoized fingerprint of this key's public key.
+func (p *XPub) fingerprint() [FingerprintSize]byte {
+	return p.cache.resolveFingerprint(p.pub)
+}
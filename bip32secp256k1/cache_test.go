@@ -0,0 +1,104 @@
+package bip32secp256k1
+
+import (
+	"bytes"
+	"sync"
+	"testing"
+)
+
+func TestKeyCacheMatchesUncached(t *testing.T) {
+	root := mustMaster(t, Mainnet)
+	xpub, err := root.XPub()
+	if err != nil {
+		t.Fatalf("XPub: %v", err)
+	}
+	// Keys built without a cache take the uncached fallback path.
+	bare := &XPrv{key: root.key, cc: root.cc, network: root.network}
+	barePub := &XPub{pub: xpub.pub, cc: xpub.cc, network: xpub.network}
+
+	for _, index := range []uint32{0, 1, HardenedOffset, HardenedOffset + 7} {
+		for range 2 {
+			want, err := bare.Derive(index)
+			if err != nil {
+				t.Fatalf("uncached Derive(%d): %v", index, err)
+			}
+			got, err := root.Derive(index)
+			if err != nil {
+				t.Fatalf("cached Derive(%d): %v", index, err)
+			}
+			if !bytes.Equal(got.Bytes(), want.Bytes()) {
+				t.Fatalf("cached private child %d differs", index)
+			}
+			if IsHardened(index) {
+				continue
+			}
+			wantPub, err := barePub.Derive(index)
+			if err != nil {
+				t.Fatalf("uncached public Derive(%d): %v", index, err)
+			}
+			gotPub, err := xpub.Derive(index)
+			if err != nil {
+				t.Fatalf("cached public Derive(%d): %v", index, err)
+			}
+			if !bytes.Equal(gotPub.Bytes(), wantPub.Bytes()) {
+				t.Fatalf("cached public child %d differs", index)
+			}
+		}
+	}
+
+	parsed, err := NewXPubFromBytes(xpub.Bytes())
+	if err != nil {
+		t.Fatalf("NewXPubFromBytes: %v", err)
+	}
+	if len(parsed.Bytes()) != SerializedKeySize || !bytes.Equal(parsed.Bytes(), xpub.Bytes()) {
+		t.Fatal("cache changed the serialized form")
+	}
+}
+
+func TestKeyCacheConcurrentDerive(t *testing.T) {
+	root := mustMaster(t, Mainnet)
+	want, err := (&XPrv{key: root.key, cc: root.cc, network: root.network}).Derive(3)
+	if err != nil {
+		t.Fatalf("Derive: %v", err)
+	}
+	xpub, err := root.XPub()
+	if err != nil {
+		t.Fatalf("XPub: %v", err)
+	}
+	wantPub, err := want.XPub()
+	if err != nil {
+		t.Fatalf("XPub: %v", err)
+	}
+
+	var wg sync.WaitGroup
+	errs := make(chan string, 32)
+	for range 16 {
+		wg.Go(func() {
+			child, err := root.Derive(3)
+			if err != nil || !bytes.Equal(child.Bytes(), want.Bytes()) {
+				errs <- "private child mismatch"
+			}
+			pub, err := xpub.Derive(3)
+			if err != nil || !bytes.Equal(pub.Bytes(), wantPub.Bytes()) {
+				errs <- "public child mismatch"
+			}
+		})
+	}
+	wg.Wait()
+	close(errs)
+	for msg := range errs {
+		t.Fatal(msg)
+	}
+}
+
+func BenchmarkXPrvDeriveRepeated(b *testing.B) {
+	root, err := NewMasterKey(testSeed, Mainnet)
+	if err != nil {
+		b.Fatal(err)
+	}
+	for b.Loop() {
+		if _, err := root.Derive(0); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -11,6 +11,8 @@ const (
 	ChainCodeSize = bip32.ChainCodeSize
 	// FingerprintSize is the width of a serialized parent fingerprint.
 	FingerprintSize = 4
+	// IdentifierSize is the width of a HASH160 key identifier.
+	IdentifierSize = 20
 	// SerializedKeySize is the BIP-32 binary extended-key payload size. It does
 	// not include the four-byte Base58Check checksum.
 	SerializedKeySize = 78
@@ -0,0 +1,45 @@
+package bip32secp256k1
+
+import bip32 "github.com/islishude/bip32/v2"
+
+var (
+	_ bip32.ExtendedPrivateKey[*XPrv, *XPub] = (*XPrv)(nil)
+	_ bip32.ExtendedPublicKey[*XPub]         = (*XPub)(nil)
+)
+
+// Curve returns bip32.CurveSecp256k1.
+func (k *XPrv) Curve() bip32.Curve {
+	return bip32.CurveSecp256k1
+}
+
+// Curve returns bip32.CurveSecp256k1.
+func (p *XPub) Curve() bip32.Curve {
+	return bip32.CurveSecp256k1
+}
+
+// PublicKeyBytes returns the compressed SEC 1 public key as a slice.
+func (k *XPrv) PublicKeyBytes() ([]byte, error) {
+	pub, err := k.PublicKey()
+	if err != nil {
+		return nil, err
+	}
+	return pub[:], nil
+}
+
+// PublicKeyBytes returns the compressed SEC 1 public key as a slice.
+func (p *XPub) PublicKeyBytes() []byte {
+	if p == nil {
+		return nil
+	}
+	return append([]byte(nil), p.pub[:]...)
+}
+
+// PathDepth returns Depth widened to uint32.
+func (k *XPrv) PathDepth() uint32 {
+	return uint32(k.Depth())
+}
+
+// PathDepth returns Depth widened to uint32.
+func (p *XPub) PathDepth() uint32 {
+	return uint32(p.Depth())
+}
@@ -0,0 +1,58 @@
+package bip32secp256k1
+
+import internalsecp "github.com/islishude/bip32/v2/internal/secp256k1"
+
+// encodeDER returns the ASN.1 DER encoding of a compact r || s ECDSA
+// signature, as carried in Bitcoin scripts and witnesses.
+func encodeDER(sig *[internalsecp.SignatureSize]byte) []byte {
+	r, s := derInteger(sig[:32]), derInteger(sig[32:])
+	out := make([]byte, 0, 6+len(r)+len(s))
+	out = append(out, 0x30, byte(4+len(r)+len(s)))
+	out = append(out, 0x02, byte(len(r)))
+	out = append(out, r...)
+	out = append(out, 0x02, byte(len(s)))
+	return append(out, s...)
+}
+
+// derInteger strips leading zeros from a big-endian value and re-adds one if
+// the top bit would otherwise mark it negative.
+func derInteger(value []byte) []byte {
+	for len(value) > 1 && value[0] == 0 {
+		value = value[1:]
+	}
+	if value[0]&0x80 != 0 {
+		return append([]byte{0}, value...)
+	}
+	return value
+}
+
+// parseDER strictly parses a DER ECDSA signature under the BIP-66 rules and
+// returns it as r || s. Range checks on r and s are left to verification.
+func parseDER(der []byte) (sig [internalsecp.SignatureSize]byte, ok bool) {
+	if len(der) < 8 || len(der) > 72 || der[0] != 0x30 || int(der[1]) != len(der)-2 {
+		return sig, false
+	}
+	rest := der[2:]
+	for half := range 2 {
+		if len(rest) < 2 || rest[0] != 0x02 {
+			return sig, false
+		}
+		size := int(rest[1])
+		if size == 0 || size > 33 || len(rest) < 2+size {
+			return sig, false
+		}
+		value := rest[2 : 2+size]
+		if value[0]&0x80 != 0 || size > 1 && value[0] == 0 && value[1]&0x80 == 0 {
+			return sig, false
+		}
+		if size == 33 {
+			if value[0] != 0 {
+				return sig, false
+			}
+			value = value[1:]
+		}
+		copy(sig[32*half+32-len(value):32*(half+1)], value)
+		rest = rest[2+size:]
+	}
+	return sig, len(rest) == 0
+}
@@ -16,9 +16,9 @@ func (k *XPrv) derive(index uint32, mac hmac512Func) (*XPrv, error) {
 		return nil, ErrDepthOverflow
 	}
 
-	parentPub, err := k.PublicKey()
-	if err != nil {
-		return nil, err
+	_, parentPub, ok := k.publicPoint()
+	if !ok {
+		return nil, ErrInvalidXPrv
 	}
 
 	var data [PublicKeySize + 4]byte
@@ -46,8 +46,9 @@ func (k *XPrv) derive(index uint32, mac hmac512Func) (*XPrv, error) {
 		key:               childKey,
 		network:           k.network,
 		depth:             k.depth + 1,
-		parentFingerprint: keyFingerprint(parentPub),
+		parentFingerprint: k.cache.resolveFingerprint(parentPub),
 		childNumber:       index,
+		cache:             newKeyCache(),
 	}
 	copy(child.cc[:], i[PrivateKeySize:])
 	clear(childKey[:])
@@ -2,6 +2,14 @@
 // deterministic extended keys over secp256k1.
 //
 // It supports normal and hardened private derivation, normal public derivation,
-// standard xprv/xpub/tprv/tpub serialization, and explicit path derivation. It
-// does not implement ECDSA signing or SLIP-132 version families.
+// standard xprv/xpub/tprv/tpub serialization, and explicit path derivation.
+// It also provides ECDH and ECIES encryption between derived keys, Bitcoin
+// addresses, "Bitcoin Signed Message" and BIP-322 simple message signatures,
+// MuSig2 (BIP-327) multi-signatures over aggregate keys that can be derived as
+// BIP-328 extended keys, BIP-48/BIP-67 sorted multisig scripts and addresses,
+// password-encrypted keystore files, Ethereum addresses and keystore V3
+// export, and crypto.Signer adapters for ECDSA over in-memory keys or a
+// pluggable signing Backend, which also signs messages with recoverable ECDSA
+// and taproot Schnorr signatures. It does not sign transactions or implement
+// SLIP-132 version families.
 package bip32secp256k1
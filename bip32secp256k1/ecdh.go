@@ -0,0 +1,25 @@
+package bip32secp256k1
+
+import internalsecp "github.com/islishude/bip32/v2/internal/secp256k1"
+
+// SharedSecretSize is the byte length of an ECDH shared secret.
+const SharedSecretSize = 32
+
+// ECDH returns the SEC 1 Diffie-Hellman shared secret between this key and a
+// peer's compressed public key: the x-coordinate of privateKey*peer, as
+// crypto/ecdh returns for NIST curves. The multiplication is constant time in
+// the private key. The raw secret is not uniformly random; pass it through a
+// KDF such as HKDF before using it as a symmetric key.
+func (k *XPrv) ECDH(peer [PublicKeySize]byte) ([SharedSecretSize]byte, error) {
+	if k == nil {
+		return [SharedSecretSize]byte{}, ErrNilKey
+	}
+	if !internalsecp.ValidPrivateScalar(&k.key) {
+		return [SharedSecretSize]byte{}, ErrInvalidXPrv
+	}
+	secret, ok := internalsecp.ECDH(&k.key, &peer)
+	if !ok {
+		return [SharedSecretSize]byte{}, ErrInvalidPublicKey
+	}
+	return secret, nil
+}
@@ -0,0 +1,127 @@
+package bip32secp256k1
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hkdf"
+	"crypto/rand"
+	"crypto/sha256"
+	"io"
+
+	internalsecp "github.com/islishude/bip32/v2/internal/secp256k1"
+)
+
+// eciesInfo is the HKDF info prefix that binds derived keys to this scheme.
+const eciesInfo = "bip32secp256k1 ECIES HKDF-SHA256 AES-256-GCM"
+
+const (
+	eciesKeySize   = 32
+	eciesNonceSize = 12
+	eciesTagSize   = 16
+)
+
+// ECIESOverhead is the number of bytes Encrypt adds to a plaintext: a
+// compressed ephemeral public key and an AES-GCM tag.
+const ECIESOverhead = PublicKeySize + eciesTagSize
+
+// Encrypt encrypts plaintext to this extended public key with ECIES.
+//
+// A fresh ephemeral key pair is drawn from r, or crypto/rand.Reader when r is
+// nil. The ECDH shared secret is expanded with HKDF-SHA256, using the
+// ephemeral and recipient public keys as info, into an AES-256-GCM key and
+// nonce that are used exactly once. The result is ephemeralPublicKey ||
+// ciphertext || tag. additionalData is authenticated but not encrypted and
+// must be passed unchanged to Decrypt.
+func (p *XPub) Encrypt(r io.Reader, plaintext, additionalData []byte) ([]byte, error) {
+	if p == nil {
+		return nil, ErrNilKey
+	}
+	if r == nil {
+		r = rand.Reader
+	}
+
+	var ephemeral [PrivateKeySize]byte
+	defer clear(ephemeral[:])
+	for {
+		if _, err := io.ReadFull(r, ephemeral[:]); err != nil {
+			return nil, err
+		}
+		// Out-of-range draws are rejected rather than reduced to avoid bias.
+		if internalsecp.ValidPrivateScalar(&ephemeral) {
+			break
+		}
+	}
+	ephemeralPub, _ := internalsecp.PublicKeyFromScalar(&ephemeral)
+
+	secret, ok := internalsecp.ECDH(&ephemeral, &p.pub)
+	if !ok {
+		return nil, ErrInvalidXPub
+	}
+	defer clear(secret[:])
+	aead, nonce, err := eciesCipher(secret[:], &ephemeralPub, &p.pub)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]byte, PublicKeySize, PublicKeySize+len(plaintext)+eciesTagSize)
+	copy(out, ephemeralPub[:])
+	return aead.Seal(out, nonce[:], plaintext, additionalData), nil
+}
+
+// Decrypt decrypts an ECIES ciphertext produced by Encrypt for this key's
+// public key. Any tampering with the ciphertext or additionalData, or use of
+// the wrong key, returns ErrDecryption.
+func (k *XPrv) Decrypt(ciphertext, additionalData []byte) ([]byte, error) {
+	if k == nil {
+		return nil, ErrNilKey
+	}
+	if len(ciphertext) < ECIESOverhead {
+		return nil, ErrDecryption
+	}
+	ephemeralPub := [PublicKeySize]byte(ciphertext[:PublicKeySize])
+	_, pub, ok := k.publicPoint()
+	if !ok {
+		return nil, ErrInvalidXPrv
+	}
+
+	secret, ok := internalsecp.ECDH(&k.key, &ephemeralPub)
+	if !ok {
+		return nil, ErrDecryption
+	}
+	defer clear(secret[:])
+	aead, nonce, err := eciesCipher(secret[:], &ephemeralPub, &pub)
+	if err != nil {
+		return nil, err
+	}
+
+	plaintext, err := aead.Open(nil, nonce[:], ciphertext[PublicKeySize:], additionalData)
+	if err != nil {
+		return nil, ErrDecryption
+	}
+	return plaintext, nil
+}
+
+func eciesCipher(secret []byte, ephemeralPub, recipientPub *[PublicKeySize]byte) (cipher.AEAD, [eciesNonceSize]byte, error) {
+	var nonce [eciesNonceSize]byte
+	info := make([]byte, 0, len(eciesInfo)+2*PublicKeySize)
+	info = append(info, eciesInfo...)
+	info = append(info, ephemeralPub[:]...)
+	info = append(info, recipientPub[:]...)
+
+	okm, err := hkdf.Key(sha256.New, secret, nil, string(info), eciesKeySize+eciesNonceSize)
+	if err != nil {
+		return nil, nonce, err
+	}
+	defer clear(okm)
+	copy(nonce[:], okm[eciesKeySize:])
+
+	block, err := aes.NewCipher(okm[:eciesKeySize])
+	if err != nil {
+		return nil, nonce, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, nonce, err
+	}
+	return aead, nonce, nil
+}
@@ -0,0 +1,119 @@
+package bip32secp256k1
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+func TestECDHBetweenDerivedKeys(t *testing.T) {
+	root := mustMaster(t, Mainnet)
+	alice, err := root.DerivePath("m/0'/1")
+	if err != nil {
+		t.Fatalf("DerivePath: %v", err)
+	}
+	bob, err := root.DerivePath("m/0'/2")
+	if err != nil {
+		t.Fatalf("DerivePath: %v", err)
+	}
+	alicePub, _ := alice.PublicKey()
+	bobPub, _ := bob.PublicKey()
+
+	ab, err := alice.ECDH(bobPub)
+	if err != nil {
+		t.Fatalf("alice ECDH: %v", err)
+	}
+	ba, err := bob.ECDH(alicePub)
+	if err != nil {
+		t.Fatalf("bob ECDH: %v", err)
+	}
+	if ab != ba {
+		t.Fatal("ECDH shared secrets differ")
+	}
+	if _, err := alice.ECDH([PublicKeySize]byte{0x02}); !errors.Is(err, ErrInvalidPublicKey) {
+		t.Fatalf("invalid peer error = %v", err)
+	}
+	var nilKey *XPrv
+	if _, err := nilKey.ECDH(bobPub); !errors.Is(err, ErrNilKey) {
+		t.Fatalf("nil key error = %v", err)
+	}
+}
+
+func TestECIESRoundTrip(t *testing.T) {
+	root := mustMaster(t, Mainnet)
+	customer, err := root.DerivePath("m/7'/0")
+	if err != nil {
+		t.Fatalf("DerivePath: %v", err)
+	}
+	customerPub, err := customer.XPub()
+	if err != nil {
+		t.Fatalf("XPub: %v", err)
+	}
+	other, err := root.DerivePath("m/7'/1")
+	if err != nil {
+		t.Fatalf("DerivePath: %v", err)
+	}
+
+	plaintext := []byte("per-customer payload")
+	aad := []byte("invoice 42")
+	ciphertext, err := customerPub.Encrypt(nil, plaintext, aad)
+	if err != nil {
+		t.Fatalf("Encrypt: %v", err)
+	}
+	if len(ciphertext) != len(plaintext)+ECIESOverhead {
+		t.Fatalf("ciphertext length = %d, want %d", len(ciphertext), len(plaintext)+ECIESOverhead)
+	}
+	got, err := customer.Decrypt(ciphertext, aad)
+	if err != nil {
+		t.Fatalf("Decrypt: %v", err)
+	}
+	if !bytes.Equal(got, plaintext) {
+		t.Fatalf("Decrypt = %q, want %q", got, plaintext)
+	}
+
+	again, err := customerPub.Encrypt(nil, plaintext, aad)
+	if err != nil {
+		t.Fatalf("Encrypt: %v", err)
+	}
+	if bytes.Equal(again, ciphertext) {
+		t.Fatal("Encrypt reused an ephemeral key")
+	}
+
+	tampered := bytes.Clone(ciphertext)
+	tampered[len(tampered)-1] ^= 1
+	for name, tc := range map[string]struct {
+		key        *XPrv
+		ciphertext []byte
+		aad        []byte
+	}{
+		"tampered":  {customer, tampered, aad},
+		"wrong aad": {customer, ciphertext, []byte("invoice 43")},
+		"wrong key": {other, ciphertext, aad},
+		"truncated": {customer, ciphertext[:ECIESOverhead-1], aad},
+	} {
+		if _, err := tc.key.Decrypt(tc.ciphertext, tc.aad); !errors.Is(err, ErrDecryption) {
+			t.Fatalf("%s: Decrypt error = %v, want %v", name, err, ErrDecryption)
+		}
+	}
+}
+
+func TestECIESRejectsOutOfRangeEphemeral(t *testing.T) {
+	xpub, err := mustMaster(t, Mainnet).XPub()
+	if err != nil {
+		t.Fatalf("XPub: %v", err)
+	}
+	// The first draw is zero and must be skipped rather than reduced.
+	r := bytes.NewReader(append(make([]byte, PrivateKeySize), bytes.Repeat([]byte{7}, PrivateKeySize)...))
+	ciphertext, err := xpub.Encrypt(r, nil, nil)
+	if err != nil {
+		t.Fatalf("Encrypt: %v", err)
+	}
+	seven := [PrivateKeySize]byte(bytes.Repeat([]byte{7}, PrivateKeySize))
+	want, _ := (&XPrv{key: seven}).PublicKey()
+	if !bytes.Equal(ciphertext[:PublicKeySize], want[:]) {
+		t.Fatal("ephemeral key did not come from the second draw")
+	}
+	if _, err := xpub.Encrypt(bytes.NewReader(nil), nil, nil); err == nil {
+		t.Fatal("Encrypt succeeded with an empty random source")
+	}
+}
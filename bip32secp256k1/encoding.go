@@ -0,0 +1,133 @@
+package bip32secp256k1
+
+import (
+	"database/sql/driver"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+)
+
+// MarshalText returns the xpub or tpub Base58Check representation.
+func (p *XPub) MarshalText() ([]byte, error) {
+	encoded, err := p.Encode()
+	if err != nil {
+		return nil, err
+	}
+	return []byte(encoded), nil
+}
+
+// UnmarshalText parses an xpub or tpub value into p.
+func (p *XPub) UnmarshalText(text []byte) error {
+	if p == nil {
+		return ErrNilKey
+	}
+	parsed, err := ParseXPub(string(text))
+	if err != nil {
+		return err
+	}
+	*p = *parsed
+	return nil
+}
+
+// MarshalJSON returns the xpub or tpub representation as a JSON string.
+func (p *XPub) MarshalJSON() ([]byte, error) {
+	encoded, err := p.Encode()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(encoded)
+}
+
+// UnmarshalJSON parses a JSON string holding an xpub or tpub value. A JSON
+// null leaves p unchanged.
+func (p *XPub) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var encoded string
+	if err := json.Unmarshal(data, &encoded); err != nil {
+		return ErrInvalidEncoding
+	}
+	return p.UnmarshalText([]byte(encoded))
+}
+
+// MarshalBinary returns the 78-byte payload from Bytes.
+func (p *XPub) MarshalBinary() ([]byte, error) {
+	if p == nil {
+		return nil, ErrNilKey
+	}
+	payload := p.Bytes()
+	if payload == nil {
+		return nil, ErrInvalidXPub
+	}
+	return payload, nil
+}
+
+// UnmarshalBinary parses a 78-byte extended-public-key payload into p.
+func (p *XPub) UnmarshalBinary(data []byte) error {
+	if p == nil {
+		return ErrNilKey
+	}
+	parsed, err := NewXPubFromBytes(data)
+	if err != nil {
+		return err
+	}
+	*p = *parsed
+	return nil
+}
+
+// Value stores p as its xpub or tpub string. A nil p is stored as SQL NULL.
+func (p *XPub) Value() (driver.Value, error) {
+	if p == nil {
+		return nil, nil
+	}
+	return p.Encode()
+}
+
+// Scan reads an xpub or tpub string, or a 78-byte binary payload, from a
+// database column. Use sql.Null[XPub] for nullable columns.
+func (p *XPub) Scan(src any) error {
+	switch src := src.(type) {
+	case string:
+		return p.UnmarshalText([]byte(src))
+	case []byte:
+		if len(src) == SerializedKeySize {
+			return p.UnmarshalBinary(src)
+		}
+		return p.UnmarshalText(src)
+	default:
+		return fmt.Errorf("%w: cannot scan %T", ErrInvalidEncoding, src)
+	}
+}
+
+// Format writes a redacted placeholder showing only the key fingerprint and
+// depth, whatever the verb, so an XPrv passed to fmt or a logger never prints
+// its private key or chain code. Format, GoString, and LogValue have value
+// receivers so that XPrv values and structs holding them are covered as well
+// as pointers.
+func (k XPrv) Format(f fmt.State, _ rune) {
+	_, _ = f.Write([]byte(k.redacted()))
+}
+
+// GoString returns the same redacted placeholder as Format.
+func (k XPrv) GoString() string {
+	return k.redacted()
+}
+
+// LogValue returns the same redacted placeholder as Format for log/slog.
+func (k XPrv) LogValue() slog.Value {
+	return slog.StringValue(k.redacted())
+}
+
+func (k *XPrv) redacted() string {
+	if k == nil {
+		return "<nil>"
+	}
+	pub, err := k.PublicKey()
+	if err != nil {
+		return "bip32secp256k1.XPrv{invalid}"
+	}
+	fingerprint := k.cache.resolveFingerprint(pub)
+	return fmt.Sprintf("bip32secp256k1.XPrv{fingerprint: %s, depth: %d, key: REDACTED}", hex.EncodeToString(fingerprint[:]), k.depth)
+}
@@ -0,0 +1,188 @@
+package bip32secp256k1
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+var (
+	_ encoding.TextMarshaler     = (*XPub)(nil)
+	_ encoding.TextUnmarshaler   = (*XPub)(nil)
+	_ encoding.BinaryMarshaler   = (*XPub)(nil)
+	_ encoding.BinaryUnmarshaler = (*XPub)(nil)
+	_ json.Marshaler             = (*XPub)(nil)
+	_ json.Unmarshaler           = (*XPub)(nil)
+	_ driver.Valuer              = (*XPub)(nil)
+	_ sql.Scanner                = (*XPub)(nil)
+	_ fmt.Formatter              = XPrv{}
+	_ fmt.GoStringer             = XPrv{}
+	_ slog.LogValuer             = XPrv{}
+)
+
+func TestXPubMarshaling(t *testing.T) {
+	account, err := mustMaster(t, Mainnet).DerivePath("m/44'/0'/0'")
+	if err != nil {
+		t.Fatalf("DerivePath: %v", err)
+	}
+	xpub, err := account.XPub()
+	if err != nil {
+		t.Fatalf("XPub: %v", err)
+	}
+	encoded, err := xpub.Encode()
+	if err != nil {
+		t.Fatalf("Encode: %v", err)
+	}
+
+	type record struct {
+		Key      *XPub `json:"key"`
+		Optional *XPub `json:"optional"`
+	}
+	data, err := json.Marshal(record{Key: xpub})
+	if err != nil {
+		t.Fatalf("json.Marshal: %v", err)
+	}
+	if want := `{"key":"` + encoded + `","optional":null}`; string(data) != want {
+		t.Fatalf("json = %s, want %s", data, want)
+	}
+	var decoded record
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("json.Unmarshal: %v", err)
+	}
+	if decoded.Optional != nil || !bytes.Equal(decoded.Key.Bytes(), xpub.Bytes()) {
+		t.Fatal("JSON round trip changed the key")
+	}
+	// The decoded key must be fully usable, including its derivation cache.
+	want, _ := xpub.Derive(7)
+	got, err := decoded.Key.Derive(7)
+	if err != nil || !bytes.Equal(got.Bytes(), want.Bytes()) {
+		t.Fatalf("decoded Derive = %v", err)
+	}
+
+	binary, err := xpub.MarshalBinary()
+	if err != nil || !bytes.Equal(binary, xpub.Bytes()) {
+		t.Fatalf("MarshalBinary = %x, %v", binary, err)
+	}
+	var fromBinary XPub
+	if err := fromBinary.UnmarshalBinary(binary); err != nil || !bytes.Equal(fromBinary.Bytes(), binary) {
+		t.Fatalf("UnmarshalBinary = %v", err)
+	}
+
+	value, err := xpub.Value()
+	if err != nil || value != encoded {
+		t.Fatalf("Value = %v, %v", value, err)
+	}
+	var nilXPub *XPub
+	if value, err := nilXPub.Value(); value != nil || err != nil {
+		t.Fatalf("nil Value = %v, %v", value, err)
+	}
+	for _, src := range []any{encoded, []byte(encoded), binary} {
+		var scanned XPub
+		if err := scanned.Scan(src); err != nil || !bytes.Equal(scanned.Bytes(), binary) {
+			t.Fatalf("Scan(%T) = %v", src, err)
+		}
+	}
+
+	var target XPub
+	for _, tc := range []struct {
+		name string
+		err  error
+		want error
+	}{
+		{"text", target.UnmarshalText([]byte("xpub")), ErrInvalidEncoding},
+		{"json number", target.UnmarshalJSON([]byte("1")), ErrInvalidEncoding},
+		{"binary", target.UnmarshalBinary(binary[:10]), ErrInvalidXPub},
+		{"private text", target.UnmarshalText([]byte(mustEncode(t, account))), ErrInvalidXPub},
+		{"scan int", target.Scan(int64(1)), ErrInvalidEncoding},
+		{"scan nil", target.Scan(nil), ErrInvalidEncoding},
+		{"nil receiver", nilXPub.UnmarshalText([]byte(encoded)), ErrNilKey},
+	} {
+		if !errors.Is(tc.err, tc.want) {
+			t.Fatalf("%s: error = %v, want %v", tc.name, tc.err, tc.want)
+		}
+	}
+	if target.Bytes() != nil {
+		t.Fatal("failed unmarshal modified the receiver")
+	}
+	if _, err := nilXPub.MarshalText(); !errors.Is(err, ErrNilKey) {
+		t.Fatalf("nil MarshalText error = %v", err)
+	}
+}
+
+func TestXPrvRedactedFormatting(t *testing.T) {
+	root := mustMaster(t, Mainnet)
+	account, err := root.DerivePath("m/44'/0'/0'")
+	if err != nil {
+		t.Fatalf("DerivePath: %v", err)
+	}
+	xpub, err := account.XPub()
+	if err != nil {
+		t.Fatalf("XPub: %v", err)
+	}
+	fingerprint := xpub.fingerprint()
+	want := "bip32secp256k1.XPrv{fingerprint: " + hex.EncodeToString(fingerprint[:]) + ", depth: 3, key: REDACTED}"
+
+	secrets := []string{mustEncode(t, account), hex.EncodeToString(account.PrivateKey()), hex.EncodeToString(account.ChainCode())}
+	type holder struct {
+		Name string
+		XPrv
+	}
+	embedded := holder{Name: "account", XPrv: *account}
+	for _, verb := range []string{"%v", "%+v", "%#v", "%s", "%x", "%q", "%d"} {
+		if got := fmt.Sprintf(verb, account); got != want {
+			t.Fatalf("Sprintf(%s) = %s, want %s", verb, got, want)
+		}
+		if got := fmt.Sprintf(verb, *account); got != want {
+			t.Fatalf("Sprintf(%s) of a value = %s, want %s", verb, got, want)
+		}
+		if got := fmt.Sprintf(verb, embedded); !strings.Contains(got, want) {
+			t.Fatalf("Sprintf(%s) of an embedding struct = %s", verb, got)
+		}
+		if got := fmt.Sprintf(verb, []XPrv{*account}); !strings.Contains(got, want) {
+			t.Fatalf("Sprintf(%s) of a slice = %s", verb, got)
+		}
+	}
+
+	var logs bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&logs, nil))
+	logger.Info("derived", "key", account)
+	logger.Info("derived", "key", *account)
+	if got := strings.Count(logs.String(), `"key":"`+want+`"`); got != 2 {
+		t.Fatalf("slog output = %s", logs.String())
+	}
+	slog.New(slog.NewTextHandler(&logs, nil)).Info("derived", "holder", embedded)
+	if !strings.Contains(logs.String(), want) {
+		t.Fatalf("slog output = %s", logs.String())
+	}
+	for _, secret := range secrets {
+		if strings.Contains(logs.String(), secret) {
+			t.Fatal("slog output leaked key material")
+		}
+	}
+
+	var nilKey *XPrv
+	if got := fmt.Sprint(nilKey); got != "<nil>" {
+		t.Fatalf("nil XPrv = %s", got)
+	}
+	account.Wipe()
+	if got := fmt.Sprint(account); got != "bip32secp256k1.XPrv{invalid}" {
+		t.Fatalf("wiped XPrv = %s", got)
+	}
+}
+
+func mustEncode(t *testing.T, k *XPrv) string {
+	t.Helper()
+	encoded, err := k.Encode()
+	if err != nil {
+		t.Fatalf("Encode: %v", err)
+	}
+	return encoded
+}
@@ -29,4 +29,46 @@ var (
 	ErrNotRoot = errors.New("bip32secp256k1: absolute derivation requires a root key")
 	// ErrHardenedFromXPub reports hardened public-child derivation.
 	ErrHardenedFromXPub = errors.New("bip32secp256k1: cannot derive hardened child from xpub")
+	// ErrHardenedChild reports parent recovery from a hardened child.
+	ErrHardenedChild = errors.New("bip32secp256k1: cannot recover parent from hardened child")
+	// ErrNotChild reports parent recovery from a key that is not its child.
+	ErrNotChild = errors.New("bip32secp256k1: key is not a child of parent")
+	// ErrInvalidCapacity reports a non-positive Keychain cache capacity.
+	ErrInvalidCapacity = errors.New("bip32secp256k1: invalid keychain capacity")
+	// ErrInvalidPublicKey reports a peer key that is not a compressed point.
+	ErrInvalidPublicKey = errors.New("bip32secp256k1: invalid public key")
+	// ErrInvalidAddress reports a malformed Bitcoin address.
+	ErrInvalidAddress = errors.New("bip32secp256k1: invalid address")
+	// ErrUnsupportedAddress reports an address type an operation cannot use.
+	ErrUnsupportedAddress = errors.New("bip32secp256k1: unsupported address type")
+	// ErrInvalidDigest reports an ECDSASigner digest that is not 32 bytes or
+	// does not match the size of the hash named by the signer options.
+	ErrInvalidDigest = errors.New("bip32secp256k1: invalid digest")
+	// ErrInvalidSignature reports a malformed or non-verifying signature.
+	ErrInvalidSignature = errors.New("bip32secp256k1: invalid signature")
+	// ErrInvalidNonce reports a malformed MuSig2 nonce or one made for
+	// another key.
+	ErrInvalidNonce = errors.New("bip32secp256k1: invalid MuSig2 nonce")
+	// ErrNonceReused reports a MuSig2 secret nonce that was already used.
+	ErrNonceReused = errors.New("bip32secp256k1: MuSig2 nonce already used")
+	// ErrNotParticipant reports a key that is not part of a MuSig2 aggregate.
+	ErrNotParticipant = errors.New("bip32secp256k1: key is not a MuSig2 participant")
+	// ErrTweakedKey reports BIP-328 derivation from a tweaked aggregate key.
+	ErrTweakedKey = errors.New("bip32secp256k1: aggregate key has been tweaked")
+	// ErrInvalidThreshold reports a multisig threshold or key count that
+	// OP_CHECKMULTISIG cannot express.
+	ErrInvalidThreshold = errors.New("bip32secp256k1: invalid multisig threshold")
+	// ErrCosignerDepth reports multisig cosigners at different depths.
+	ErrCosignerDepth = errors.New("bip32secp256k1: cosigner depth mismatch")
+	// ErrDuplicateCosigner reports the same key used by two cosigners.
+	ErrDuplicateCosigner = errors.New("bip32secp256k1: duplicate cosigner")
+	// ErrInvalidKeystore reports a malformed keystore, one for another curve,
+	// or one whose metadata does not match its key.
+	ErrInvalidKeystore = errors.New("bip32secp256k1: invalid keystore")
+	// ErrUnsupportedKeystore reports an unknown keystore version, KDF or
+	// cipher, or KDF costs outside the accepted bounds.
+	ErrUnsupportedKeystore = errors.New("bip32secp256k1: unsupported keystore")
+	// ErrDecryption reports an ECIES ciphertext or keystore that failed to
+	// authenticate.
+	ErrDecryption = errors.New("bip32secp256k1: message authentication failed")
 )
@@ -0,0 +1,296 @@
+package bip32secp256k1
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	internalsecp "github.com/islishude/bip32/v2/internal/secp256k1"
+	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/crypto/scrypt"
+	"golang.org/x/crypto/sha3"
+)
+
+const (
+	ethereumKeystoreVersion = 3
+	ethereumKeySize         = 32
+
+	// The standard costs written by go-ethereum.
+	ethereumScryptN          = 1 << 18
+	ethereumScryptR          = 8
+	ethereumScryptP          = 1
+	ethereumPBKDF2Iterations = 1 << 18
+
+	// Bounds on the costs an opened file can demand.
+	maxEthereumScryptN          = 1 << 20
+	maxEthereumScryptR          = 32
+	maxEthereumScryptP          = 16
+	maxEthereumScryptMemory     = 1 << 30 // bytes, 128·N·r
+	maxEthereumScryptWork       = 1 << 24 // N·r·p
+	maxEthereumPBKDF2Iterations = 10_000_000
+	maxEthereumSaltSize         = 64
+)
+
+// EthereumKDF selects the key derivation function of an Ethereum keystore.
+type EthereumKDF uint8
+
+const (
+	// EthereumScrypt selects scrypt with N=2^18, r=8 and p=1. It is the
+	// default.
+	EthereumScrypt EthereumKDF = iota + 1
+	// EthereumPBKDF2 selects PBKDF2-HMAC-SHA256 with 2^18 iterations.
+	EthereumPBKDF2
+)
+
+// EthereumKeystoreOptions configures SealEthereumKeystore. The zero value
+// selects scrypt and crypto/rand.Reader.
+type EthereumKeystoreOptions struct {
+	KDF EthereumKDF
+	// Rand supplies the salt, IV and keystore id.
+	Rand io.Reader
+}
+
+type ethereumKeystore struct {
+	Address string         `json:"address,omitempty"`
+	Crypto  ethereumCrypto `json:"crypto"`
+	ID      string         `json:"id"`
+	Version int            `json:"version"`
+}
+
+type ethereumCrypto struct {
+	Cipher       string `json:"cipher"`
+	CipherParams struct {
+		IV string `json:"iv"`
+	} `json:"cipherparams"`
+	CipherText string          `json:"ciphertext"`
+	KDF        string          `json:"kdf"`
+	KDFParams  json.RawMessage `json:"kdfparams"`
+	MAC        string          `json:"mac"`
+}
+
+type ethereumKDFParams struct {
+	DKLen int    `json:"dklen"`
+	Salt  string `json:"salt"`
+	N     int    `json:"n,omitempty"`
+	R     int    `json:"r,omitempty"`
+	P     int    `json:"p,omitempty"`
+	C     int    `json:"c,omitempty"`
+	PRF   string `json:"prf,omitempty"`
+}
+
+// EthereumAddress returns the EIP-55 checksummed Ethereum address of the
+// public key: the last 20 bytes of the Keccak-256 hash of its uncompressed
+// coordinates.
+func (p *XPub) EthereumAddress() (string, error) {
+	if p == nil {
+		return "", ErrNilKey
+	}
+	point, ok := p.publicPoint()
+	if !ok {
+		return "", ErrInvalidXPub
+	}
+	address := ethereumAddress(point.UncompressedBytes())
+	return "0x" + eip55(hex.EncodeToString(address[:])), nil
+}
+
+// SealEthereumKeystore exports the private key of k as a Web3 Secret Storage
+// (Ethereum keystore V3) JSON file: the password is stretched with scrypt or
+// PBKDF2, the key is encrypted with AES-128-CTR, and the ciphertext is
+// authenticated with a Keccak-256 MAC. Only the 32-byte private key is
+// exported; the chain code and BIP-32 metadata are not. The address field is
+// that of k's public key. opts may be nil.
+func (k *XPrv) SealEthereumKeystore(password []byte, opts *EthereumKeystoreOptions) ([]byte, error) {
+	if k == nil {
+		return nil, ErrNilKey
+	}
+	if opts == nil {
+		opts = &EthereumKeystoreOptions{}
+	}
+	r := opts.Rand
+	if r == nil {
+		r = rand.Reader
+	}
+	point, _, ok := k.publicPoint()
+	if !ok {
+		return nil, ErrInvalidXPrv
+	}
+	address := ethereumAddress(point.UncompressedBytes())
+
+	var random [32 + aes.BlockSize + 16]byte
+	if _, err := io.ReadFull(r, random[:]); err != nil {
+		return nil, err
+	}
+	salt, iv, id := random[:32], random[32:32+aes.BlockSize], random[32+aes.BlockSize:]
+	params := ethereumKDFParams{DKLen: 32, Salt: hex.EncodeToString(salt)}
+	var kdf string
+	switch opts.KDF {
+	case 0, EthereumScrypt:
+		kdf = "scrypt"
+		params.N, params.R, params.P = ethereumScryptN, ethereumScryptR, ethereumScryptP
+	case EthereumPBKDF2:
+		kdf = "pbkdf2"
+		params.C, params.PRF = ethereumPBKDF2Iterations, "hmac-sha256"
+	default:
+		return nil, ErrUnsupportedKeystore
+	}
+	derived, err := ethereumDeriveKey(kdf, &params, salt, password)
+	if err != nil {
+		return nil, err
+	}
+	defer clear(derived)
+
+	ciphertext := make([]byte, ethereumKeySize)
+	if err := ethereumCTR(derived[:16], iv, ciphertext, k.key[:]); err != nil {
+		return nil, err
+	}
+	mac := ethereumMAC(derived, ciphertext)
+	rawParams, err := json.Marshal(params)
+	if err != nil {
+		return nil, err
+	}
+
+	// The id is a random (version 4) UUID.
+	id[6] = id[6]&0x0f | 0x40
+	id[8] = id[8]&0x3f | 0x80
+	out := ethereumKeystore{
+		Address: hex.EncodeToString(address[:]),
+		ID:      fmt.Sprintf("%x-%x-%x-%x-%x", id[0:4], id[4:6], id[6:8], id[8:10], id[10:16]),
+		Version: ethereumKeystoreVersion,
+	}
+	out.Crypto = ethereumCrypto{
+		Cipher:     "aes-128-ctr",
+		CipherText: hex.EncodeToString(ciphertext),
+		KDF:        kdf,
+		KDFParams:  rawParams,
+		MAC:        hex.EncodeToString(mac[:]),
+	}
+	out.Crypto.CipherParams.IV = hex.EncodeToString(iv)
+	return json.Marshal(out)
+}
+
+// OpenEthereumKeystore decrypts a Web3 Secret Storage (Ethereum keystore V3)
+// file and returns its 32-byte private key, which the caller owns and should
+// clear. A wrong password or a modified ciphertext returns ErrDecryption.
+// When the file has an address field it must match the key. Compare the
+// result with XPrv.PrivateKey of the expected derivation path to check where
+// the key came from.
+func OpenEthereumKeystore(data, password []byte) ([]byte, error) {
+	var in ethereumKeystore
+	if err := json.Unmarshal(data, &in); err != nil {
+		return nil, ErrInvalidKeystore
+	}
+	if in.Version != ethereumKeystoreVersion || in.Crypto.Cipher != "aes-128-ctr" {
+		return nil, ErrUnsupportedKeystore
+	}
+	var params ethereumKDFParams
+	if err := json.Unmarshal(in.Crypto.KDFParams, &params); err != nil {
+		return nil, ErrInvalidKeystore
+	}
+	salt, err1 := hex.DecodeString(params.Salt)
+	iv, err2 := hex.DecodeString(in.Crypto.CipherParams.IV)
+	ciphertext, err3 := hex.DecodeString(in.Crypto.CipherText)
+	mac, err4 := hex.DecodeString(in.Crypto.MAC)
+	if err1 != nil || err2 != nil || err3 != nil || err4 != nil ||
+		len(salt) == 0 || len(salt) > maxEthereumSaltSize || len(iv) != aes.BlockSize ||
+		len(ciphertext) != ethereumKeySize || len(mac) != sha256.Size {
+		return nil, ErrInvalidKeystore
+	}
+	if !validEthereumKDF(in.Crypto.KDF, &params) {
+		return nil, ErrUnsupportedKeystore
+	}
+
+	derived, err := ethereumDeriveKey(in.Crypto.KDF, &params, salt, password)
+	if err != nil {
+		return nil, ErrUnsupportedKeystore
+	}
+	defer clear(derived)
+	want := ethereumMAC(derived, ciphertext)
+	if subtle.ConstantTimeCompare(want[:], mac) != 1 {
+		return nil, ErrDecryption
+	}
+	var key [ethereumKeySize]byte
+	defer clear(key[:])
+	if err := ethereumCTR(derived[:16], iv, key[:], ciphertext); err != nil {
+		return nil, err
+	}
+	point, ok := internalsecp.PublicPointFromScalar(&key)
+	if !ok {
+		return nil, ErrInvalidKeystore
+	}
+	address := ethereumAddress(point.UncompressedBytes())
+	if in.Address != "" && !strings.EqualFold(strings.TrimPrefix(in.Address, "0x"), hex.EncodeToString(address[:])) {
+		return nil, ErrInvalidKeystore
+	}
+	return append([]byte(nil), key[:]...), nil
+}
+
+func validEthereumKDF(kdf string, p *ethereumKDFParams) bool {
+	if p.DKLen != 32 {
+		return false
+	}
+	switch kdf {
+	case "scrypt":
+		return p.N > 1 && p.N&(p.N-1) == 0 && p.N <= maxEthereumScryptN &&
+			p.R >= 1 && p.R <= maxEthereumScryptR && p.P >= 1 && p.P <= maxEthereumScryptP &&
+			128*p.N*p.R <= maxEthereumScryptMemory && p.N*p.R*p.P <= maxEthereumScryptWork
+	case "pbkdf2":
+		return p.PRF == "hmac-sha256" && p.C >= 1 && p.C <= maxEthereumPBKDF2Iterations
+	default:
+		return false
+	}
+}
+
+func ethereumDeriveKey(kdf string, p *ethereumKDFParams, salt, password []byte) ([]byte, error) {
+	if kdf == "pbkdf2" {
+		return pbkdf2.Key(password, salt, p.C, p.DKLen, sha256.New), nil
+	}
+	return scrypt.Key(password, salt, p.N, p.R, p.P, p.DKLen)
+}
+
+// ethereumMAC returns Keccak-256(derivedKey[16:32] || ciphertext).
+func ethereumMAC(derived, ciphertext []byte) (out [32]byte) {
+	h := sha3.NewLegacyKeccak256()
+	_, _ = h.Write(derived[16:32])
+	_, _ = h.Write(ciphertext)
+	h.Sum(out[:0])
+	return out
+}
+
+func ethereumCTR(key, iv, dst, src []byte) error {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return err
+	}
+	cipher.NewCTR(block, iv).XORKeyStream(dst, src)
+	return nil
+}
+
+func ethereumAddress(uncompressed [65]byte) (out [20]byte) {
+	h := sha3.NewLegacyKeccak256()
+	_, _ = h.Write(uncompressed[1:])
+	var sum [32]byte
+	h.Sum(sum[:0])
+	copy(out[:], sum[12:])
+	return out
+}
+
+// eip55 applies the EIP-55 mixed-case checksum to a lowercase hex address.
+func eip55(address string) string {
+	h := sha3.NewLegacyKeccak256()
+	_, _ = h.Write([]byte(address))
+	sum := h.Sum(nil)
+	out := []byte(address)
+	for i, c := range out {
+		if c >= 'a' && sum[i/2]>>(4*(1-i%2))&0x0f >= 8 {
+			out[i] = c - 'a' + 'A'
+		}
+	}
+	return string(out)
+}
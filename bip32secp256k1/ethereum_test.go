@@ -0,0 +1,132 @@
+package bip32secp256k1
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+)
+
+// The Web3 Secret Storage specification test vectors, with the address of
+// their private key.
+const (
+	web3Password   = "testpassword"
+	web3PrivateKey = "7a28b5ba57c53603b0b07b56bba752f7784bf506fa95edc395f5cf6c7514fe9d"
+	web3PBKDF2     = `{"crypto":{"cipher":"aes-128-ctr","cipherparams":{"iv":"6087dab2f9fdbbfaddc31a909735c1e6"},"ciphertext":"5318b4d5bcd28de64ee5559e671353e16f075ecae9f99c7a79a38af5f869aa46","kdf":"pbkdf2","kdfparams":{"c":262144,"dklen":32,"prf":"hmac-sha256","salt":"ae3cd4e7013836a3df6bd7241b12db061dbe2c6785853cce422d148a624ce0bd"},"mac":"517ead924a9d0dc3124507e3393d175ce3ff7c1e96529c6c555ce9e51205e9b2"},"id":"3198bc9c-6672-5ab3-d995-4942343ae5b6","version":3}`
+	web3Scrypt     = `{"address":"008aeeda4d805471df9b2a5b0f38a0c3bcba786b","crypto":{"cipher":"aes-128-ctr","cipherparams":{"iv":"83dbcc02d8ccb40e466191a123791e0e"},"ciphertext":"d172bf743a674da9cdad04534d56926ef8358534d458fffccd4e6ad2fbde479c","kdf":"scrypt","kdfparams":{"dklen":32,"n":262144,"r":1,"p":8,"salt":"ab0c7876052600dd703518d6fc3fe8984592145b591fc8fb5c6d43190334ba19"},"mac":"2103ac29920d71da29f15d75b4a16dbe95cfd7ff8faea1056c33131d846e3097"},"id":"3198bc9c-6672-5ab3-d995-4942343ae5b6","version":3}`
+)
+
+func TestEthereumAddress(t *testing.T) {
+	for _, tc := range []struct {
+		key, address string
+	}{
+		{"0000000000000000000000000000000000000000000000000000000000000001", "0x7E5F4552091A69125d5DfCb7b8C2659029395Bdf"},
+		{web3PrivateKey, "0x008AeEda4D805471dF9b2A5B0f38A0C3bCBA786b"},
+	} {
+		k := &XPrv{network: Mainnet, cache: newKeyCache()}
+		raw, _ := hex.DecodeString(tc.key)
+		copy(k.key[:], raw)
+		xpub, err := k.XPub()
+		if err != nil {
+			t.Fatalf("XPub: %v", err)
+		}
+		if got, err := xpub.EthereumAddress(); err != nil || got != tc.address {
+			t.Fatalf("EthereumAddress(%s) = %s, %v; want %s", tc.key, got, err, tc.address)
+		}
+	}
+	var nilXPub *XPub
+	if _, err := nilXPub.EthereumAddress(); !errors.Is(err, ErrNilKey) {
+		t.Fatalf("nil key error = %v", err)
+	}
+}
+
+func TestEthereumKeystoreVectors(t *testing.T) {
+	for _, vector := range []string{web3PBKDF2, web3Scrypt} {
+		key, err := OpenEthereumKeystore([]byte(vector), []byte(web3Password))
+		if err != nil || hex.EncodeToString(key) != web3PrivateKey {
+			t.Fatalf("OpenEthereumKeystore = %x, %v", key, err)
+		}
+	}
+	if _, err := OpenEthereumKeystore([]byte(web3PBKDF2), []byte("wrong")); !errors.Is(err, ErrDecryption) {
+		t.Fatalf("wrong password error = %v", err)
+	}
+}
+
+func TestEthereumKeystoreRoundTrip(t *testing.T) {
+	child, err := mustMaster(t, Mainnet).DerivePath("m/44'/60'/0'/0/0")
+	if err != nil {
+		t.Fatalf("DerivePath: %v", err)
+	}
+	xpub, err := child.XPub()
+	if err != nil {
+		t.Fatalf("XPub: %v", err)
+	}
+	address, err := xpub.EthereumAddress()
+	if err != nil {
+		t.Fatalf("EthereumAddress: %v", err)
+	}
+
+	// PBKDF2 keeps the test fast; the scrypt path is covered by the vectors.
+	sealed, err := child.SealEthereumKeystore([]byte("pw"), &EthereumKeystoreOptions{KDF: EthereumPBKDF2})
+	if err != nil {
+		t.Fatalf("SealEthereumKeystore: %v", err)
+	}
+	var doc map[string]any
+	if err := json.Unmarshal(sealed, &doc); err != nil {
+		t.Fatalf("keystore is not JSON: %v", err)
+	}
+	if doc["address"] != strings.ToLower(address[2:]) || doc["version"] != 3.0 {
+		t.Fatalf("keystore header = %v, %v", doc["address"], doc["version"])
+	}
+	if id, _ := doc["id"].(string); len(id) != 36 || id[14] != '4' {
+		t.Fatalf("keystore id = %q", doc["id"])
+	}
+	key, err := OpenEthereumKeystore(sealed, []byte("pw"))
+	if err != nil || !bytes.Equal(key, child.PrivateKey()) {
+		t.Fatalf("OpenEthereumKeystore = %x, %v", key, err)
+	}
+
+	doc["address"] = "7e5f4552091a69125d5dfcb7b8c2659029395bdf"
+	edited, _ := json.Marshal(doc)
+	if _, err := OpenEthereumKeystore(edited, []byte("pw")); !errors.Is(err, ErrInvalidKeystore) {
+		t.Fatalf("mismatched address error = %v", err)
+	}
+	doc["crypto"].(map[string]any)["kdfparams"].(map[string]any)["c"] = 1 << 30
+	edited, _ = json.Marshal(doc)
+	if _, err := OpenEthereumKeystore(edited, []byte("pw")); !errors.Is(err, ErrUnsupportedKeystore) {
+		t.Fatalf("excessive cost error = %v", err)
+	}
+	if _, err := child.SealEthereumKeystore(nil, &EthereumKeystoreOptions{KDF: 9}); !errors.Is(err, ErrUnsupportedKeystore) {
+		t.Fatalf("unknown KDF error = %v", err)
+	}
+}
+
+func TestEthereumKeystoreBoundsScryptCost(t *testing.T) {
+	for _, tc := range []struct {
+		n, r, p int
+		want    bool
+	}{
+		{ethereumScryptN, ethereumScryptR, ethereumScryptP, true},
+		{1 << 18, 1, 8, true},   // the Web3 Secret Storage test vector
+		{1 << 20, 32, 1, false}, // 4 GiB, though each parameter is in range
+		{1 << 20, 8, 16, false}, // 2^27 BlockMix calls
+	} {
+		p := ethereumKDFParams{DKLen: 32, N: tc.n, R: tc.r, P: tc.p}
+		if got := validEthereumKDF("scrypt", &p); got != tc.want {
+			t.Fatalf("validEthereumKDF(N=%d, r=%d, p=%d) = %v, want %v", tc.n, tc.r, tc.p, got, tc.want)
+		}
+	}
+
+	var doc map[string]any
+	if err := json.Unmarshal([]byte(web3Scrypt), &doc); err != nil {
+		t.Fatal(err)
+	}
+	params := doc["crypto"].(map[string]any)["kdfparams"].(map[string]any)
+	params["n"], params["r"], params["p"] = 1<<20, 32, 1
+	edited, _ := json.Marshal(doc)
+	if _, err := OpenEthereumKeystore(edited, []byte(web3Password)); !errors.Is(err, ErrUnsupportedKeystore) {
+		t.Fatalf("N=2^20, r=32 error = %v", err)
+	}
+}
@@ -1,6 +1,7 @@
 package bip32secp256k1
 
 import (
+	"bytes"
 	"crypto/hmac"
 	"crypto/sha256"
 	"crypto/sha512"
@@ -19,12 +20,42 @@ func hmacSHA512(key, data []byte) (out [sha512.Size]byte) {
 	return out
 }
 
+// keyedHMACSHA512 returns an hmac512Func for many messages under one key. The
+// key pads are hashed once and the state is reset for each message, which
+// halves the SHA-512 compressions per call. Other keys fall back to
+// hmacSHA512.
+func keyedHMACSHA512(key []byte) hmac512Func {
+	h := hmac.New(sha512.New, key)
+	return func(k, data []byte) (out [sha512.Size]byte) {
+		if !bytes.Equal(k, key) {
+			return hmacSHA512(k, data)
+		}
+		h.Reset()
+		_, _ = h.Write(data)
+		h.Sum(out[:0])
+		return out
+	}
+}
+
 func keyFingerprint(pub [PublicKeySize]byte) (out [FingerprintSize]byte) {
-	sha := sha256.Sum256(pub[:])
+	id := hash160(pub[:])
+	copy(out[:], id[:FingerprintSize])
+	return out
+}
+
+// hash160 returns RIPEMD-160(SHA-256(data)), the hash behind key fingerprints
+// and legacy and segwit v0 key-hash addresses.
+func hash160(data []byte) (out [20]byte) {
+	sha := sha256.Sum256(data)
 	h := ripemd160.New()
 	_, _ = h.Write(sha[:])
-	id := h.Sum(nil)
-	copy(out[:], id[:FingerprintSize])
-	clear(id)
+	h.Sum(out[:0])
 	return out
 }
+
+// sha256d returns SHA-256(SHA-256(data)), Bitcoin's checksum and message
+// hash.
+func sha256d(data []byte) [32]byte {
+	first := sha256.Sum256(data)
+	return sha256.Sum256(first[:])
+}
@@ -1,7 +1,5 @@
 package bip32secp256k1
 
-import internalsecp "github.com/islishude/bip32/v2/internal/secp256k1"
-
 // XPrv is a standard BIP-32 extended private key.
 type XPrv struct {
 	key [PrivateKeySize]byte
@@ -11,6 +9,8 @@ type XPrv struct {
 	depth             uint8
 	parentFingerprint [FingerprintSize]byte
 	childNumber       uint32
+
+	cache *keyCache
 }
 
 // XPub is a standard BIP-32 extended public key.
@@ -22,6 +22,8 @@ type XPub struct {
 	depth             uint8
 	parentFingerprint [FingerprintSize]byte
 	childNumber       uint32
+
+	cache *keyCache
 }
 
 // PrivateKey returns a copy of the canonical 32-byte private key.
@@ -39,7 +41,7 @@ func (k *XPrv) PublicKey() ([PublicKeySize]byte, error) {
 	if k == nil {
 		return [PublicKeySize]byte{}, ErrNilKey
 	}
-	pub, ok := internalsecp.PublicKeyFromScalar(&k.key)
+	_, pub, ok := k.publicPoint()
 	if !ok {
 		return [PublicKeySize]byte{}, ErrInvalidXPrv
 	}
@@ -56,6 +58,8 @@ func (k *XPrv) XPub() (*XPub, error) {
 	if err != nil {
 		return nil, err
 	}
+	// The public half shares the private key's cache, which holds only public
+	// values, so the point and fingerprint are computed once for both.
 	return &XPub{
 		pub:               pub,
 		cc:                k.cc,
@@ -63,6 +67,7 @@ func (k *XPrv) XPub() (*XPub, error) {
 		depth:             k.depth,
 		parentFingerprint: k.parentFingerprint,
 		childNumber:       k.childNumber,
+		cache:             k.cache,
 	}, nil
 }
 
@@ -126,6 +131,23 @@ func (p *XPub) Depth() uint8 {
 	return p.depth
 }
 
+// Identifier returns HASH160 of the public key, the BIP-32 key identifier.
+func (p *XPub) Identifier() [IdentifierSize]byte {
+	if p == nil {
+		return [IdentifierSize]byte{}
+	}
+	return hash160(p.pub[:])
+}
+
+// Fingerprint returns the first four bytes of the key identifier, the value
+// children record as their parent fingerprint.
+func (p *XPub) Fingerprint() [FingerprintSize]byte {
+	if p == nil {
+		return [FingerprintSize]byte{}
+	}
+	return p.fingerprint()
+}
+
 // ParentFingerprint returns the first four bytes of HASH160(parent public key).
 func (k *XPrv) ParentFingerprint() [FingerprintSize]byte {
 	if k == nil {
@@ -170,6 +192,7 @@ func (k *XPrv) Wipe() {
 	k.depth = 0
 	clear(k.parentFingerprint[:])
 	k.childNumber = 0
+	k.cache = nil
 }
 
 func (k *XPrv) clone() *XPrv {
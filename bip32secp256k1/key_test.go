@@ -3,6 +3,7 @@ package bip32secp256k1
 import (
 	"bytes"
 	"encoding"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"reflect"
@@ -168,10 +169,10 @@ func TestBase58CheckErrorClassification(t *testing.T) {
 	if _, err := ParseXPrv(badChecksum); !errors.Is(err, ErrInvalidChecksum) {
 		t.Fatalf("checksum error = %v", err)
 	}
-	if _, err := ParseXPrv(xpub); !errors.Is(err, ErrInvalidXPrv) {
+	if _, err := ParseXPrv(xpub); !errors.Is(err, ErrInvalidXPrv) || !strings.Contains(err.Error(), "extended public key") {
 		t.Fatalf("xpub-as-xprv error = %v", err)
 	}
-	if _, err := ParseXPub(xprv); !errors.Is(err, ErrInvalidXPub) {
+	if _, err := ParseXPub(xprv); !errors.Is(err, ErrInvalidXPub) || !strings.Contains(err.Error(), "extended private key") {
 		t.Fatalf("xprv-as-xpub error = %v", err)
 	}
 }
@@ -195,11 +196,11 @@ func TestKeyCopiesWipeAndExplicitTextOnly(t *testing.T) {
 	}
 	stringer := reflect.TypeFor[fmt.Stringer]()
 	textMarshaler := reflect.TypeFor[encoding.TextMarshaler]()
-	for _, value := range []any{root, xpub} {
-		typ := reflect.TypeOf(value)
-		if typ.Implements(stringer) || typ.Implements(textMarshaler) {
-			t.Fatalf("%v unexpectedly supports implicit text serialization", typ)
-		}
+	if typ := reflect.TypeOf(root); typ.Implements(stringer) || typ.Implements(textMarshaler) {
+		t.Fatalf("%v unexpectedly supports implicit text serialization", typ)
+	}
+	if typ := reflect.TypeOf(xpub); typ.Implements(stringer) {
+		t.Fatalf("%v unexpectedly implements fmt.Stringer", typ)
 	}
 
 	root.Wipe()
@@ -237,6 +238,30 @@ func TestNilKeyMethods(t *testing.T) {
 	}
 }
 
+func TestXPubIdentifier(t *testing.T) {
+	// BIP-32 test vector 1 lists the master identifier and fingerprint.
+	root := mustMaster(t, Mainnet)
+	xpub, err := root.XPub()
+	if err != nil {
+		t.Fatalf("XPub: %v", err)
+	}
+	id := xpub.Identifier()
+	if got := hex.EncodeToString(id[:]); got != "3442193e1bb70916e914552172cd4e2dbc9df811" {
+		t.Fatalf("Identifier = %s", got)
+	}
+	child, err := xpub.Derive(0)
+	if err != nil {
+		t.Fatalf("Derive: %v", err)
+	}
+	if fp := xpub.Fingerprint(); fp != child.ParentFingerprint() || !bytes.Equal(id[:FingerprintSize], fp[:]) {
+		t.Fatal("Fingerprint does not match the child's parent fingerprint")
+	}
+	var nilXPub *XPub
+	if nilXPub.Identifier() != [IdentifierSize]byte{} || nilXPub.Fingerprint() != [FingerprintSize]byte{} {
+		t.Fatal("nil XPub returned a non-zero identifier")
+	}
+}
+
 func mustMaster(t *testing.T, network Network) *XPrv {
 	t.Helper()
 	root, err := NewMasterKey(testSeed, network)
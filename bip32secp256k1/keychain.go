@@ -0,0 +1,80 @@
+package bip32secp256k1
+
+import "github.com/islishude/bip32/v2/internal/keycache"
+
+// Keychain memoizes extended private keys derived below a root key. Nodes are
+// held in a least-recently-used cache bounded by the capacity passed to
+// NewKeychain, and evicted nodes are wiped. A Keychain is safe for concurrent
+// use.
+type Keychain struct {
+	chain *keycache.Chain[*XPrv]
+}
+
+// KeychainStats reports Keychain activity. Hits and Misses count DerivePath
+// lookups; Len is the number of derived nodes currently cached.
+type KeychainStats struct {
+	Hits      uint64
+	Misses    uint64
+	Evictions uint64
+	Len       int
+}
+
+// NewKeychain returns a Keychain over a copy of root that caches at most
+// capacity derived nodes. The root itself is not counted against capacity.
+func NewKeychain(root *XPrv, capacity int) (*Keychain, error) {
+	if root == nil {
+		return nil, ErrNilKey
+	}
+	if !root.isRoot() {
+		return nil, ErrNotRoot
+	}
+	if capacity < 1 {
+		return nil, ErrInvalidCapacity
+	}
+	return &Keychain{
+		chain: keycache.New(root.clone(), capacity, keycache.Ops[*XPrv]{
+			Derive: (*XPrv).Derive,
+			Clone:  (*XPrv).clone,
+			Wipe:   (*XPrv).Wipe,
+		}),
+	}, nil
+}
+
+// DerivePath derives an absolute path with the same syntax as XPrv.DerivePath.
+// The returned key is a copy owned by the caller, who may wipe it.
+func (c *Keychain) DerivePath(path string) (*XPrv, error) {
+	if c == nil {
+		return nil, ErrNilKey
+	}
+	indexes, err := ParseAbsolutePath(path)
+	if err != nil {
+		return nil, err
+	}
+	return c.chain.Derive(indexes)
+}
+
+// Stats returns a snapshot of the cache counters.
+func (c *Keychain) Stats() KeychainStats {
+	if c == nil {
+		return KeychainStats{}
+	}
+	s := c.chain.Stats()
+	return KeychainStats{Hits: s.Hits, Misses: s.Misses, Evictions: s.Evictions, Len: s.Len}
+}
+
+// Purge wipes and drops every cached node while keeping the root.
+func (c *Keychain) Purge() {
+	if c == nil {
+		return
+	}
+	c.chain.Purge()
+}
+
+// Wipe clears the root and every cached node on a best-effort basis. The
+// Keychain must not be used afterwards.
+func (c *Keychain) Wipe() {
+	if c == nil {
+		return
+	}
+	c.chain.Wipe()
+}
@@ -0,0 +1,101 @@
+package bip32secp256k1
+
+import (
+	"bytes"
+	"errors"
+	"sync"
+	"testing"
+)
+
+func TestKeychainMatchesDerivePath(t *testing.T) {
+	root := mustMaster(t, Mainnet)
+	chain, err := NewKeychain(root, 4)
+	if err != nil {
+		t.Fatalf("NewKeychain: %v", err)
+	}
+
+	for _, path := range []string{"m/44'/0'/0'", "m/44'/0'/0'/0/0", "m/44'/0'/0'/0/1", "m", "m/44'/0'/0'/0/0"} {
+		want, err := root.DerivePath(path)
+		if err != nil {
+			t.Fatalf("DerivePath(%q): %v", path, err)
+		}
+		got, err := chain.DerivePath(path)
+		if err != nil {
+			t.Fatalf("Keychain.DerivePath(%q): %v", path, err)
+		}
+		if !bytes.Equal(got.Bytes(), want.Bytes()) {
+			t.Fatalf("Keychain.DerivePath(%q) mismatch", path)
+		}
+		got.Wipe()
+	}
+	if s := chain.Stats(); s.Hits != 2 || s.Misses != 3 || s.Len != 4 || s.Evictions != 2 {
+		t.Fatalf("stats = %+v", s)
+	}
+
+	if _, err := chain.DerivePath("0/0"); !errors.Is(err, ErrInvalidPath) {
+		t.Fatalf("relative path error = %v", err)
+	}
+	chain.Purge()
+	if s := chain.Stats(); s.Len != 0 {
+		t.Fatalf("Purge left %d nodes", s.Len)
+	}
+}
+
+func TestKeychainValidation(t *testing.T) {
+	root := mustMaster(t, Mainnet)
+	if _, err := NewKeychain(nil, 1); !errors.Is(err, ErrNilKey) {
+		t.Fatalf("nil root error = %v", err)
+	}
+	if _, err := NewKeychain(root, 0); !errors.Is(err, ErrInvalidCapacity) {
+		t.Fatalf("zero capacity error = %v", err)
+	}
+	child, err := root.Derive(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewKeychain(child, 1); !errors.Is(err, ErrNotRoot) {
+		t.Fatalf("non-root error = %v", err)
+	}
+}
+
+func TestKeychainConcurrentUse(t *testing.T) {
+	root := mustMaster(t, Mainnet)
+	chain, err := NewKeychain(root, 3)
+	if err != nil {
+		t.Fatalf("NewKeychain: %v", err)
+	}
+	paths := []string{"m/0'", "m/0'/1", "m/0'/2", "m/1'/0", "m/0'/1/2"}
+	want := make(map[string][]byte)
+	for _, path := range paths {
+		key, err := root.DerivePath(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want[path] = key.Bytes()
+	}
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 8)
+	for worker := range 8 {
+		wg.Go(func() {
+			for i := range 50 {
+				path := paths[(worker+i)%len(paths)]
+				key, err := chain.DerivePath(path)
+				if err != nil {
+					errs <- err
+					return
+				}
+				if !bytes.Equal(key.Bytes(), want[path]) {
+					errs <- errors.New("mismatch at " + path)
+					return
+				}
+				key.Wipe()
+			}
+		})
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,167 @@
+package bip32secp256k1
+
+import (
+	"crypto/subtle"
+	"encoding/hex"
+	"io"
+	"time"
+
+	bip32 "github.com/islishude/bip32/v2"
+	"github.com/islishude/bip32/v2/internal/keystore"
+)
+
+// KeystoreKDF selects the password-based key derivation function of a
+// keystore.
+type KeystoreKDF uint8
+
+const (
+	// KeystoreArgon2id selects Argon2id with t=3, 64 MiB and 4 lanes. It is
+	// the default.
+	KeystoreArgon2id KeystoreKDF = iota + 1
+	// KeystoreScrypt selects scrypt with N=2^17, r=8 and p=1.
+	KeystoreScrypt
+)
+
+// KeystoreCipher selects the AEAD that seals a keystore.
+type KeystoreCipher uint8
+
+const (
+	// KeystoreAES256GCM selects AES-256-GCM. It is the default.
+	KeystoreAES256GCM KeystoreCipher = iota + 1
+	// KeystoreXChaCha20Poly1305 selects XChaCha20-Poly1305.
+	KeystoreXChaCha20Poly1305
+)
+
+// KeystoreOptions configures SealKeystore. The zero value selects Argon2id,
+// AES-256-GCM, crypto/rand.Reader and the current time.
+type KeystoreOptions struct {
+	KDF    KeystoreKDF
+	Cipher KeystoreCipher
+	// Rand supplies the salt and nonce.
+	Rand io.Reader
+	// Created is recorded in the metadata at one-second resolution.
+	Created time.Time
+}
+
+// KeystoreMetadata is the authenticated plaintext metadata of a keystore.
+type KeystoreMetadata struct {
+	Network     Network
+	Fingerprint [FingerprintSize]byte
+	Created     time.Time
+}
+
+var keystoreFormat = keystore.Format{
+	Curve:       string(bip32.CurveSecp256k1),
+	Invalid:     ErrInvalidKeystore,
+	Unsupported: ErrUnsupportedKeystore,
+	Decryption:  ErrDecryption,
+}
+
+// SealKeystore encrypts k under password into a versioned JSON keystore.
+//
+// The password is stretched with the selected KDF and the 78-byte extended
+// key payload is sealed with the selected AEAD. The network, key fingerprint
+// and creation time are stored in plaintext so that files can be identified
+// without the password, and are authenticated along with the KDF and cipher
+// parameters. opts may be nil.
+func (k *XPrv) SealKeystore(password []byte, opts *KeystoreOptions) ([]byte, error) {
+	if k == nil {
+		return nil, ErrNilKey
+	}
+	if opts == nil {
+		opts = &KeystoreOptions{}
+	}
+	params, err := keystoreParams(opts.KDF, opts.Cipher)
+	if err != nil {
+		return nil, err
+	}
+	pub, err := k.PublicKey()
+	if err != nil {
+		return nil, err
+	}
+	payload := k.Bytes()
+	if payload == nil {
+		return nil, ErrInvalidXPrv
+	}
+	defer clear(payload)
+
+	created := opts.Created
+	if created.IsZero() {
+		created = time.Now()
+	}
+	fingerprint := k.cache.resolveFingerprint(pub)
+	meta := keystore.Metadata{
+		Network:     networkName(k.network),
+		Fingerprint: hex.EncodeToString(fingerprint[:]),
+		Created:     created.Unix(),
+	}
+	return keystoreFormat.Seal(opts.Rand, payload, password, meta, params)
+}
+
+// OpenKeystore decrypts a keystore written by SealKeystore. A wrong password
+// and a file whose metadata or ciphertext was modified both return
+// ErrDecryption.
+func OpenKeystore(data, password []byte) (*XPrv, *KeystoreMetadata, error) {
+	payload, meta, _, err := keystoreFormat.Open(data, password)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer clear(payload)
+	k, err := NewXPrvFromBytes(payload)
+	if err != nil {
+		return nil, nil, ErrInvalidKeystore
+	}
+
+	// The metadata was authenticated, so a mismatch means the file was
+	// written by another implementation that recorded it incorrectly.
+	pub, err := k.PublicKey()
+	if err != nil {
+		k.Wipe()
+		return nil, nil, ErrInvalidKeystore
+	}
+	fingerprint := k.cache.resolveFingerprint(pub)
+	want, err := hex.DecodeString(meta.Fingerprint)
+	if err != nil || subtle.ConstantTimeCompare(want, fingerprint[:]) != 1 || meta.Network != networkName(k.network) {
+		k.Wipe()
+		return nil, nil, ErrInvalidKeystore
+	}
+	return k, &KeystoreMetadata{
+		Network:     k.network,
+		Fingerprint: fingerprint,
+		Created:     time.Unix(meta.Created, 0),
+	}, nil
+}
+
+// ChangeKeystorePassword reseals a keystore under newPassword, keeping its
+// metadata, KDF and cipher and drawing a fresh salt and nonce.
+func ChangeKeystorePassword(data, oldPassword, newPassword []byte) ([]byte, error) {
+	return keystoreFormat.ChangePassword(nil, data, oldPassword, newPassword)
+}
+
+func keystoreParams(kdf KeystoreKDF, c KeystoreCipher) (keystore.Params, error) {
+	var params keystore.Params
+	switch kdf {
+	case 0, KeystoreArgon2id:
+		params.KDF = keystore.Argon2id
+	case KeystoreScrypt:
+		params.KDF = keystore.Scrypt
+	default:
+		return params, ErrUnsupportedKeystore
+	}
+	switch c {
+	case 0, KeystoreAES256GCM:
+		params.Cipher = keystore.AES256GCM
+	case KeystoreXChaCha20Poly1305:
+		params.Cipher = keystore.XChaCha20Poly1305
+	default:
+		return params, ErrUnsupportedKeystore
+	}
+	return keystore.DefaultParams(params.KDF, params.Cipher), nil
+}
+
+func networkName(network Network) string {
+	if network == Testnet {
+		return "testnet"
+	}
+	return "mainnet"
+}
@@ -0,0 +1,97 @@
+package bip32secp256k1
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestKeystoreRoundTrip(t *testing.T) {
+	root := mustMaster(t, Testnet)
+	xpub, err := root.XPub()
+	if err != nil {
+		t.Fatalf("XPub: %v", err)
+	}
+	created := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	password := []byte("correct horse battery staple")
+
+	var sealed []byte
+	for _, opts := range []*KeystoreOptions{
+		{Created: created},
+		{KDF: KeystoreScrypt, Cipher: KeystoreXChaCha20Poly1305, Created: created},
+	} {
+		sealed, err = root.SealKeystore(password, opts)
+		if err != nil {
+			t.Fatalf("SealKeystore: %v", err)
+		}
+		if bytes.Contains(sealed, []byte(mustEncode(t, root))) {
+			t.Fatal("keystore contains the plaintext key")
+		}
+		key, meta, err := OpenKeystore(sealed, password)
+		if err != nil {
+			t.Fatalf("OpenKeystore: %v", err)
+		}
+		if !bytes.Equal(key.Bytes(), root.Bytes()) {
+			t.Fatal("OpenKeystore returned a different key")
+		}
+		want := KeystoreMetadata{Network: Testnet, Fingerprint: xpub.fingerprint(), Created: created}
+		if meta.Network != want.Network || meta.Fingerprint != want.Fingerprint || !meta.Created.Equal(want.Created) {
+			t.Fatalf("metadata = %+v, want %+v", meta, want)
+		}
+	}
+
+	// Wrong passwords and old passwords after a change fail alike.
+	changed, err := ChangeKeystorePassword(sealed, password, []byte("new password"))
+	if err != nil {
+		t.Fatalf("ChangeKeystorePassword: %v", err)
+	}
+	if _, _, err := OpenKeystore(changed, password); !errors.Is(err, ErrDecryption) {
+		t.Fatalf("old password error = %v", err)
+	}
+	key, meta, err := OpenKeystore(changed, []byte("new password"))
+	if err != nil || !bytes.Equal(key.Bytes(), root.Bytes()) || !meta.Created.Equal(created) {
+		t.Fatalf("OpenKeystore after password change = %+v, %v", meta, err)
+	}
+}
+
+func TestKeystoreRejectsInvalidInput(t *testing.T) {
+	root := mustMaster(t, Mainnet)
+	if _, err := root.SealKeystore(nil, &KeystoreOptions{KDF: 9}); !errors.Is(err, ErrUnsupportedKeystore) {
+		t.Fatalf("unknown KDF error = %v", err)
+	}
+	if _, err := root.SealKeystore(nil, &KeystoreOptions{Cipher: 9}); !errors.Is(err, ErrUnsupportedKeystore) {
+		t.Fatalf("unknown cipher error = %v", err)
+	}
+	var nilKey *XPrv
+	if _, err := nilKey.SealKeystore(nil, nil); !errors.Is(err, ErrNilKey) {
+		t.Fatalf("nil key error = %v", err)
+	}
+
+	sealed, err := root.SealKeystore([]byte("pw"), nil)
+	if err != nil {
+		t.Fatalf("SealKeystore: %v", err)
+	}
+	var doc map[string]any
+	if err := json.Unmarshal(sealed, &doc); err != nil {
+		t.Fatalf("keystore is not JSON: %v", err)
+	}
+	meta := doc["metadata"].(map[string]any)
+	if meta["curve"] != "secp256k1" || meta["network"] != "mainnet" {
+		t.Fatalf("metadata = %v", meta)
+	}
+	meta["network"] = "testnet"
+	edited, _ := json.Marshal(doc)
+	if _, _, err := OpenKeystore(edited, []byte("pw")); !errors.Is(err, ErrDecryption) {
+		t.Fatalf("edited metadata error = %v", err)
+	}
+	meta["network"], meta["curve"] = "mainnet", "ed25519"
+	edited, _ = json.Marshal(doc)
+	if _, _, err := OpenKeystore(edited, []byte("pw")); !errors.Is(err, ErrInvalidKeystore) {
+		t.Fatalf("other curve error = %v", err)
+	}
+	if _, _, err := OpenKeystore([]byte("not json"), []byte("pw")); !errors.Is(err, ErrInvalidKeystore) {
+		t.Fatalf("malformed keystore error = %v", err)
+	}
+}
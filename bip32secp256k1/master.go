@@ -27,7 +27,7 @@ func newMasterKey(seed []byte, network Network, mac hmac512Func) (*XPrv, error)
 		return nil, ErrInvalidMasterKey
 	}
 
-	master := &XPrv{key: key, network: network}
+	master := &XPrv{key: key, network: network, cache: newKeyCache()}
 	copy(master.cc[:], i[PrivateKeySize:])
 	clear(key[:])
 	return master, nil
@@ -0,0 +1,199 @@
+package bip32secp256k1
+
+import (
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/binary"
+
+	internalsecp "github.com/islishude/bip32/v2/internal/secp256k1"
+)
+
+// messageMagic prefixes every legacy signed message before hashing.
+const messageMagic = "\x18Bitcoin Signed Message:\n"
+
+// MessageSignatureSize is the byte length of a decoded legacy message
+// signature: a header byte followed by r || s.
+const MessageSignatureSize = 1 + internalsecp.SignatureSize
+
+// BIP-137 header bases. The recovery id is added to each.
+const (
+	headerUncompressedP2PKH = 27
+	headerP2PKH             = 31
+	headerP2SHP2WPKH        = 35
+	headerP2WPKH            = 39
+	headerEnd               = 43
+)
+
+// SignMessage signs message in the "Bitcoin Signed Message" format used by
+// Bitcoin Core's signmessage and returns the base64 recoverable signature.
+//
+// The header byte follows BIP-137 so verifiers can tell which address type
+// the signer meant: P2PKH, P2SHP2WPKH and P2WPKH are supported. Taproot
+// addresses have no legacy message format; use SignMessageBIP322. The nonce
+// is derived with RFC 6979, so signing is deterministic.
+func (k *XPrv) SignMessage(message []byte, addressType AddressType) (string, error) {
+	if k == nil {
+		return "", ErrNilKey
+	}
+	return signMessage(message, addressType, func(digest *[32]byte) ([]byte, error) {
+		return signRecoverable(&k.key, digest)
+	})
+}
+
+// SignMessage is XPrv.SignMessage for the backend key, which signs through
+// Backend.SignECDSARecoverable.
+func (s *BackendSigner) SignMessage(message []byte, addressType AddressType) (string, error) {
+	if s == nil {
+		return "", ErrNilKey
+	}
+	return signMessage(message, addressType, func(digest *[32]byte) ([]byte, error) {
+		return s.backend.SignECDSARecoverable(s.path, digest[:])
+	})
+}
+
+// signMessage builds a BIP-137 signature from the recovery id and r || s that
+// sign returns for the message hash.
+func signMessage(message []byte, addressType AddressType, sign func(digest *[32]byte) ([]byte, error)) (string, error) {
+	var header byte
+	switch addressType {
+	case P2PKH:
+		header = headerP2PKH
+	case P2SHP2WPKH:
+		header = headerP2SHP2WPKH
+	case P2WPKH:
+		header = headerP2WPKH
+	default:
+		return "", ErrUnsupportedAddress
+	}
+	hash := messageHash(message)
+	sig, err := sign(&hash)
+	if err != nil {
+		return "", err
+	}
+	if len(sig) != MessageSignatureSize || sig[0] > 3 {
+		return "", ErrInvalidSignature
+	}
+	var out [MessageSignatureSize]byte
+	out[0] = header + sig[0]
+	copy(out[1:], sig[1:])
+	return base64.StdEncoding.EncodeToString(out[:]), nil
+}
+
+// signRecoverable returns the recovery id followed by the low-S RFC 6979
+// signature r || s of hash.
+func signRecoverable(key, hash *[32]byte) ([]byte, error) {
+	sig, recoveryID, ok := internalsecp.SignECDSA(key, hash, nil)
+	if !ok {
+		return nil, ErrInvalidXPrv
+	}
+	return append([]byte{recoveryID}, sig[:]...), nil
+}
+
+// RecoverMessagePublicKey returns the compressed public key that produced a
+// legacy message signature. Signatures made with uncompressed keys recover to
+// the same point, returned in compressed form.
+func RecoverMessagePublicKey(message []byte, signature string) ([PublicKeySize]byte, error) {
+	point, _, err := recoverMessageSigner(message, signature)
+	if err != nil {
+		return [PublicKeySize]byte{}, err
+	}
+	return point.Bytes(), nil
+}
+
+// VerifyMessage verifies a legacy "Bitcoin Signed Message" signature against
+// a P2PKH, P2SH-P2WPKH or P2WPKH address on either network.
+//
+// The header byte only selects the recovery id and key compression; as in
+// Electrum and most wallets, a compressed signature is accepted for any of
+// the three single-key address types derived from the recovered key, because
+// many signers always use the P2PKH header. Taproot and other script
+// addresses return ErrUnsupportedAddress.
+func VerifyMessage(address string, message []byte, signature string) error {
+	script, _, err := decodeAddress(address)
+	if err != nil {
+		return err
+	}
+	if !legacyMessageScript(script) {
+		return ErrUnsupportedAddress
+	}
+	point, compressed, err := recoverMessageSigner(message, signature)
+	if err != nil {
+		return err
+	}
+
+	var candidates [][]byte
+	if compressed {
+		pub := point.Bytes()
+		for _, addressType := range []AddressType{P2PKH, P2SHP2WPKH, P2WPKH} {
+			candidate, _ := outputScript(pub, addressType)
+			candidates = append(candidates, candidate)
+		}
+	} else {
+		uncompressed := point.UncompressedBytes()
+		candidates = append(candidates, p2pkhScript(hash160(uncompressed[:])))
+	}
+	for _, candidate := range candidates {
+		if subtle.ConstantTimeCompare(candidate, script) == 1 {
+			return nil
+		}
+	}
+	return ErrInvalidSignature
+}
+
+// legacyMessageScript reports whether script is a P2PKH, P2SH or P2WPKH
+// output, the kinds a legacy message signature can prove.
+func legacyMessageScript(script []byte) bool {
+	switch {
+	case len(script) == 25 && script[0] == opDup:
+		return true
+	case len(script) == 23 && script[0] == opHash160:
+		return true
+	case len(script) == 22 && script[0] == 0x00:
+		return true
+	default:
+		return false
+	}
+}
+
+func recoverMessageSigner(message []byte, signature string) (internalsecp.PublicPoint, bool, error) {
+	raw, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil || len(raw) != MessageSignatureSize {
+		return internalsecp.PublicPoint{}, false, ErrInvalidSignature
+	}
+	header := raw[0]
+	if header < headerUncompressedP2PKH || header >= headerEnd {
+		return internalsecp.PublicPoint{}, false, ErrInvalidSignature
+	}
+	compressed := header >= headerP2PKH
+	recoveryID := (header - headerUncompressedP2PKH) & 3
+
+	hash := messageHash(message)
+	point, ok := internalsecp.RecoverECDSA(&hash, (*[internalsecp.SignatureSize]byte)(raw[1:]), recoveryID)
+	if !ok {
+		return internalsecp.PublicPoint{}, false, ErrInvalidSignature
+	}
+	return point, compressed, nil
+}
+
+// messageHash returns SHA-256d(magic || varint(len(message)) || message).
+func messageHash(message []byte) [32]byte {
+	buf := make([]byte, 0, len(messageMagic)+binary.MaxVarintLen64+len(message))
+	buf = append(buf, messageMagic...)
+	buf = appendCompactSize(buf, uint64(len(message)))
+	buf = append(buf, message...)
+	return sha256d(buf)
+}
+
+// appendCompactSize appends n in Bitcoin's variable-length integer encoding.
+func appendCompactSize(b []byte, n uint64) []byte {
+	switch {
+	case n < 0xfd:
+		return append(b, byte(n))
+	case n <= 0xffff:
+		return binary.LittleEndian.AppendUint16(append(b, 0xfd), uint16(n))
+	case n <= 0xffffffff:
+		return binary.LittleEndian.AppendUint32(append(b, 0xfe), uint32(n))
+	default:
+		return binary.LittleEndian.AppendUint64(append(b, 0xff), n)
+	}
+}
@@ -0,0 +1,240 @@
+package bip32secp256k1
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"testing"
+)
+
+// The BIP-322 reference key, address and signatures.
+const (
+	bip322WIF     = "L3VFeEujGtevx9w18HD1fhRbCH67Az2dpCymeRE1SoPK6XQtaN2k"
+	bip322Address = "bc1q9vza2e8x573nczrlzms0wvx3gsqjx7vavgkx0l"
+)
+
+func TestAddresses(t *testing.T) {
+	key := mustWIF(t, bip322WIF)
+	for _, tc := range []struct {
+		network Network
+		want    map[AddressType]string
+	}{
+		{Mainnet, map[AddressType]string{
+			P2PKH:      "14vV3aCHBeStb5bkenkNHbe2YAFinYdXgc",
+			P2SHP2WPKH: "37qyp7jQAzqb2rCBpMvVtLDuuzKAUCVnJb",
+			P2WPKH:     bip322Address,
+			P2TR:       "bc1ppv609nr0vr25u07u95waq5lucwfm6tde4nydujnu8npg4q75mr5sxq8lt3",
+		}},
+		{Testnet, map[AddressType]string{
+			P2PKH:      "mjSSLdHFzft9NC5NNMik7WrMQ9rRhMhNpT",
+			P2SHP2WPKH: "2MyQBsrfRnTLwEdpjVVYNWHDB8LXLJUcub9",
+			P2WPKH:     "tb1q9vza2e8x573nczrlzms0wvx3gsqjx7vaxwd45v",
+			P2TR:       "tb1ppv609nr0vr25u07u95waq5lucwfm6tde4nydujnu8npg4q75mr5s3g3s37",
+		}},
+	} {
+		key.network = tc.network
+		xpub, err := key.XPub()
+		if err != nil {
+			t.Fatalf("XPub: %v", err)
+		}
+		for addressType, want := range tc.want {
+			got, err := xpub.Address(addressType)
+			if err != nil || got != want {
+				t.Fatalf("network %d type %d address = %q, %v; want %q", tc.network, addressType, got, err, want)
+			}
+			script, network, err := decodeAddress(got)
+			if err != nil || network != tc.network {
+				t.Fatalf("decodeAddress(%q) network = %d, %v", got, network, err)
+			}
+			if wantScript, _ := outputScript(xpub.PublicKey(), addressType); hex.EncodeToString(script) != hex.EncodeToString(wantScript) {
+				t.Fatalf("decodeAddress(%q) script = %x", got, script)
+			}
+		}
+	}
+
+	xpub, _ := key.XPub()
+	if _, err := xpub.Address(AddressType(0)); !errors.Is(err, ErrUnsupportedAddress) {
+		t.Fatalf("unknown address type error = %v", err)
+	}
+	var nilPub *XPub
+	if _, err := nilPub.Address(P2WPKH); !errors.Is(err, ErrNilKey) {
+		t.Fatalf("nil Address error = %v", err)
+	}
+	for _, bad := range []string{"", "bc1q9vza2e8x573nczrlzms0wvx3gsqjx7vavgkx0m", "14vV3aCHBeStb5bkenkNHbe2YAFinYdXgd", "xpub"} {
+		if _, _, err := decodeAddress(bad); !errors.Is(err, ErrInvalidAddress) {
+			t.Fatalf("decodeAddress(%q) error = %v", bad, err)
+		}
+	}
+}
+
+func TestLegacyMessageSignatures(t *testing.T) {
+	key := mustWIF(t, bip322WIF)
+	pub, err := key.PublicKey()
+	if err != nil {
+		t.Fatalf("PublicKey: %v", err)
+	}
+	// Generated with btcec's SignCompact, which matches Bitcoin Core.
+	for _, tc := range []struct {
+		message, compressed, uncompressed string
+	}{
+		{"", "H0Gm/ylcjvGmj29D/r6ASHKKWAyGA/MEU/Eh+IhFw3ZxFxy9b/dJ8/XFsiutCV8DWlFGnO95m/KoZwFPJTF1qaM=", "G0Gm/ylcjvGmj29D/r6ASHKKWAyGA/MEU/Eh+IhFw3ZxFxy9b/dJ8/XFsiutCV8DWlFGnO95m/KoZwFPJTF1qaM="},
+		{"Hello World", "IOW2xi+ebJLeBtr674l4QH76dqDoVjLV80R9EFKFQX5rBrlCXPIZaYs8Yuayg0ZqjyiCbLy9pzZIS7JWT65/nsU=", "HOW2xi+ebJLeBtr674l4QH76dqDoVjLV80R9EFKFQX5rBrlCXPIZaYs8Yuayg0ZqjyiCbLy9pzZIS7JWT65/nsU="},
+		{"This is an example of a signed message.", "IBvhYGFH/heMzey8DURryXOy5vCKbx4UXTuBgbyQ7x7Ed+uoIrYQbnhMLKJnf7cVAiIDcVgPxGfD1drguXWWK04=", "HBvhYGFH/heMzey8DURryXOy5vCKbx4UXTuBgbyQ7x7Ed+uoIrYQbnhMLKJnf7cVAiIDcVgPxGfD1drguXWWK04="},
+	} {
+		message := []byte(tc.message)
+		got, err := key.SignMessage(message, P2PKH)
+		if err != nil || got != tc.compressed {
+			t.Fatalf("SignMessage(%q) = %q, %v", tc.message, got, err)
+		}
+		if recovered, err := RecoverMessagePublicKey(message, got); err != nil || recovered != pub {
+			t.Fatalf("RecoverMessagePublicKey(%q) = %x, %v", tc.message, recovered, err)
+		}
+		for _, address := range []string{"14vV3aCHBeStb5bkenkNHbe2YAFinYdXgc", "37qyp7jQAzqb2rCBpMvVtLDuuzKAUCVnJb", bip322Address, "tb1q9vza2e8x573nczrlzms0wvx3gsqjx7vaxwd45v"} {
+			if err := VerifyMessage(address, message, got); err != nil {
+				t.Fatalf("VerifyMessage(%s, %q): %v", address, tc.message, err)
+			}
+		}
+		if err := VerifyMessage("169ojqRJ3d4f7aNMu86nAAwGJyeykmByFU", message, tc.uncompressed); err != nil {
+			t.Fatalf("VerifyMessage uncompressed %q: %v", tc.message, err)
+		}
+		if err := VerifyMessage("14vV3aCHBeStb5bkenkNHbe2YAFinYdXgc", message, tc.uncompressed); !errors.Is(err, ErrInvalidSignature) {
+			t.Fatalf("uncompressed signature for compressed address error = %v", err)
+		}
+		if err := VerifyMessage(bip322Address, []byte(tc.message+"!"), got); !errors.Is(err, ErrInvalidSignature) {
+			t.Fatalf("wrong message error = %v", err)
+		}
+	}
+
+	for addressType, header := range map[AddressType]byte{P2SHP2WPKH: 35, P2WPKH: 39} {
+		sig, err := key.SignMessage([]byte("header"), addressType)
+		if err != nil {
+			t.Fatalf("SignMessage type %d: %v", addressType, err)
+		}
+		raw, _ := base64.StdEncoding.DecodeString(sig)
+		if raw[0]-header > 3 {
+			t.Fatalf("type %d header = %d", addressType, raw[0])
+		}
+	}
+	if _, err := key.SignMessage(nil, P2TR); !errors.Is(err, ErrUnsupportedAddress) {
+		t.Fatalf("taproot SignMessage error = %v", err)
+	}
+	sig, _ := key.SignMessage(nil, P2PKH)
+	if err := VerifyMessage("bc1ppv609nr0vr25u07u95waq5lucwfm6tde4nydujnu8npg4q75mr5sxq8lt3", nil, sig); !errors.Is(err, ErrUnsupportedAddress) {
+		t.Fatalf("taproot VerifyMessage error = %v", err)
+	}
+	for _, bad := range []string{"", "!!", sig[:len(sig)-4], base64.StdEncoding.EncodeToString(append([]byte{43}, make([]byte, 64)...))} {
+		if _, err := RecoverMessagePublicKey(nil, bad); !errors.Is(err, ErrInvalidSignature) {
+			t.Fatalf("RecoverMessagePublicKey(%q) error = %v", bad, err)
+		}
+	}
+	var nilKey *XPrv
+	if _, err := nilKey.SignMessage(nil, P2PKH); !errors.Is(err, ErrNilKey) {
+		t.Fatalf("nil SignMessage error = %v", err)
+	}
+}
+
+func TestBIP322SimpleSignatures(t *testing.T) {
+	key := mustWIF(t, bip322WIF)
+	for _, tc := range []struct {
+		message, toSpend, signature string
+	}{
+		{"", "c5680aa69bb8d860bf82d4e9cd3504b55dde018de765a91bb566283c545a99a7", "AkcwRAIgM2gBAQqvZX15ZiysmKmQpDrG83avLIT492QBzLnQIxYCIBaTpOaD20qRlEylyxFSeEA2ba9YOixpX8z46TSDtS40ASECx/EgAxlkQpQ9hYjgGu6EBCPMVPwVIVJqO4XCsMvViHI="},
+		{"Hello World", "b79d196740ad5217771c1098fc4a4b51e0535c32236c71f1ea4d61a2d603352b", "AkcwRAIgZRfIY3p7/DoVTty6YZbWS71bc5Vct9p9Fia83eRmw2QCICK/ENGfwLtptFluMGs2KsqoNSk89pO7F29zJLUx9a/sASECx/EgAxlkQpQ9hYjgGu6EBCPMVPwVIVJqO4XCsMvViHI="},
+	} {
+		message := []byte(tc.message)
+		script, _, err := decodeAddress(bip322Address)
+		if err != nil {
+			t.Fatalf("decodeAddress: %v", err)
+		}
+		txid := bip322ToSpendID(script, message)
+		for i, j := 0, len(txid)-1; i < j; i, j = i+1, j-1 {
+			txid[i], txid[j] = txid[j], txid[i]
+		}
+		if got := hex.EncodeToString(txid[:]); got != tc.toSpend {
+			t.Fatalf("to_spend(%q) = %s", tc.message, got)
+		}
+
+		got, err := key.SignMessageBIP322(message, P2WPKH)
+		if err != nil || got != tc.signature {
+			t.Fatalf("SignMessageBIP322(%q) = %q, %v", tc.message, got, err)
+		}
+		if err := VerifyMessageBIP322(bip322Address, message, got); err != nil {
+			t.Fatalf("VerifyMessageBIP322(%q): %v", tc.message, err)
+		}
+		if err := VerifyMessageBIP322(bip322Address, []byte(tc.message+"!"), got); !errors.Is(err, ErrInvalidSignature) {
+			t.Fatalf("wrong message error = %v", err)
+		}
+	}
+
+	const taprootAddress = "bc1ppv609nr0vr25u07u95waq5lucwfm6tde4nydujnu8npg4q75mr5sxq8lt3"
+	const taprootSignature = "AUHd69PrJQEv+oKTfZ8l+WROBHuy9HKrbFCJu7U1iK2iiEy1vMU5EfMtjc+VSHM7aU0SDbak5IUZRVno2P5mjSafAQ=="
+	if err := VerifyMessageBIP322(taprootAddress, []byte("Hello World"), taprootSignature); err != nil {
+		t.Fatalf("taproot vector: %v", err)
+	}
+	sig, err := key.SignMessageBIP322([]byte("Hello World"), P2TR)
+	if err != nil {
+		t.Fatalf("SignMessageBIP322 taproot: %v", err)
+	}
+	if err := VerifyMessageBIP322(taprootAddress, []byte("Hello World"), sig); err != nil {
+		t.Fatalf("taproot round trip: %v", err)
+	}
+	if err := VerifyMessageBIP322(taprootAddress, []byte("Hello World!"), sig); !errors.Is(err, ErrInvalidSignature) {
+		t.Fatalf("taproot wrong message error = %v", err)
+	}
+
+	wrongKey := mustMaster(t, Mainnet)
+	other, err := wrongKey.SignMessageBIP322([]byte("Hello World"), P2WPKH)
+	if err != nil {
+		t.Fatalf("SignMessageBIP322 other key: %v", err)
+	}
+	if err := VerifyMessageBIP322(bip322Address, []byte("Hello World"), other); !errors.Is(err, ErrInvalidSignature) {
+		t.Fatalf("wrong key error = %v", err)
+	}
+	if _, err := key.SignMessageBIP322(nil, P2PKH); !errors.Is(err, ErrUnsupportedAddress) {
+		t.Fatalf("P2PKH SignMessageBIP322 error = %v", err)
+	}
+	if err := VerifyMessageBIP322("14vV3aCHBeStb5bkenkNHbe2YAFinYdXgc", nil, other); !errors.Is(err, ErrUnsupportedAddress) {
+		t.Fatalf("P2PKH VerifyMessageBIP322 error = %v", err)
+	}
+	for _, bad := range []string{"", "!!", strings.TrimSuffix(other, "=") + "A", base64.StdEncoding.EncodeToString([]byte{0xfd, 1, 0})} {
+		if err := VerifyMessageBIP322(bip322Address, nil, bad); !errors.Is(err, ErrInvalidSignature) {
+			t.Fatalf("VerifyMessageBIP322(%q) error = %v", bad, err)
+		}
+	}
+}
+
+func TestDEREncoding(t *testing.T) {
+	var sig [64]byte
+	sig[31], sig[32] = 1, 0x80
+	der := encodeDER(&sig)
+	if got := hex.EncodeToString(der); got != "3026020101022100"+"80"+strings.Repeat("00", 31) {
+		t.Fatalf("encodeDER = %s", got)
+	}
+	parsed, ok := parseDER(der)
+	if !ok || parsed != sig {
+		t.Fatalf("parseDER round trip = %x, %v", parsed, ok)
+	}
+	for _, bad := range []string{
+		"3006020101020101" + "00",                            // trailing data
+		"300602010002810101",                                 // negative s
+		"30070202000102010101",                               // padded r
+		"3006020101020101"[:14],                              // truncated
+		"3106020101020101",                                   // wrong tag
+		"302602210101" + strings.Repeat("00", 31) + "020101", // 33-byte r without zero pad
+	} {
+		raw, _ := hex.DecodeString(bad)
+		if _, ok := parseDER(raw); ok {
+			t.Fatalf("parseDER(%s) accepted", bad)
+		}
+	}
+}
+
+func mustWIF(t *testing.T, wif string) *XPrv {
+	t.Helper()
+	payload, err := base58CheckDecode(wif, 34)
+	if err != nil || payload[0] != 0x80 || payload[33] != 0x01 {
+		t.Fatalf("decode WIF: %v", err)
+	}
+	return &XPrv{key: [PrivateKeySize]byte(payload[1:33]), network: Mainnet, cache: newKeyCache()}
+}
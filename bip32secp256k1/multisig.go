@@ -0,0 +1,184 @@
+package bip32secp256k1
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"slices"
+)
+
+// MaxMultisigKeys is the largest key count OP_CHECKMULTISIG accepts.
+const MaxMultisigKeys = 20
+
+// BIP48Path returns the BIP-48 cosigner account path
+// m/48'/coin'/account'/script' for a multisig wallet. The coin type is 0 on
+// Mainnet and 1 on Testnet, and addressType must be P2SHP2WSH (script type
+// 1') or P2WSH (script type 2').
+func BIP48Path(network Network, account uint32, addressType AddressType) (string, error) {
+	if !validNetwork(network) {
+		return "", ErrInvalidNetwork
+	}
+	if IsHardened(account) {
+		return "", ErrInvalidPath
+	}
+	var scriptType int
+	switch addressType {
+	case P2SHP2WSH:
+		scriptType = 1
+	case P2WSH:
+		scriptType = 2
+	default:
+		return "", ErrUnsupportedAddress
+	}
+	coin := 0
+	if network == Testnet {
+		coin = 1
+	}
+	return fmt.Sprintf("m/48'/%d'/%d'/%d'", coin, account, scriptType), nil
+}
+
+// Multisig is an m-of-n wallet over cosigner account xpubs, such as the
+// BIP-48 accounts of several hardware wallets. Child keys are sorted as
+// BIP-67 specifies, so the cosigner order does not affect scripts or
+// addresses.
+type Multisig struct {
+	threshold int
+	cosigners []*XPub
+}
+
+// NewMultisig validates a threshold and cosigner set. All cosigners must be
+// on the same network and at the same depth, and no key may appear twice:
+// two cosigners with the same fingerprint usually mean one wallet's xpub was
+// imported twice, which silently lowers the real threshold.
+func NewMultisig(threshold int, cosigners []*XPub) (*Multisig, error) {
+	if len(cosigners) == 0 || len(cosigners) > MaxMultisigKeys || threshold < 1 || threshold > len(cosigners) {
+		return nil, ErrInvalidThreshold
+	}
+	seen := make(map[[FingerprintSize]byte]bool, len(cosigners))
+	for n, cosigner := range cosigners {
+		if cosigner == nil {
+			return nil, ErrNilKey
+		}
+		if cosigner.network != cosigners[0].network {
+			return nil, fmt.Errorf("%w: cosigner %d", ErrInvalidNetwork, n)
+		}
+		if cosigner.depth != cosigners[0].depth {
+			return nil, fmt.Errorf("%w: cosigner %d", ErrCosignerDepth, n)
+		}
+		fingerprint := cosigner.fingerprint()
+		if seen[fingerprint] {
+			return nil, fmt.Errorf("%w: cosigner %d", ErrDuplicateCosigner, n)
+		}
+		seen[fingerprint] = true
+	}
+	m := &Multisig{threshold: threshold, cosigners: make([]*XPub, len(cosigners))}
+	for n, cosigner := range cosigners {
+		m.cosigners[n] = cosigner.clone()
+	}
+	return m, nil
+}
+
+// Threshold returns the number of signatures the wallet requires.
+func (m *Multisig) Threshold() int {
+	if m == nil {
+		return 0
+	}
+	return m.threshold
+}
+
+// Network returns the cosigners' network.
+func (m *Multisig) Network() Network {
+	if m == nil {
+		return 0
+	}
+	return m.cosigners[0].network
+}
+
+// PublicKeys derives every cosigner's key at change/index, the last two
+// BIP-48 path levels, and returns them in BIP-67 order.
+func (m *Multisig) PublicKeys(change, index uint32) ([][PublicKeySize]byte, error) {
+	if m == nil {
+		return nil, ErrNilKey
+	}
+	keys := make([][PublicKeySize]byte, len(m.cosigners))
+	for n, cosigner := range m.cosigners {
+		branch, err := cosigner.Derive(change)
+		if err != nil {
+			return nil, err
+		}
+		child, err := branch.Derive(index)
+		if err != nil {
+			return nil, err
+		}
+		keys[n] = child.pub
+	}
+	SortPublicKeys(keys)
+	return keys, nil
+}
+
+// WitnessScript returns the sorted OP_CHECKMULTISIG script at change/index.
+func (m *Multisig) WitnessScript(change, index uint32) ([]byte, error) {
+	keys, err := m.PublicKeys(change, index)
+	if err != nil {
+		return nil, err
+	}
+	return MultisigScript(m.threshold, keys)
+}
+
+// Address returns the P2WSH or P2SH-P2WSH address of the multisig script at
+// change/index.
+func (m *Multisig) Address(change, index uint32, addressType AddressType) (string, error) {
+	script, err := m.WitnessScript(change, index)
+	if err != nil {
+		return "", err
+	}
+	return MultisigAddress(script, m.Network(), addressType)
+}
+
+// SortPublicKeys sorts compressed public keys lexicographically in place, as
+// BIP-67 specifies for multisig scripts.
+func SortPublicKeys(keys [][PublicKeySize]byte) {
+	slices.SortFunc(keys, func(a, b [PublicKeySize]byte) int {
+		return bytes.Compare(a[:], b[:])
+	})
+}
+
+// MultisigScript returns OP_threshold <keys...> OP_n OP_CHECKMULTISIG with
+// the keys in the given order. Sort them first with SortPublicKeys for a
+// BIP-67 script.
+func MultisigScript(threshold int, keys [][PublicKeySize]byte) ([]byte, error) {
+	if len(keys) == 0 || len(keys) > MaxMultisigKeys || threshold < 1 || threshold > len(keys) {
+		return nil, ErrInvalidThreshold
+	}
+	script := make([]byte, 0, 3+len(keys)*(1+PublicKeySize))
+	script = appendSmallInt(script, threshold)
+	for _, key := range keys {
+		script = append(script, PublicKeySize)
+		script = append(script, key[:]...)
+	}
+	script = appendSmallInt(script, len(keys))
+	return append(script, opCheckMultisig), nil
+}
+
+// MultisigAddress returns the P2WSH or P2SH-P2WSH address paying to a
+// witness script.
+func MultisigAddress(witnessScript []byte, network Network, addressType AddressType) (string, error) {
+	program := sha256.Sum256(witnessScript)
+	p2wsh := append([]byte{0x00, 32}, program[:]...)
+	switch addressType {
+	case P2WSH:
+		return encodeAddress(p2wsh, network)
+	case P2SHP2WSH:
+		return encodeAddress(p2shScript(hash160(p2wsh)), network)
+	default:
+		return "", ErrUnsupportedAddress
+	}
+}
+
+// appendSmallInt pushes n with OP_1..OP_16, or a one-byte push above that.
+func appendSmallInt(script []byte, n int) []byte {
+	if n <= 16 {
+		return append(script, byte(op1-1+n))
+	}
+	return append(script, 1, byte(n))
+}
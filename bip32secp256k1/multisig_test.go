@@ -0,0 +1,189 @@
+package bip32secp256k1
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+)
+
+func TestBIP48Path(t *testing.T) {
+	for _, tc := range []struct {
+		network     Network
+		account     uint32
+		addressType AddressType
+		want        string
+	}{
+		{Mainnet, 0, P2WSH, "m/48'/0'/0'/2'"},
+		{Mainnet, 3, P2SHP2WSH, "m/48'/0'/3'/1'"},
+		{Testnet, 1, P2WSH, "m/48'/1'/1'/2'"},
+	} {
+		got, err := BIP48Path(tc.network, tc.account, tc.addressType)
+		if err != nil || got != tc.want {
+			t.Fatalf("BIP48Path(%v, %d, %v) = %q, %v; want %q", tc.network, tc.account, tc.addressType, got, err, tc.want)
+		}
+	}
+	if _, err := BIP48Path(Mainnet, 0, P2WPKH); !errors.Is(err, ErrUnsupportedAddress) {
+		t.Fatalf("single-key type error = %v", err)
+	}
+	if _, err := BIP48Path(Mainnet, HardenedOffset, P2WSH); !errors.Is(err, ErrInvalidPath) {
+		t.Fatalf("hardened account error = %v", err)
+	}
+	if _, err := BIP48Path(Network(9), 0, P2WSH); !errors.Is(err, ErrInvalidNetwork) {
+		t.Fatalf("network error = %v", err)
+	}
+}
+
+type multisigVector struct {
+	change, index uint32
+	script        string
+	p2wsh, p2sh   string
+}
+
+// Vectors cross-checked against btcd's hdkeychain, txscript.MultiSigScript
+// and btcutil address encoding for a 2-of-3 over accounts 0..2 of testSeed.
+func TestMultisigAddresses(t *testing.T) {
+	for _, tc := range []struct {
+		network Network
+		xpubs   []string
+		want    []multisigVector
+	}{
+		{Mainnet, []string{
+			"xpub6E64WfdQwBGz85XhbZryr9gUGUPBgoSu5WV6tJWpzAvgAmpVpdPHkT3XYm9R5J6MeWzvLQoz4q845taC9Q28XutbptxAmg7q8QPkjvTL4oi",
+			"xpub6EtmjZxncacfqGwpL4D1p5u87FcGp2mu7Hmcoteii4mMeonD72s9HthbHHT2LkXZfBNhCT6WwDsRevMBRRNjMueHNmzD6RQTqXjQycDSm21",
+			"xpub6DhxrNgFCXQwFxbdQnHpEYS3BPcCbKc4GkHtp8d7U81kojjqr9XaD4CoRA8GLoqmRFcm4x8RyBCdYDfRawBpwq3ptoAn6F8CLQ74XN4Ck3s",
+		}, []multisigVector{
+			{0, 0,
+				"5221026ec83e0640767ea6c3452d097843b7754be2def26ba74ee28b33d42d4a05f11121039313c80a98460104a4e9abc86f1f73d1fa982ecb3172269d917415e6aeee26752103d4e72831222f5aca68bef091b3524fbf0df32049714ebbfea0b1980d44acc36453ae",
+				"bc1qruke643fa3dk6x5y3tuzx5kpgh663td8ve7d4a6l7gepducfdqdsxlmrpn", "3HRvoGFCZpK914gtMV73bQrarRRZP7o5Zc"},
+			{1, 5,
+				"5221024bf4c4223c18bd1dedb208e9c57fd816242a8391b863930a6c8c74e4a5c0fe0f2103d9189f085f1e5134d39d2d25d02e94bd25605842a80306254ee60f170e60183a2103f309cd9f978cadd90fe3c5ecb4d0033f6c1c19ec8b481e4ffddb393a81bbf04653ae",
+				"bc1qcavea3nny29kee6pwwxyl5nmcl2u4h0cpwjdkz368nmf0cjqa4ns948w7t", "36G9gQKL7s83MEG2wnkgp7S1s33HzP7nwj"},
+		}},
+		{Testnet, []string{
+			"tpubDEC8p4skY4i7mNjxt9yF3u7my5T6KtGLcvEDcH5rKA6XVtJN4JV3SsHWoePTqsHNh47WBkQ79r77KsUYc2PAmaqqasBJMvidbxiupZpJexg",
+			"tpubDFAkyi7Hw6GQJuttSzbBMEdxXH2nyz1pV2pVJtUikW5XgZgw1JEaCEdcsK5Vq6zvhhJacm4rY42AypLDc8pbsRT76Z5wNEVPYzN27oLPFRi",
+			"tpubDFE4mcAUAjbyqLPcD3S5YHbdYcTVVMgo74a5TxfeorFXKQbtREXdkajt6Q6SSGeQc6tsKiZSV1GuEB5DxEVqfxycBPLbR2Wn8CamhqicF2a",
+		}, []multisigVector{
+			{0, 0,
+				"52210245daf6c677b6b57c34d28f16582c2fb5e96eb513e0af583d5af5a6deb7dfa50a2102c59421c1dcb3e7b99d68f91142e53dab0e4cb75ce09ba32c98b254463c9372612103a416734a7729d2e0a4f885f4e939618a5a45f5f32511641236536a24272d99f353ae",
+				"tb1q4gjvjrj3sm760kjy082d68unnzvw3745s04vkhlcvs55mhfr39tquwnee7", "2MuqBS8gfv24qdu1pgtdvUxRCoCKmCNBBk7"},
+			{1, 5,
+				"5221024655b9f134a2c1ed46f9b8b698760532aee81fea3b8cc0696f5b5ede516f91192103457c62648d3f01db04d60bf3a809dabb8a0fa83ecc496c3906b55aa333ae5752210360fe9c0519d8c4837fbe2dd585287382b12b575231fa3896e1908962984c3cb053ae",
+				"tb1q44utqugkc5hhmjd0m0wqjsx70n4r3xmlw6aw8kmqryrdqglq5g8qpw49h3", "2N5nVrZxfd7U1KZzoFkuxUkgnfWkjwirQet"},
+		}},
+	} {
+		root := mustMaster(t, tc.network)
+		cosigners := make([]*XPub, len(tc.xpubs))
+		for account, encoded := range tc.xpubs {
+			path, err := BIP48Path(tc.network, uint32(account), P2WSH)
+			if err != nil {
+				t.Fatalf("BIP48Path: %v", err)
+			}
+			key, err := root.DerivePath(path)
+			if err != nil {
+				t.Fatalf("DerivePath(%s): %v", path, err)
+			}
+			xpub, err := key.XPub()
+			if err != nil {
+				t.Fatalf("XPub: %v", err)
+			}
+			if got, err := xpub.Encode(); err != nil || got != encoded {
+				t.Fatalf("%s = %s, %v; want %s", path, got, err, encoded)
+			}
+			cosigners[account] = xpub
+		}
+		// Reversing the cosigners must not change anything under BIP-67.
+		reversed := []*XPub{cosigners[2], cosigners[1], cosigners[0]}
+		for _, order := range [][]*XPub{cosigners, reversed} {
+			wallet, err := NewMultisig(2, order)
+			if err != nil {
+				t.Fatalf("NewMultisig: %v", err)
+			}
+			if wallet.Threshold() != 2 || wallet.Network() != tc.network {
+				t.Fatalf("wallet = %d, %v", wallet.Threshold(), wallet.Network())
+			}
+			for _, want := range tc.want {
+				script, err := wallet.WitnessScript(want.change, want.index)
+				if err != nil || hex.EncodeToString(script) != want.script {
+					t.Fatalf("WitnessScript(%d, %d) = %x, %v", want.change, want.index, script, err)
+				}
+				if got, err := wallet.Address(want.change, want.index, P2WSH); err != nil || got != want.p2wsh {
+					t.Fatalf("P2WSH(%d, %d) = %s, %v; want %s", want.change, want.index, got, err, want.p2wsh)
+				}
+				if got, err := wallet.Address(want.change, want.index, P2SHP2WSH); err != nil || got != want.p2sh {
+					t.Fatalf("P2SH-P2WSH(%d, %d) = %s, %v; want %s", want.change, want.index, got, err, want.p2sh)
+				}
+			}
+		}
+		wallet, err := NewMultisig(2, cosigners)
+		if err != nil {
+			t.Fatalf("NewMultisig: %v", err)
+		}
+		if _, err := wallet.Address(0, 0, P2WPKH); !errors.Is(err, ErrUnsupportedAddress) {
+			t.Fatalf("single-key address error = %v", err)
+		}
+		if _, err := wallet.PublicKeys(0, HardenedOffset); !errors.Is(err, ErrHardenedFromXPub) {
+			t.Fatalf("hardened child error = %v", err)
+		}
+		if _, err := cosigners[0].Address(P2WSH); !errors.Is(err, ErrUnsupportedAddress) {
+			t.Fatalf("XPub.Address(P2WSH) error = %v", err)
+		}
+	}
+}
+
+func TestMultisigValidation(t *testing.T) {
+	root := mustMaster(t, Mainnet)
+	xpubAt := func(root *XPrv, path string) *XPub {
+		t.Helper()
+		key, err := root.DerivePath(path)
+		if err != nil {
+			t.Fatalf("DerivePath(%s): %v", path, err)
+		}
+		xpub, err := key.XPub()
+		if err != nil {
+			t.Fatalf("XPub: %v", err)
+		}
+		return xpub
+	}
+	a := xpubAt(root, "m/48'/0'/0'/2'")
+	b := xpubAt(root, "m/48'/0'/1'/2'")
+	shallow := xpubAt(root, "m/48'/0'/2'")
+	testnet := xpubAt(mustMaster(t, Testnet), "m/48'/1'/0'/2'")
+
+	for _, tc := range []struct {
+		name      string
+		threshold int
+		cosigners []*XPub
+		want      error
+	}{
+		{"empty", 1, nil, ErrInvalidThreshold},
+		{"zero threshold", 0, []*XPub{a, b}, ErrInvalidThreshold},
+		{"threshold above n", 3, []*XPub{a, b}, ErrInvalidThreshold},
+		{"nil cosigner", 1, []*XPub{a, nil}, ErrNilKey},
+		{"network", 2, []*XPub{a, testnet}, ErrInvalidNetwork},
+		{"depth", 2, []*XPub{a, shallow}, ErrCosignerDepth},
+		{"duplicate", 2, []*XPub{a, b, a}, ErrDuplicateCosigner},
+	} {
+		if _, err := NewMultisig(tc.threshold, tc.cosigners); !errors.Is(err, tc.want) {
+			t.Fatalf("%s: error = %v, want %v", tc.name, err, tc.want)
+		}
+	}
+
+	keys := make([][PublicKeySize]byte, MaxMultisigKeys+1)
+	if _, err := MultisigScript(1, keys); !errors.Is(err, ErrInvalidThreshold) {
+		t.Fatalf("oversized script error = %v", err)
+	}
+	// Key counts above 16 use a one-byte push instead of OP_n.
+	script, err := MultisigScript(17, keys[:MaxMultisigKeys])
+	if err != nil {
+		t.Fatalf("MultisigScript: %v", err)
+	}
+	if script[0] != 1 || script[1] != 17 || script[len(script)-3] != 1 || script[len(script)-2] != MaxMultisigKeys || script[len(script)-1] != opCheckMultisig {
+		t.Fatalf("large multisig script framing = %x", script)
+	}
+
+	var nilWallet *Multisig
+	if _, err := nilWallet.Address(0, 0, P2WSH); !errors.Is(err, ErrNilKey) {
+		t.Fatalf("nil wallet error = %v", err)
+	}
+}
@@ -0,0 +1,349 @@
+package bip32secp256k1
+
+import (
+	"bytes"
+	"crypto/rand"
+	"fmt"
+	"io"
+	"slices"
+	"sync/atomic"
+
+	internalsecp "github.com/islishude/bip32/v2/internal/secp256k1"
+)
+
+// MuSig2 encoding sizes.
+const (
+	// MuSig2PubNonceSize is the byte length of a public nonce or aggregate
+	// nonce: two compressed points.
+	MuSig2PubNonceSize = internalsecp.MuSig2PubNonceSize
+	// MuSig2PartialSignatureSize is the byte length of a partial signature.
+	MuSig2PartialSignatureSize = 32
+	// SchnorrSignatureSize is the byte length of a BIP-340 signature.
+	SchnorrSignatureSize = internalsecp.SignatureSize
+)
+
+// bip328ChainCode is the fixed chain code BIP-328 assigns to aggregate keys.
+var bip328ChainCode = [ChainCodeSize]byte{
+	0x86, 0x80, 0x87, 0xca, 0x02, 0xa6, 0xf9, 0x74,
+	0xc4, 0x59, 0x89, 0x24, 0xc3, 0x6b, 0x57, 0x76,
+	0x2d, 0x32, 0xcb, 0x45, 0x71, 0x71, 0x67, 0xe3,
+	0x00, 0x62, 0x2c, 0x71, 0x67, 0xe3, 0x89, 0x65,
+}
+
+// AggregateKey is a BIP-327 MuSig2 aggregate of an ordered list of
+// participant keys, together with any tweaks applied to it.
+//
+// A fresh aggregate is also the BIP-328 extended public key with the fixed
+// aggregate chain code, so normal BIP-32 derivation can be applied to it
+// with Derive. Each derivation step is recorded as a plain tweak, which lets
+// the original participant keys sign for the derived key. An AggregateKey is
+// immutable; derivation and tweaking return new values.
+type AggregateKey struct {
+	ctx internalsecp.KeyAggContext
+	// xpub is the BIP-328 extended key at the current derivation position,
+	// or nil once an explicit tweak has moved the key off that chain.
+	xpub *XPub
+}
+
+// SortXPubs returns participants sorted by compressed public key, the
+// BIP-327 KeySort order, so that every participant derives the same
+// aggregate key without agreeing on an order.
+func SortXPubs(participants []*XPub) []*XPub {
+	sorted := slices.Clone(participants)
+	slices.SortStableFunc(sorted, func(a, b *XPub) int {
+		pa, pb := a.PublicKey(), b.PublicKey()
+		return bytes.Compare(pa[:], pb[:])
+	})
+	return sorted
+}
+
+// AggregateXPubs aggregates the participants' public keys in the given
+// order. Only the public keys are used; chain codes and metadata are
+// ignored. All participants must be on the same network, which the BIP-328
+// aggregate extended key inherits. A key may appear more than once.
+func AggregateXPubs(participants []*XPub) (*AggregateKey, error) {
+	if len(participants) == 0 {
+		return nil, ErrInvalidPublicKey
+	}
+	pubs := make([][PublicKeySize]byte, len(participants))
+	for n, participant := range participants {
+		if participant == nil {
+			return nil, ErrNilKey
+		}
+		if participant.network != participants[0].network {
+			return nil, ErrInvalidNetwork
+		}
+		pubs[n] = participant.pub
+	}
+	ctx, bad, ok := internalsecp.KeyAgg(pubs)
+	if !ok {
+		if bad >= 0 {
+			return nil, fmt.Errorf("%w: participant %d", ErrInvalidXPub, bad)
+		}
+		return nil, ErrInvalidPublicKey
+	}
+	return &AggregateKey{
+		ctx: ctx,
+		xpub: &XPub{
+			pub:     ctx.PublicKey(),
+			cc:      bip328ChainCode,
+			network: participants[0].network,
+			cache:   newKeyCache(),
+		},
+	}, nil
+}
+
+// PublicKey returns the compressed aggregate public key with all derivation
+// steps and tweaks applied.
+func (a *AggregateKey) PublicKey() [PublicKeySize]byte {
+	if a == nil {
+		return [PublicKeySize]byte{}
+	}
+	return a.ctx.PublicKey()
+}
+
+// XOnlyPublicKey returns the BIP-340 x-only aggregate public key, the key
+// that final MuSig2 signatures verify against.
+func (a *AggregateKey) XOnlyPublicKey() [internalsecp.XOnlyPublicKeySize]byte {
+	if a == nil {
+		return [internalsecp.XOnlyPublicKeySize]byte{}
+	}
+	return a.ctx.XOnlyPublicKey()
+}
+
+// XPub returns the BIP-328 extended public key at the current derivation
+// position. It returns ErrTweakedKey after Tweak or TaprootTweak.
+func (a *AggregateKey) XPub() (*XPub, error) {
+	if a == nil {
+		return nil, ErrNilKey
+	}
+	if a.xpub == nil {
+		return nil, ErrTweakedKey
+	}
+	return a.xpub.clone(), nil
+}
+
+// Derive applies BIP-32 normal derivation at index to the aggregate key.
+// The result's public key equals XPub().Derive(index), and signing sessions
+// for it produce signatures valid under that child key.
+func (a *AggregateKey) Derive(index uint32) (*AggregateKey, error) {
+	if a == nil {
+		return nil, ErrNilKey
+	}
+	if a.xpub == nil {
+		return nil, ErrTweakedKey
+	}
+	child, err := a.xpub.Derive(index)
+	if err != nil {
+		return nil, err
+	}
+	i := a.xpub.childHMAC(index, hmacSHA512)
+	defer clear(i[:])
+	ctx := a.ctx
+	if !ctx.ApplyTweak((*[32]byte)(i[:PrivateKeySize]), false) {
+		return nil, ErrInvalidChild
+	}
+	return &AggregateKey{ctx: ctx, xpub: child}, nil
+}
+
+// DeriveRelativePath applies a normal relative path with Derive. Any
+// hardened segment returns ErrHardenedFromXPub.
+func (a *AggregateKey) DeriveRelativePath(path string) (*AggregateKey, error) {
+	if a == nil {
+		return nil, ErrNilKey
+	}
+	indexes, err := ParseRelativePath(path)
+	if err != nil {
+		return nil, err
+	}
+	child := a
+	for _, index := range indexes {
+		child, err = child.Derive(index)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return child, nil
+}
+
+// Tweak adds tweak*G to the aggregate key. An x-only tweak first negates the
+// key if its y-coordinate is odd, as taproot requires. The tweaked key is no
+// longer a BIP-328 extended key, so it cannot be derived further.
+func (a *AggregateKey) Tweak(tweak [32]byte, xOnly bool) (*AggregateKey, error) {
+	if a == nil {
+		return nil, ErrNilKey
+	}
+	ctx := a.ctx
+	if !ctx.ApplyTweak(&tweak, xOnly) {
+		return nil, ErrInvalidChild
+	}
+	return &AggregateKey{ctx: ctx}, nil
+}
+
+// TaprootTweak applies the BIP-341 output key tweak for merkleRoot, which is
+// empty for a key-path-only output as in BIP-86. Signatures from the result
+// spend the taproot output whose key is XOnlyPublicKey.
+func (a *AggregateKey) TaprootTweak(merkleRoot []byte) (*AggregateKey, error) {
+	if a == nil {
+		return nil, ErrNilKey
+	}
+	internalKey := a.ctx.XOnlyPublicKey()
+	return a.Tweak(internalsecp.TaggedHash("TapTweak", internalKey[:], merkleRoot), true)
+}
+
+// MuSig2Nonce is a signer's secret nonce for a single signing session. It
+// cannot be serialized: keep it in memory between the two rounds. Signing
+// wipes it, and a wiped nonce is rejected with ErrNonceReused, because
+// signing twice with one nonce reveals the private key. It is safe for
+// concurrent use: of several concurrent MuSig2Sign calls, only one signs.
+type MuSig2Nonce struct {
+	secret [internalsecp.MuSig2SecNonceSize]byte
+	public [MuSig2PubNonceSize]byte
+	// used is set by whichever of MuSig2Sign and Wipe claims the nonce
+	// first. Only the claimant reads or clears secret.
+	used atomic.Bool
+}
+
+// NewMuSig2Nonce generates a fresh nonce pair for signing with this key.
+//
+// Randomness is drawn from r, or crypto/rand.Reader when r is nil. As
+// BIP-327 recommends, the private key, the aggregate key and the message
+// are mixed in when known, so a weak random source alone does not lead to
+// nonce reuse; agg and message may be nil.
+func (k *XPrv) NewMuSig2Nonce(r io.Reader, agg *AggregateKey, message []byte) (*MuSig2Nonce, error) {
+	if k == nil {
+		return nil, ErrNilKey
+	}
+	pub, err := k.PublicKey()
+	if err != nil {
+		return nil, err
+	}
+	if r == nil {
+		r = rand.Reader
+	}
+	var seed [32]byte
+	defer clear(seed[:])
+	if _, err := io.ReadFull(r, seed[:]); err != nil {
+		return nil, err
+	}
+	var aggpk *[internalsecp.XOnlyPublicKeySize]byte
+	if agg != nil {
+		x := agg.XOnlyPublicKey()
+		aggpk = &x
+	}
+	nonce := new(MuSig2Nonce)
+	var ok bool
+	nonce.secret, nonce.public, ok = internalsecp.MuSig2NonceGen(&seed, &k.key, &pub, aggpk, message, nil)
+	if !ok {
+		return nil, ErrInvalidNonce
+	}
+	return nonce, nil
+}
+
+// Public returns the public nonce to send to the other signers.
+func (n *MuSig2Nonce) Public() [MuSig2PubNonceSize]byte {
+	if n == nil {
+		return [MuSig2PubNonceSize]byte{}
+	}
+	return n.public
+}
+
+// Wipe zeroes the secret nonce, for example when a session is abandoned.
+func (n *MuSig2Nonce) Wipe() {
+	if n == nil || !n.used.CompareAndSwap(false, true) {
+		return
+	}
+	clear(n.secret[:])
+}
+
+// AggregateMuSig2Nonces combines every signer's public nonce into the
+// aggregate nonce for a session. An undecodable nonce returns an error
+// wrapping ErrInvalidNonce that names the signer's position.
+func AggregateMuSig2Nonces(nonces [][MuSig2PubNonceSize]byte) ([MuSig2PubNonceSize]byte, error) {
+	aggNonce, bad, ok := internalsecp.MuSig2NonceAgg(nonces)
+	if !ok {
+		if bad >= 0 {
+			return aggNonce, fmt.Errorf("%w: signer %d", ErrInvalidNonce, bad)
+		}
+		return aggNonce, ErrInvalidNonce
+	}
+	return aggNonce, nil
+}
+
+// MuSig2Session is the state shared by all signers of one message under one
+// aggregate key and aggregate nonce.
+type MuSig2Session struct {
+	session internalsecp.MuSig2Session
+}
+
+// NewMuSig2Session starts a signing session for message, which may be of
+// any length; Bitcoin signs 32-byte sighashes.
+func (a *AggregateKey) NewMuSig2Session(aggNonce [MuSig2PubNonceSize]byte, message []byte) (*MuSig2Session, error) {
+	if a == nil {
+		return nil, ErrNilKey
+	}
+	session, ok := internalsecp.NewMuSig2Session(&a.ctx, &aggNonce, message)
+	if !ok {
+		return nil, ErrInvalidNonce
+	}
+	return &MuSig2Session{session: session}, nil
+}
+
+// MuSig2Sign produces this participant's partial signature with a nonce
+// from NewMuSig2Nonce on the same key. The nonce is wiped even when signing
+// fails, so a failed session must restart with fresh nonces.
+func (k *XPrv) MuSig2Sign(nonce *MuSig2Nonce, session *MuSig2Session) ([MuSig2PartialSignatureSize]byte, error) {
+	if k == nil || nonce == nil || session == nil {
+		return [MuSig2PartialSignatureSize]byte{}, ErrNilKey
+	}
+	if !nonce.used.CompareAndSwap(false, true) {
+		return [MuSig2PartialSignatureSize]byte{}, ErrNonceReused
+	}
+	secret := nonce.secret
+	clear(nonce.secret[:])
+	defer clear(secret[:])
+	pub, err := k.PublicKey()
+	if err != nil {
+		return [MuSig2PartialSignatureSize]byte{}, err
+	}
+	if [PublicKeySize]byte(secret[2*PrivateKeySize:]) != pub {
+		return [MuSig2PartialSignatureSize]byte{}, ErrInvalidNonce
+	}
+	psig, ok := session.session.Sign(&secret, &k.key)
+	if !ok {
+		return [MuSig2PartialSignatureSize]byte{}, ErrNotParticipant
+	}
+	return psig, nil
+}
+
+// VerifyPartial checks a participant's partial signature against the public
+// nonce they sent. It lets an aggregator identify a misbehaving signer when
+// the final signature does not verify.
+func (s *MuSig2Session) VerifyPartial(partial [MuSig2PartialSignatureSize]byte, pubNonce [MuSig2PubNonceSize]byte, signer *XPub) error {
+	if s == nil || signer == nil {
+		return ErrNilKey
+	}
+	if !s.session.VerifyPartial(&partial, &pubNonce, &signer.pub) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+// Aggregate combines the partial signatures of all participants into a
+// BIP-340 signature valid under the aggregate key's XOnlyPublicKey. An
+// out-of-range partial signature returns an error wrapping
+// ErrInvalidSignature that names its position. Aggregate does not verify
+// the partial signatures; use VerifyPartial for that.
+func (s *MuSig2Session) Aggregate(partials [][MuSig2PartialSignatureSize]byte) ([SchnorrSignatureSize]byte, error) {
+	if s == nil {
+		return [SchnorrSignatureSize]byte{}, ErrNilKey
+	}
+	sig, bad, ok := s.session.Aggregate(partials)
+	if !ok {
+		if bad >= 0 {
+			return sig, fmt.Errorf("%w: signer %d", ErrInvalidSignature, bad)
+		}
+		return sig, ErrInvalidSignature
+	}
+	return sig, nil
+}
@@ -0,0 +1,221 @@
+package bip32secp256k1
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	internalsecp "github.com/islishude/bip32/v2/internal/secp256k1"
+)
+
+func TestBIP328AggregateXPub(t *testing.T) {
+	var participants []*XPub
+	for _, pub := range []string{
+		"03935F972DA013F80AE011890FA89B67A27B7BE6CCB24D3274D18B2D4067F261A9",
+		"02F9308A019258C31049344F85F89D5229B531C845836F99B08601F113BCE036F9",
+		"02DFF1D77F2A671C5F36183726DB2341BE58FEAE1DA2DECED843240F7B502BA659",
+	} {
+		decoded, _ := hex.DecodeString(pub)
+		participants = append(participants, &XPub{pub: [PublicKeySize]byte(decoded), network: Mainnet, cache: newKeyCache()})
+	}
+	agg, err := AggregateXPubs(participants)
+	if err != nil {
+		t.Fatalf("AggregateXPubs: %v", err)
+	}
+	xpub, err := agg.XPub()
+	if err != nil {
+		t.Fatalf("XPub: %v", err)
+	}
+	// Cross-checked against btcec's MuSig2 aggregation and hdkeychain.
+	if got, _ := xpub.Encode(); got != "xpub661MyMwAqRbcFt6tk3uaczE1y6EvM1TqXvawXcYmFEWijEM4PDBnuCXwwYWEfkAjE3mRRoUJHwo63hukyKsSKYoPbmEDefNRgQejpLpuFZk" {
+		t.Fatalf("aggregate xpub = %s", got)
+	}
+	child, err := agg.DeriveRelativePath("0/1")
+	if err != nil {
+		t.Fatalf("DeriveRelativePath: %v", err)
+	}
+	childXPub, _ := child.XPub()
+	if got, _ := childXPub.Encode(); got != "xpub69u3DzTGaszu2pceVPqTEtAsvqEuKfRT6BUb8jvcHqzgDMUkCHK5ZyW3A1Y4KKSPTvPcikk81LvDPgfacPJKxdrJC9yh4ZP9A3VsWCYa9Z2" {
+		t.Fatalf("derived aggregate xpub = %s", got)
+	}
+	if got := child.PublicKey(); hex.EncodeToString(got[:]) != "03b050a0345cf5c61f4095acdb70551105a95f87610d6acb757b459cd3dd249cde" {
+		t.Fatalf("derived aggregate key = %x", got)
+	}
+
+	tweaked, err := agg.TaprootTweak(nil)
+	if err != nil {
+		t.Fatalf("TaprootTweak: %v", err)
+	}
+	if _, err := tweaked.Derive(0); !errors.Is(err, ErrTweakedKey) {
+		t.Fatalf("Derive after tweak error = %v", err)
+	}
+	if _, err := tweaked.XPub(); !errors.Is(err, ErrTweakedKey) {
+		t.Fatalf("XPub after tweak error = %v", err)
+	}
+	if _, err := agg.Derive(HardenedOffset); !errors.Is(err, ErrHardenedFromXPub) {
+		t.Fatalf("hardened Derive error = %v", err)
+	}
+	testnet := *participants[0]
+	testnet.network = Testnet
+	if _, err := AggregateXPubs([]*XPub{participants[0], &testnet}); !errors.Is(err, ErrInvalidNetwork) {
+		t.Fatalf("mixed network error = %v", err)
+	}
+	bad := *participants[1]
+	bad.pub[0] = 0x04
+	if _, err := AggregateXPubs([]*XPub{participants[0], &bad}); !errors.Is(err, ErrInvalidXPub) {
+		t.Fatalf("invalid participant error = %v", err)
+	}
+}
+
+func TestMuSig2SigningRoundTrip(t *testing.T) {
+	root := mustMaster(t, Mainnet)
+	var signers []*XPrv
+	var participants []*XPub
+	for _, path := range []string{"m/48'/0'/0'/2'", "m/48'/0'/1'/2'", "m/48'/0'/2'/2'"} {
+		key, err := root.DerivePath(path)
+		if err != nil {
+			t.Fatalf("DerivePath(%s): %v", path, err)
+		}
+		pub, _ := key.XPub()
+		signers = append(signers, key)
+		participants = append(participants, pub)
+	}
+	sorted := SortXPubs(participants)
+	for i := 1; i < len(sorted); i++ {
+		if bytes.Compare(sorted[i-1].pub[:], sorted[i].pub[:]) >= 0 {
+			t.Fatal("SortXPubs did not sort")
+		}
+	}
+	agg, err := AggregateXPubs(sorted)
+	if err != nil {
+		t.Fatalf("AggregateXPubs: %v", err)
+	}
+
+	derived, err := agg.DeriveRelativePath("0/7")
+	if err != nil {
+		t.Fatalf("DeriveRelativePath: %v", err)
+	}
+	xpub, _ := agg.XPub()
+	want, _ := xpub.DeriveRelativePath("0/7")
+	if derived.PublicKey() != want.PublicKey() {
+		t.Fatal("aggregate derivation differs from xpub derivation")
+	}
+	taproot, err := derived.TaprootTweak(nil)
+	if err != nil {
+		t.Fatalf("TaprootTweak: %v", err)
+	}
+	internalKey := derived.XOnlyPublicKey()
+	if outputKey, _, _ := internalsecp.TaprootOutputKey(&internalKey, nil); outputKey != taproot.XOnlyPublicKey() {
+		t.Fatal("TaprootTweak differs from the BIP-341 output key")
+	}
+
+	for _, agg := range []*AggregateKey{agg, derived, taproot} {
+		msg := [32]byte{1, 2, 3}
+		nonces := make([]*MuSig2Nonce, len(signers))
+		publics := make([][MuSig2PubNonceSize]byte, len(signers))
+		for n, signer := range signers {
+			nonces[n], err = signer.NewMuSig2Nonce(nil, agg, msg[:])
+			if err != nil {
+				t.Fatalf("NewMuSig2Nonce: %v", err)
+			}
+			publics[n] = nonces[n].Public()
+		}
+		aggNonce, err := AggregateMuSig2Nonces(publics)
+		if err != nil {
+			t.Fatalf("AggregateMuSig2Nonces: %v", err)
+		}
+		session, err := agg.NewMuSig2Session(aggNonce, msg[:])
+		if err != nil {
+			t.Fatalf("NewMuSig2Session: %v", err)
+		}
+		partials := make([][MuSig2PartialSignatureSize]byte, len(signers))
+		for n, signer := range signers {
+			partials[n], err = signer.MuSig2Sign(nonces[n], session)
+			if err != nil {
+				t.Fatalf("MuSig2Sign(%d): %v", n, err)
+			}
+			if err := session.VerifyPartial(partials[n], publics[n], participants[n]); err != nil {
+				t.Fatalf("VerifyPartial(%d): %v", n, err)
+			}
+		}
+		if err := session.VerifyPartial(partials[0], publics[1], participants[1]); !errors.Is(err, ErrInvalidSignature) {
+			t.Fatalf("VerifyPartial wrong signer error = %v", err)
+		}
+		sig, err := session.Aggregate(partials)
+		if err != nil {
+			t.Fatalf("Aggregate: %v", err)
+		}
+		xonly := agg.XOnlyPublicKey()
+		if !internalsecp.VerifySchnorr(&xonly, &msg, &sig) {
+			t.Fatal("aggregate signature does not verify")
+		}
+		if _, err := signers[0].MuSig2Sign(nonces[0], session); !errors.Is(err, ErrNonceReused) {
+			t.Fatalf("nonce reuse error = %v", err)
+		}
+	}
+
+	nonce, _ := signers[0].NewMuSig2Nonce(nil, nil, nil)
+	aggNonce, _ := AggregateMuSig2Nonces([][MuSig2PubNonceSize]byte{nonce.Public()})
+	session, _ := agg.NewMuSig2Session(aggNonce, nil)
+	if _, err := signers[1].MuSig2Sign(nonce, session); !errors.Is(err, ErrInvalidNonce) {
+		t.Fatalf("foreign nonce error = %v", err)
+	}
+	outsider, _ := root.DerivePath("m/1'")
+	nonce, _ = outsider.NewMuSig2Nonce(nil, nil, nil)
+	if _, err := outsider.MuSig2Sign(nonce, session); !errors.Is(err, ErrNotParticipant) {
+		t.Fatalf("outsider error = %v", err)
+	}
+	if _, err := AggregateMuSig2Nonces([][MuSig2PubNonceSize]byte{nonce.Public(), {}}); !errors.Is(err, ErrInvalidNonce) {
+		t.Fatalf("invalid nonce error = %v", err)
+	}
+	order := bigTo32(secp256k1Order)
+	if _, err := session.Aggregate([][MuSig2PartialSignatureSize]byte{{}, order}); !errors.Is(err, ErrInvalidSignature) {
+		t.Fatalf("out-of-range partial error = %v", err)
+	}
+}
+
+func TestMuSig2SignConcurrentNonceUse(t *testing.T) {
+	root := mustMaster(t, Mainnet)
+	signer, _ := root.DerivePath("m/48'/0'/0'/2'")
+	pub, _ := signer.XPub()
+	agg, err := AggregateXPubs([]*XPub{pub})
+	if err != nil {
+		t.Fatalf("AggregateXPubs: %v", err)
+	}
+	msg := [32]byte{4, 5, 6}
+	nonce, err := signer.NewMuSig2Nonce(nil, agg, msg[:])
+	if err != nil {
+		t.Fatalf("NewMuSig2Nonce: %v", err)
+	}
+	aggNonce, _ := AggregateMuSig2Nonces([][MuSig2PubNonceSize]byte{nonce.Public()})
+	session, err := agg.NewMuSig2Session(aggNonce, msg[:])
+	if err != nil {
+		t.Fatalf("NewMuSig2Session: %v", err)
+	}
+
+	// Run with -race: concurrent signers and a concurrent Wipe must neither
+	// race on the secret nonce nor both sign with it.
+	const signers = 16
+	var signed atomic.Int32
+	var wg sync.WaitGroup
+	for range signers {
+		wg.Go(func() {
+			if _, err := signer.MuSig2Sign(nonce, session); err == nil {
+				signed.Add(1)
+			} else if !errors.Is(err, ErrNonceReused) {
+				t.Errorf("MuSig2Sign error = %v", err)
+			}
+		})
+	}
+	wg.Go(nonce.Wipe)
+	wg.Wait()
+	if n := signed.Load(); n > 1 {
+		t.Fatalf("%d concurrent MuSig2Sign calls succeeded with one nonce", n)
+	}
+	if nonce.secret != [internalsecp.MuSig2SecNonceSize]byte{} {
+		t.Fatal("secret nonce was not wiped")
+	}
+}
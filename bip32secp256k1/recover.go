@@ -0,0 +1,110 @@
+package bip32secp256k1
+
+import (
+	"crypto/subtle"
+
+	internalsecp "github.com/islishude/bip32/v2/internal/secp256k1"
+)
+
+// RecoverParent recovers the parent extended private key from the parent's
+// extended public key and the private key of one of its normal children.
+//
+// This is the well-known BIP-32 weakness of normal derivation: the child key
+// minus the public tweak HMAC-SHA512(chainCode, parentPub || index) is the
+// parent key. It returns ErrHardenedChild for a hardened child, whose tweak
+// depends on the parent private key, and ErrNotChild when child was not derived
+// from parent. The recovered key carries the metadata of parent.
+func RecoverParent(parent *XPub, child *XPrv) (*XPrv, error) {
+	if parent == nil || child == nil {
+		return nil, ErrNilKey
+	}
+	if IsHardened(child.childNumber) {
+		return nil, ErrHardenedChild
+	}
+	if child.network != parent.network || child.depth != parent.depth+1 ||
+		child.parentFingerprint != parent.fingerprint() {
+		return nil, ErrNotChild
+	}
+
+	var data [PublicKeySize + 4]byte
+	copy(data[:PublicKeySize], parent.pub[:])
+	indexBE := ser32BE(child.childNumber)
+	copy(data[PublicKeySize:], indexBE[:])
+
+	i := hmacSHA512(parent.cc[:], data[:])
+	defer clear(i[:])
+	if subtle.ConstantTimeCompare(i[PrivateKeySize:], child.cc[:]) != 1 {
+		return nil, ErrNotChild
+	}
+	var tweak [PrivateKeySize]byte
+	copy(tweak[:], i[:PrivateKeySize])
+	defer clear(tweak[:])
+
+	key, ok := internalsecp.SubScalars(&child.key, &tweak)
+	if !ok {
+		return nil, ErrNotChild
+	}
+	recovered := &XPrv{
+		key:               key,
+		cc:                parent.cc,
+		network:           parent.network,
+		depth:             parent.depth,
+		parentFingerprint: parent.parentFingerprint,
+		childNumber:       parent.childNumber,
+		cache:             newKeyCache(),
+	}
+	clear(key[:])
+	// A matching chain code already makes a mismatch here a hash collision,
+	// but the check keeps a wrong key from ever being returned.
+	if pub, err := recovered.PublicKey(); err != nil || pub != parent.pub {
+		recovered.Wipe()
+		return nil, ErrNotChild
+	}
+	return recovered, nil
+}
+
+// ExposesParent reports whether child is a normal child of parent, in which
+// case holding both discloses the parent private key through RecoverParent.
+func ExposesParent(parent *XPub, child *XPrv) bool {
+	recovered, err := RecoverParent(parent, child)
+	if err != nil {
+		return false
+	}
+	recovered.Wipe()
+	return true
+}
+
+// ParentExposure identifies an extended public key and an extended private key
+// that together disclose the parent private key. The fields index the slices
+// passed to FindParentExposures.
+type ParentExposure struct {
+	Parent int
+	Child  int
+}
+
+// FindParentExposures scans a key store for pairs of a parent XPub and a
+// normal child XPrv from which RecoverParent would succeed. Nil entries are
+// skipped. Exposures are returned in child order.
+func FindParentExposures(xpubs []*XPub, xprvs []*XPrv) []ParentExposure {
+	byFingerprint := make(map[[FingerprintSize]byte][]int, len(xpubs))
+	for n, xpub := range xpubs {
+		if xpub == nil {
+			continue
+		}
+		fp := xpub.fingerprint()
+		byFingerprint[fp] = append(byFingerprint[fp], n)
+	}
+
+	var exposures []ParentExposure
+	for c, xprv := range xprvs {
+		if xprv == nil || IsHardened(xprv.childNumber) {
+			continue
+		}
+		for _, p := range byFingerprint[xprv.parentFingerprint] {
+			if ExposesParent(xpubs[p], xprv) {
+				exposures = append(exposures, ParentExposure{Parent: p, Child: c})
+			}
+		}
+	}
+	return exposures
+}
@@ -0,0 +1,88 @@
+package bip32secp256k1
+
+import (
+	"bytes"
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestRecoverParent(t *testing.T) {
+	root := mustMaster(t, Mainnet)
+	account, err := root.DerivePath("m/44'/0'/0'")
+	if err != nil {
+		t.Fatalf("DerivePath: %v", err)
+	}
+	accountPub, err := account.XPub()
+	if err != nil {
+		t.Fatalf("XPub: %v", err)
+	}
+	child, err := account.Derive(7)
+	if err != nil {
+		t.Fatalf("Derive: %v", err)
+	}
+
+	recovered, err := RecoverParent(accountPub, child)
+	if err != nil {
+		t.Fatalf("RecoverParent: %v", err)
+	}
+	if !bytes.Equal(recovered.Bytes(), account.Bytes()) {
+		t.Fatal("recovered parent differs from the account key")
+	}
+
+	hardened, err := account.Derive(HardenedOffset + 7)
+	if err != nil {
+		t.Fatalf("Derive hardened: %v", err)
+	}
+	if _, err := RecoverParent(accountPub, hardened); !errors.Is(err, ErrHardenedChild) {
+		t.Fatalf("hardened child error = %v", err)
+	}
+	grandchild, err := child.Derive(0)
+	if err != nil {
+		t.Fatalf("Derive grandchild: %v", err)
+	}
+	if _, err := RecoverParent(accountPub, grandchild); !errors.Is(err, ErrNotChild) {
+		t.Fatalf("grandchild error = %v", err)
+	}
+	forged := child.clone()
+	forged.cc[0] ^= 1
+	if _, err := RecoverParent(accountPub, forged); !errors.Is(err, ErrNotChild) {
+		t.Fatalf("wrong chain code error = %v", err)
+	}
+	if _, err := RecoverParent(nil, child); !errors.Is(err, ErrNilKey) {
+		t.Fatalf("nil parent error = %v", err)
+	}
+}
+
+func TestFindParentExposures(t *testing.T) {
+	root := mustMaster(t, Mainnet)
+	rootPub, err := root.XPub()
+	if err != nil {
+		t.Fatalf("XPub: %v", err)
+	}
+	account, err := root.DerivePath("m/0'")
+	if err != nil {
+		t.Fatalf("DerivePath: %v", err)
+	}
+	accountPub, err := account.XPub()
+	if err != nil {
+		t.Fatalf("XPub: %v", err)
+	}
+	leaked, err := account.DeriveRelativePath("0")
+	if err != nil {
+		t.Fatalf("DeriveRelativePath: %v", err)
+	}
+	safe, err := account.DeriveRelativePath("1'")
+	if err != nil {
+		t.Fatalf("DeriveRelativePath: %v", err)
+	}
+
+	got := FindParentExposures([]*XPub{rootPub, nil, accountPub}, []*XPrv{safe, account, nil, leaked})
+	want := []ParentExposure{{Parent: 2, Child: 3}}
+	if !slices.Equal(got, want) {
+		t.Fatalf("FindParentExposures = %v, want %v", got, want)
+	}
+	if !ExposesParent(accountPub, leaked) || ExposesParent(rootPub, leaked) {
+		t.Fatal("ExposesParent misreported the leaked pair")
+	}
+}
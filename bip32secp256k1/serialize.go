@@ -2,6 +2,7 @@ package bip32secp256k1
 
 import (
 	"encoding/binary"
+	"fmt"
 
 	internalsecp "github.com/islishude/bip32/v2/internal/secp256k1"
 )
@@ -87,7 +88,7 @@ func NewXPrvFromBytes(serialized []byte) (*XPrv, error) {
 	network, ok := networkFromPrivateVersion(version)
 	if !ok {
 		if _, public := networkFromPublicVersion(version); public {
-			return nil, ErrInvalidXPrv
+			return nil, fmt.Errorf("%w: payload holds an extended public key", ErrInvalidXPrv)
 		}
 		return nil, ErrInvalidNetwork
 	}
@@ -107,6 +108,7 @@ func NewXPrvFromBytes(serialized []byte) (*XPrv, error) {
 		network:     network,
 		depth:       serialized[depthOffset],
 		childNumber: binary.BigEndian.Uint32(serialized[childOffset:chainCodeOffset]),
+		cache:       newKeyCache(),
 	}
 	copy(keyOut.parentFingerprint[:], serialized[fingerprintOffset:childOffset])
 	copy(keyOut.cc[:], serialized[chainCodeOffset:keyDataOffset])
@@ -128,14 +130,15 @@ func NewXPubFromBytes(serialized []byte) (*XPub, error) {
 	network, ok := networkFromPublicVersion(version)
 	if !ok {
 		if _, private := networkFromPrivateVersion(version); private {
-			return nil, ErrInvalidXPub
+			return nil, fmt.Errorf("%w: payload holds an extended private key", ErrInvalidXPub)
 		}
 		return nil, ErrInvalidNetwork
 	}
 
 	var pub [PublicKeySize]byte
 	copy(pub[:], serialized[keyDataOffset:])
-	if !internalsecp.ValidPublicKey(&pub) {
+	point, ok := internalsecp.ParsePublicKey(&pub)
+	if !ok {
 		return nil, ErrInvalidXPub
 	}
 	keyOut := &XPub{
@@ -143,6 +146,7 @@ func NewXPubFromBytes(serialized []byte) (*XPub, error) {
 		network:     network,
 		depth:       serialized[depthOffset],
 		childNumber: binary.BigEndian.Uint32(serialized[childOffset:chainCodeOffset]),
+		cache:       newKeyCacheWithPoint(point),
 	}
 	copy(keyOut.parentFingerprint[:], serialized[fingerprintOffset:childOffset])
 	copy(keyOut.cc[:], serialized[chainCodeOffset:keyDataOffset])
@@ -0,0 +1,114 @@
+package bip32secp256k1
+
+import (
+	"crypto"
+	"io"
+
+	internalsecp "github.com/islishude/bip32/v2/internal/secp256k1"
+)
+
+// PublicKey is a compressed SEC 1 secp256k1 public key, as returned by
+// ECDSASigner.Public. The standard library's ecdsa.PublicKey cannot represent
+// secp256k1 keys.
+type PublicKey [PublicKeySize]byte
+
+// Equal reports whether x is the same public key.
+func (p PublicKey) Equal(x crypto.PublicKey) bool {
+	other, ok := x.(PublicKey)
+	return ok && other == p
+}
+
+// VerifyASN1 verifies a DER-encoded ECDSA signature over a 32-byte digest.
+// Like Bitcoin's consensus rules since BIP-66, it accepts only strict DER, and
+// like its standardness rules it rejects high-S signatures.
+func (p PublicKey) VerifyASN1(digest, sig []byte) bool {
+	if len(digest) != 32 {
+		return false
+	}
+	compact, ok := parseDER(sig)
+	if !ok {
+		return false
+	}
+	return internalsecp.VerifyECDSA((*[PublicKeySize]byte)(&p), (*[32]byte)(digest), &compact)
+}
+
+// ECDSASigner adapts an XPrv to crypto.Signer for ECDSA over secp256k1, so
+// derived keys can be used with JOSE ES256K implementations and other code
+// written against the standard interface.
+type ECDSASigner struct {
+	key [PrivateKeySize]byte
+	pub PublicKey
+}
+
+var _ crypto.Signer = (*ECDSASigner)(nil)
+
+// ECDSASigner returns a crypto.Signer holding a copy of k's private key. The
+// public key is computed once here. Wiping k does not affect the signer; call
+// ECDSASigner.Wipe when it is no longer needed.
+func (k *XPrv) ECDSASigner() (*ECDSASigner, error) {
+	if k == nil {
+		return nil, ErrNilKey
+	}
+	pub, err := k.PublicKey()
+	if err != nil {
+		return nil, err
+	}
+	return &ECDSASigner{key: k.key, pub: pub}, nil
+}
+
+// Public returns the signer's PublicKey.
+func (s *ECDSASigner) Public() crypto.PublicKey {
+	if s == nil {
+		return nil
+	}
+	return s.pub
+}
+
+// Sign signs a 32-byte digest and returns a DER-encoded low-S signature, as
+// ecdsa.PrivateKey.Sign does. The nonce is derived with RFC 6979, so rand is
+// ignored and signing the same digest twice gives the same signature. If
+// opts is non-nil and names a hash, its size must match the digest.
+func (s *ECDSASigner) Sign(_ io.Reader, digest []byte, opts crypto.SignerOpts) ([]byte, error) {
+	if s == nil {
+		return nil, ErrNilKey
+	}
+	if err := checkDigestOpts(digest, opts); err != nil {
+		return nil, err
+	}
+	return signDigest(&s.key, digest)
+}
+
+// checkDigestOpts checks that digest is 32 bytes long and, if opts names a
+// hash, that the hash has the same size.
+func checkDigestOpts(digest []byte, opts crypto.SignerOpts) error {
+	if len(digest) != 32 {
+		return ErrInvalidDigest
+	}
+	if opts != nil {
+		if hash := opts.HashFunc(); hash != 0 && (hash > crypto.BLAKE2b_512 || hash.Size() != len(digest)) {
+			return ErrInvalidDigest
+		}
+	}
+	return nil
+}
+
+// signDigest returns the DER-encoded low-S RFC 6979 signature of a 32-byte
+// digest.
+func signDigest(key *[PrivateKeySize]byte, digest []byte) ([]byte, error) {
+	if len(digest) != 32 {
+		return nil, ErrInvalidDigest
+	}
+	sig, _, ok := internalsecp.SignECDSA(key, (*[32]byte)(digest), nil)
+	if !ok {
+		return nil, ErrInvalidXPrv
+	}
+	return encodeDER(&sig), nil
+}
+
+// Wipe clears the signer's copy of the private key on a best-effort basis.
+func (s *ECDSASigner) Wipe() {
+	if s == nil {
+		return
+	}
+	clear(s.key[:])
+}
@@ -0,0 +1,67 @@
+package bip32secp256k1
+
+import (
+	"crypto"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"testing"
+)
+
+func TestECDSASigner(t *testing.T) {
+	// The widely used RFC 6979 secp256k1 vector for private key 1.
+	key := &XPrv{key: [PrivateKeySize]byte{31: 1}, network: Mainnet, cache: newKeyCache()}
+	signer, err := key.ECDSASigner()
+	if err != nil {
+		t.Fatalf("ECDSASigner: %v", err)
+	}
+	digest := sha256.Sum256([]byte("Satoshi Nakamoto"))
+	sig, err := signer.Sign(nil, digest[:], crypto.SHA256)
+	if err != nil {
+		t.Fatalf("Sign: %v", err)
+	}
+	const want = "3045022100934b1ea10a4b3c1757e2b0c017d0b6143ce3c9a7e6a4a49860d7a6ab210ee3d802202442ce9d2b916064108014783e923ec36b49743e2ffa1c4496f01a512aafd9e5"
+	if got := hex.EncodeToString(sig); got != want {
+		t.Fatalf("Sign = %s, want %s", got, want)
+	}
+
+	pub, ok := signer.Public().(PublicKey)
+	if !ok {
+		t.Fatalf("Public() = %T, want PublicKey", signer.Public())
+	}
+	wantPub, _ := key.PublicKey()
+	if !pub.Equal(PublicKey(wantPub)) || pub.Equal(wantPub) {
+		t.Fatal("PublicKey.Equal mismatch")
+	}
+	if !pub.VerifyASN1(digest[:], sig) {
+		t.Fatal("VerifyASN1 rejected a valid signature")
+	}
+	other := sha256.Sum256([]byte("other"))
+	if pub.VerifyASN1(other[:], sig) || pub.VerifyASN1(digest[:], sig[:len(sig)-1]) {
+		t.Fatal("VerifyASN1 accepted an invalid signature")
+	}
+
+	for _, tc := range []struct {
+		name   string
+		digest []byte
+		opts   crypto.SignerOpts
+	}{
+		{"short digest", digest[:20], nil},
+		{"hash size mismatch", digest[:], crypto.SHA512},
+		{"unknown hash", digest[:], crypto.Hash(200)},
+	} {
+		if _, err := signer.Sign(nil, tc.digest, tc.opts); !errors.Is(err, ErrInvalidDigest) {
+			t.Fatalf("%s: error = %v", tc.name, err)
+		}
+	}
+
+	// The signer keeps its own copy of the key.
+	key.Wipe()
+	if again, err := signer.Sign(nil, digest[:], nil); err != nil || hex.EncodeToString(again) != want {
+		t.Fatalf("Sign after XPrv.Wipe = %x, %v", again, err)
+	}
+	signer.Wipe()
+	if _, err := signer.Sign(nil, digest[:], nil); !errors.Is(err, ErrInvalidXPrv) {
+		t.Fatalf("Sign after Wipe error = %v", err)
+	}
+}
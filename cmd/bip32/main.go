@@ -0,0 +1,517 @@
+// Command bip32 creates, derives, and inspects BIP-32 secp256k1 extended keys.
+//
+// Usage:
+//
+//	bip32 <command> [flags]
+//
+// The commands are:
+//
+//	master     create a master xprv from a BIP-39 mnemonic or a hex seed
+//	derive     derive an absolute or relative path from an extended key
+//	neuter     print the extended public key of an extended private key
+//	inspect    print the version, network, depth, fingerprints, and child number
+//	convert    re-encode a key with another SLIP-132 version, such as zpub
+//	addresses  print a range of addresses below an account key
+//
+// Mnemonics, seeds, passphrases, and keys are read from standard input or from
+// the file named by -in (and -passphrase-file), never from the command line,
+// so they do not end up in shell history or process listings. Every command
+// accepts -format text or -format json.
+//
+// Keys may be written in any SLIP-132 version (xpub, ypub, zpub, Ypub, Zpub and
+// their private and testnet counterparts); derive and neuter keep the input's
+// version family. Errors from bip32secp256k1, such as ErrInvalidChild for the
+// rare index that has no valid child, are reported as they are rather than
+// skipped.
+package main
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	bip32 "github.com/islishude/bip32/v2"
+	"github.com/islishude/bip32/v2/bip32secp256k1"
+	"github.com/islishude/bip32/v2/internal/bip39"
+)
+
+// maxInput bounds what is read from standard input or a file.
+const maxInput = 64 << 10
+
+// maxAddresses bounds the -count flag of the addresses command.
+const maxAddresses = 10000
+
+var errUsage = errors.New("usage")
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+// command is one subcommand. Its run function defines its own flags on
+// env.fs and calls env.parse before doing any work.
+type command struct {
+	name    string
+	summary string
+	run     func(env *env, args []string) error
+}
+
+var commands = []command{
+	{"master", "create a master xprv from a BIP-39 mnemonic or a hex seed", runMaster},
+	{"derive", "derive an absolute or relative path from an extended key", runDerive},
+	{"neuter", "print the extended public key of an extended private key", runNeuter},
+	{"inspect", "print the version, network, depth, fingerprints, and child number", runInspect},
+	{"convert", "re-encode a key with another SLIP-132 version, such as zpub", runConvert},
+	{"addresses", "print a range of addresses below an account key", runAddresses},
+}
+
+// env holds the process streams and the flags every command shares.
+type env struct {
+	stdin  io.Reader
+	stdout io.Writer
+	stderr io.Writer
+
+	fs     *flag.FlagSet
+	in     string
+	format string
+}
+
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	if len(args) == 0 || args[0] == "-h" || args[0] == "-help" || args[0] == "help" {
+		usage(stderr)
+		return 2
+	}
+	for _, c := range commands {
+		if c.name != args[0] {
+			continue
+		}
+		e := &env{stdin: stdin, stdout: stdout, stderr: stderr}
+		e.fs = flag.NewFlagSet("bip32 "+c.name, flag.ContinueOnError)
+		e.fs.SetOutput(stderr)
+		e.fs.StringVar(&e.in, "in", "-", "read the secret or key from `file` instead of standard input")
+		e.fs.StringVar(&e.format, "format", "text", "output `format`: text or json")
+		err := c.run(e, args[1:])
+		switch {
+		case err == nil:
+			return 0
+		case errors.Is(err, flag.ErrHelp), errors.Is(err, errUsage):
+			return 2
+		default:
+			fmt.Fprintf(stderr, "bip32 %s: %v\n", c.name, err)
+			return 1
+		}
+	}
+	fmt.Fprintf(stderr, "bip32: unknown command %q\n", args[0])
+	usage(stderr)
+	return 2
+}
+
+func usage(w io.Writer) {
+	fmt.Fprintln(w, "usage: bip32 <command> [flags]")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "commands:")
+	for _, c := range commands {
+		fmt.Fprintf(w, "  %-10s %s\n", c.name, c.summary)
+	}
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Secrets are read from standard input or -in FILE, never from arguments.")
+}
+
+// parse parses the command's flags and rejects positional arguments, which
+// would invite passing secrets on the command line.
+func (e *env) parse(args []string) error {
+	if err := e.fs.Parse(args); err != nil {
+		return err
+	}
+	if e.fs.NArg() != 0 {
+		fmt.Fprintf(e.stderr, "bip32 %s: unexpected argument %q; secrets and keys are read from -in or standard input\n", e.fs.Name()[len("bip32 "):], e.fs.Arg(0))
+		e.fs.Usage()
+		return errUsage
+	}
+	if e.format != "text" && e.format != "json" {
+		fmt.Fprintf(e.stderr, "bip32 %s: -format must be text or json\n", e.fs.Name()[len("bip32 "):])
+		return errUsage
+	}
+	return nil
+}
+
+// readInput returns the contents of -in or standard input without surrounding
+// whitespace.
+func (e *env) readInput() ([]byte, error) {
+	data, err := readSecret(e.in, e.stdin)
+	if err != nil {
+		return nil, err
+	}
+	start, end := 0, len(data)
+	for start < end && isSpace(data[start]) {
+		start++
+	}
+	for end > start && isSpace(data[end-1]) {
+		end--
+	}
+	out := append([]byte(nil), data[start:end]...)
+	clear(data)
+	return out, nil
+}
+
+// readSecret reads the file name, or stdin if name is "-".
+func readSecret(name string, stdin io.Reader) ([]byte, error) {
+	r := stdin
+	if name != "-" {
+		f, err := os.Open(name)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		r = f
+	}
+	data, err := io.ReadAll(io.LimitReader(r, maxInput+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > maxInput {
+		clear(data)
+		return nil, errors.New("input is too large")
+	}
+	return data, nil
+}
+
+func isSpace(b byte) bool {
+	return b == ' ' || b == '\t' || b == '\n' || b == '\r'
+}
+
+// readKey reads and parses an extended key.
+func (e *env) readKey() (*key, error) {
+	text, err := e.readInput()
+	if err != nil {
+		return nil, err
+	}
+	defer clear(text)
+	return parseKey(string(text))
+}
+
+// print writes v as indented JSON or calls text to write it as text.
+func (e *env) print(v any, text func(w io.Writer)) error {
+	if e.format == "json" {
+		enc := json.NewEncoder(e.stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(v)
+	}
+	text(e.stdout)
+	return nil
+}
+
+// printKey writes an encoded extended key.
+func (e *env) printKey(encoded string) error {
+	return e.print(struct {
+		Key string `json:"key"`
+	}{encoded}, func(w io.Writer) {
+		fmt.Fprintln(w, encoded)
+	})
+}
+
+func runMaster(e *env, args []string) error {
+	from := e.fs.String("from", "mnemonic", "input `kind`: mnemonic (BIP-39 English) or hex (raw seed)")
+	passphraseFile := e.fs.String("passphrase-file", "", "read the BIP-39 passphrase from `file`")
+	networkName := e.fs.String("network", "mainnet", "`network`: mainnet or testnet")
+	if err := e.parse(args); err != nil {
+		return err
+	}
+	network, err := parseNetwork(*networkName)
+	if err != nil {
+		return err
+	}
+	if *passphraseFile == "-" && e.in == "-" {
+		return errors.New("the mnemonic and passphrase cannot both be read from standard input")
+	}
+	input, err := e.readInput()
+	if err != nil {
+		return err
+	}
+	defer clear(input)
+
+	var seed []byte
+	switch *from {
+	case "mnemonic":
+		var passphrase []byte
+		if *passphraseFile != "" {
+			if passphrase, err = readSecret(*passphraseFile, e.stdin); err != nil {
+				return err
+			}
+			defer clear(passphrase)
+			// Spaces are significant in a passphrase; drop only the line
+			// ending an editor or echo adds.
+			passphrase = bytes.TrimSuffix(bytes.TrimSuffix(passphrase, []byte("\n")), []byte("\r"))
+		}
+		seed, err = bip39.Seed(string(input), passphrase)
+	case "hex":
+		if *passphraseFile != "" {
+			return errors.New("-passphrase-file applies only to -from mnemonic")
+		}
+		seed, err = hex.DecodeString(string(input))
+	default:
+		return fmt.Errorf("unknown -from %q", *from)
+	}
+	if err != nil {
+		return err
+	}
+	defer clear(seed)
+
+	root, err := bip32secp256k1.NewMasterKey(seed, network)
+	if err != nil {
+		return err
+	}
+	defer root.Wipe()
+	encoded, err := root.Encode()
+	if err != nil {
+		return err
+	}
+	return e.printKey(encoded)
+}
+
+func runDerive(e *env, args []string) error {
+	path := e.fs.String("path", "", "`path` to derive: absolute (m/84'/0'/0') from a master xprv, or relative (0/1)")
+	if err := e.parse(args); err != nil {
+		return err
+	}
+	if *path == "" {
+		return errors.New("-path is required")
+	}
+	k, err := e.readKey()
+	if err != nil {
+		return err
+	}
+	defer k.wipe()
+
+	child := &key{version: k.version}
+	absolute := *path == "m" || strings.HasPrefix(*path, "m/")
+	switch {
+	case k.xprv != nil && absolute:
+		child.xprv, err = k.xprv.DerivePath(*path)
+	case k.xprv != nil:
+		child.xprv, err = k.xprv.DeriveRelativePath(*path)
+	case absolute:
+		return errors.New("an extended public key can derive only relative paths")
+	default:
+		child.xpub, err = k.xpub.DeriveRelativePath(*path)
+	}
+	if err != nil {
+		return err
+	}
+	defer child.wipe()
+	encoded, err := child.encode(child.version)
+	if err != nil {
+		return err
+	}
+	return e.printKey(encoded)
+}
+
+func runNeuter(e *env, args []string) error {
+	if err := e.parse(args); err != nil {
+		return err
+	}
+	k, err := e.readKey()
+	if err != nil {
+		return err
+	}
+	defer k.wipe()
+	xpub, err := k.public()
+	if err != nil {
+		return err
+	}
+	public := &key{version: k.version.Public(), xpub: xpub}
+	encoded, err := public.encode(public.version)
+	if err != nil {
+		return err
+	}
+	return e.printKey(encoded)
+}
+
+// inspection is the output of the inspect command. It never includes private
+// key material.
+type inspection struct {
+	Version           string `json:"version"`
+	Private           bool   `json:"private"`
+	Network           string `json:"network"`
+	Depth             uint8  `json:"depth"`
+	ParentFingerprint string `json:"parent_fingerprint"`
+	ChildNumber       uint32 `json:"child_number"`
+	ChildIndex        string `json:"child_index"`
+	Fingerprint       string `json:"fingerprint"`
+	Identifier        string `json:"identifier"`
+	PublicKey         string `json:"public_key"`
+}
+
+func runInspect(e *env, args []string) error {
+	if err := e.parse(args); err != nil {
+		return err
+	}
+	k, err := e.readKey()
+	if err != nil {
+		return err
+	}
+	defer k.wipe()
+	xpub, err := k.public()
+	if err != nil {
+		return err
+	}
+	parent := xpub.ParentFingerprint()
+	fingerprint := xpub.Fingerprint()
+	id := xpub.Identifier()
+	pub := xpub.PublicKey()
+	out := inspection{
+		Version:           k.version.Name,
+		Private:           k.version.Private,
+		Network:           networkName(xpub.Network()),
+		Depth:             xpub.Depth(),
+		ParentFingerprint: hex.EncodeToString(parent[:]),
+		ChildNumber:       xpub.ChildNumber(),
+		ChildIndex:        formatIndex(xpub.ChildNumber()),
+		Fingerprint:       hex.EncodeToString(fingerprint[:]),
+		Identifier:        hex.EncodeToString(id[:]),
+		PublicKey:         hex.EncodeToString(pub[:]),
+	}
+	return e.print(out, func(w io.Writer) {
+		fmt.Fprintf(w, "version:            %s\n", out.Version)
+		fmt.Fprintf(w, "private:            %t\n", out.Private)
+		fmt.Fprintf(w, "network:            %s\n", out.Network)
+		fmt.Fprintf(w, "depth:              %d\n", out.Depth)
+		fmt.Fprintf(w, "parent fingerprint: %s\n", out.ParentFingerprint)
+		fmt.Fprintf(w, "child number:       %d (%s)\n", out.ChildNumber, out.ChildIndex)
+		fmt.Fprintf(w, "fingerprint:        %s\n", out.Fingerprint)
+		fmt.Fprintf(w, "identifier:         %s\n", out.Identifier)
+		fmt.Fprintf(w, "public key:         %s\n", out.PublicKey)
+	})
+}
+
+func runConvert(e *env, args []string) error {
+	to := e.fs.String("to", "", "target `version`, such as xpub, ypub, zpub, Zpub, or tpub")
+	if err := e.parse(args); err != nil {
+		return err
+	}
+	target, err := versionByName(*to)
+	if err != nil {
+		return err
+	}
+	k, err := e.readKey()
+	if err != nil {
+		return err
+	}
+	defer k.wipe()
+	encoded, err := k.encode(target)
+	if err != nil {
+		return err
+	}
+	return e.printKey(encoded)
+}
+
+// address is one entry of the addresses command's output.
+type address struct {
+	Path    string `json:"path"`
+	Address string `json:"address"`
+}
+
+func runAddresses(e *env, args []string) error {
+	typeName := e.fs.String("type", "", "address `type`: p2pkh, p2sh-p2wpkh, p2wpkh, or p2tr (default from the key's version)")
+	change := e.fs.Uint("change", 0, "`chain` below the account key: 0 for receive, 1 for change")
+	start := e.fs.Uint("start", 0, "first address `index`")
+	count := e.fs.Uint("count", 20, "`number` of addresses")
+	if err := e.parse(args); err != nil {
+		return err
+	}
+	if *count > maxAddresses {
+		return fmt.Errorf("-count must be at most %d", maxAddresses)
+	}
+	if *change >= uint(bip32.HardenedOffset) || *start+*count > uint(bip32.HardenedOffset) {
+		return errors.New("-change and address indexes must be normal (non-hardened) indexes")
+	}
+	k, err := e.readKey()
+	if err != nil {
+		return err
+	}
+	defer k.wipe()
+
+	addressType := k.version.Address
+	if *typeName != "" {
+		if addressType, err = parseAddressType(*typeName); err != nil {
+			return err
+		}
+	}
+	if addressType == 0 {
+		return fmt.Errorf("%s keys belong to multisig wallets; pass -type for a single-key address", k.version.Name)
+	}
+
+	account, err := k.public()
+	if err != nil {
+		return err
+	}
+	chain, err := account.Derive(uint32(*change))
+	if err != nil {
+		return err
+	}
+	out := make([]address, 0, *count)
+	for i := range uint32(*count) {
+		index := uint32(*start) + i
+		child, err := chain.Derive(index)
+		if err != nil {
+			return fmt.Errorf("index %d/%d: %w", *change, index, err)
+		}
+		addr, err := child.Address(addressType)
+		if err != nil {
+			return err
+		}
+		out = append(out, address{Path: fmt.Sprintf("%d/%d", *change, index), Address: addr})
+	}
+	return e.print(out, func(w io.Writer) {
+		for _, a := range out {
+			fmt.Fprintf(w, "%s %s\n", a.Path, a.Address)
+		}
+	})
+}
+
+func parseNetwork(name string) (bip32secp256k1.Network, error) {
+	switch name {
+	case "mainnet":
+		return bip32secp256k1.Mainnet, nil
+	case "testnet":
+		return bip32secp256k1.Testnet, nil
+	default:
+		return 0, fmt.Errorf("unknown network %q", name)
+	}
+}
+
+func networkName(network bip32secp256k1.Network) string {
+	if network == bip32secp256k1.Testnet {
+		return "testnet"
+	}
+	return "mainnet"
+}
+
+func parseAddressType(name string) (bip32secp256k1.AddressType, error) {
+	switch name {
+	case "p2pkh":
+		return bip32secp256k1.P2PKH, nil
+	case "p2sh-p2wpkh":
+		return bip32secp256k1.P2SHP2WPKH, nil
+	case "p2wpkh":
+		return bip32secp256k1.P2WPKH, nil
+	case "p2tr":
+		return bip32secp256k1.P2TR, nil
+	default:
+		return 0, fmt.Errorf("unknown address type %q", name)
+	}
+}
+
+// formatIndex writes a child number in path notation, such as 44'.
+func formatIndex(i uint32) string {
+	if bip32.IsHardened(i) {
+		return strconv.FormatUint(uint64(i-bip32.HardenedOffset), 10) + "'"
+	}
+	return strconv.FormatUint(uint64(i), 10)
+}
@@ -0,0 +1,148 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const testMnemonic = "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+
+// runOK runs the command and returns its standard output.
+func runOK(t *testing.T, stdin string, args ...string) string {
+	t.Helper()
+	var stdout, stderr bytes.Buffer
+	if code := run(args, strings.NewReader(stdin), &stdout, &stderr); code != 0 {
+		t.Fatalf("bip32 %s: exit %d: %s", strings.Join(args, " "), code, stderr.String())
+	}
+	return stdout.String()
+}
+
+// runFail runs the command, expects it to fail, and returns standard error.
+func runFail(t *testing.T, stdin string, args ...string) string {
+	t.Helper()
+	var stdout, stderr bytes.Buffer
+	if code := run(args, strings.NewReader(stdin), &stdout, &stderr); code == 0 {
+		t.Fatalf("bip32 %s succeeded with %q", strings.Join(args, " "), stdout.String())
+	}
+	return stderr.String()
+}
+
+func TestMasterFromMnemonic(t *testing.T) {
+	// The first BIP-39 reference vector, with passphrase "TREZOR".
+	passphrase := filepath.Join(t.TempDir(), "passphrase")
+	if err := os.WriteFile(passphrase, []byte("TREZOR\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	got := runOK(t, testMnemonic+"\n", "master", "-passphrase-file", passphrase)
+	if want := "xprv9s21ZrQH143K3h3fDYiay8mocZ3afhfULfb5GX8kCBdno77K4HiA15Tg23wpbeF1pLfs1c5SPmYHrEpTuuRhxMwvKDwqdKiGJS9XFKzUsAF\n"; got != want {
+		t.Fatalf("master = %q", got)
+	}
+
+	// BIP-32 test vector 1 from a hex seed.
+	got = runOK(t, "000102030405060708090a0b0c0d0e0f", "master", "-from", "hex", "-format", "json")
+	var out struct{ Key string }
+	if err := json.Unmarshal([]byte(got), &out); err != nil || out.Key != "xprv9s21ZrQH143K3QTDL4LXw2F7HEK3wJUD2nW2nRk4stbPy6cq3jPPqjiChkVvvNKmPGJxWUtg6LnF5kejMRNNU3TGtRBeJgk33yuGBxrMPHi" {
+		t.Fatalf("master -from hex = %q", out.Key)
+	}
+
+	for _, tc := range []struct {
+		stdin string
+		want  string
+	}{
+		{"abandon " + testMnemonic, "12, 15, 18, 21, or 24 words"},
+		{strings.Replace(testMnemonic, "about", "abandon", 1), "checksum mismatch"},
+		{strings.Replace(testMnemonic, "about", "abuot", 1), "outside the English wordlist"},
+	} {
+		if stderr := runFail(t, tc.stdin, "master"); !strings.Contains(stderr, tc.want) {
+			t.Fatalf("master(%q) error = %q, want %q", tc.stdin, stderr, tc.want)
+		}
+	}
+	if stderr := runFail(t, testMnemonic, "master", "-passphrase-file", "-"); !strings.Contains(stderr, "both be read from standard input") {
+		t.Fatalf("stdin passphrase error = %q", stderr)
+	}
+}
+
+func TestDeriveConvertAndAddresses(t *testing.T) {
+	// BIP-84, BIP-49, and BIP-86 reference vectors for the same mnemonic.
+	root := runOK(t, testMnemonic, "master")
+	account := runOK(t, root, "derive", "-path", "m/84'/0'/0'")
+	zprv := runOK(t, account, "convert", "-to", "zprv")
+	if want := "zprvAdG4iTXWBoARxkkzNpNh8r6Qag3irQB8PzEMkAFeTRXxHpbF9z4QgEvBRmfvqWvGp42t42nvgGpNgYSJA9iefm1yYNZKEm7z6qUWCroSQnE\n"; zprv != want {
+		t.Fatalf("zprv = %q", zprv)
+	}
+	zpub := runOK(t, zprv, "neuter")
+	if want := "zpub6rFR7y4Q2AijBEqTUquhVz398htDFrtymD9xYYfG1m4wAcvPhXNfE3EfH1r1ADqtfSdVCToUG868RvUUkgDKf31mGDtKsAYz2oz2AGutZYs\n"; zpub != want {
+		t.Fatalf("zpub = %q", zpub)
+	}
+	if xpub := runOK(t, zpub, "convert", "-to", "xpub"); runOK(t, xpub, "convert", "-to", "zpub") != zpub {
+		t.Fatal("xpub/zpub conversion did not round-trip")
+	}
+	if got := runOK(t, zpub, "addresses", "-count", "2"); got != "0/0 bc1qcr8te4kr609gcawutmrza0j4xv80jy8z306fyu\n0/1 bc1qnjg0jd8228aq7egyzacy8cys3knf9xvrerkf9g\n" {
+		t.Fatalf("zpub addresses = %q", got)
+	}
+	// derive keeps the version family of its input.
+	if got := runOK(t, zpub, "derive", "-path", "0/0"); !strings.HasPrefix(got, "zpub") {
+		t.Fatalf("derive from zpub = %q", got)
+	}
+
+	ypub := runOK(t, runOK(t, root, "derive", "-path", "m/49'/0'/0'"), "neuter")
+	ypub = runOK(t, ypub, "convert", "-to", "ypub")
+	if got := runOK(t, ypub, "addresses", "-count", "1"); got != "0/0 37VucYSaXLCAsxYyAPfbSi9eh4iEcbShgf\n" {
+		t.Fatalf("ypub addresses = %q", got)
+	}
+	taproot := runOK(t, root, "derive", "-path", "m/86'/0'/0'")
+	if got := runOK(t, taproot, "addresses", "-type", "p2tr", "-count", "1"); got != "0/0 bc1p5cyxnuxmeuwuvkwfem96lqzszd02n6xdcjrs20cac6yqjjwudpxqkedrcr\n" {
+		t.Fatalf("p2tr addresses = %q", got)
+	}
+
+	for _, tc := range []struct {
+		stdin string
+		args  []string
+		want  string
+	}{
+		{zpub, []string{"derive", "-path", "m/0"}, "only relative paths"},
+		{zpub, []string{"derive", "-path", "0'"}, "cannot derive hardened"},
+		{account, []string{"derive", "-path", "m/0"}, "absolute derivation requires a root key"},
+		{zpub, []string{"convert", "-to", "zprv"}, "does not match"},
+		{zpub, []string{"convert", "-to", "vpub"}, "does not match"},
+		{zpub, []string{"convert", "-to", "wpub"}, "unknown extended key version"},
+		{zpub[:len(zpub)-2] + "x", []string{"inspect"}, "checksum"},
+		{runOK(t, zpub, "convert", "-to", "Zpub"), []string{"addresses"}, "multisig"},
+		{zpub, []string{"inspect", "extra"}, "unexpected argument"},
+	} {
+		if stderr := runFail(t, tc.stdin, tc.args...); !strings.Contains(stderr, tc.want) {
+			t.Fatalf("%v error = %q, want %q", tc.args, stderr, tc.want)
+		}
+	}
+}
+
+func TestInspect(t *testing.T) {
+	root := runOK(t, testMnemonic, "master")
+	account := runOK(t, root, "derive", "-path", "m/84'/0'/0'")
+	var got inspection
+	if err := json.Unmarshal([]byte(runOK(t, account, "inspect", "-format", "json")), &got); err != nil {
+		t.Fatalf("inspect JSON: %v", err)
+	}
+	want := inspection{
+		Version:           "xprv",
+		Private:           true,
+		Network:           "mainnet",
+		Depth:             3,
+		ParentFingerprint: "7ef32bdb",
+		ChildNumber:       0x80000000,
+		ChildIndex:        "0'",
+		Fingerprint:       "fd13aac9",
+		Identifier:        "fd13aac9a294188cdfe1331a8d94880bccbef8c1",
+		PublicKey:         "02707a62fdacc26ea9b63b1c197906f56ee0180d0bcf1966e1a2da34f5f3a09a9b",
+	}
+	if got != want {
+		t.Fatalf("inspect = %+v, want %+v", got, want)
+	}
+	if text := runOK(t, account, "inspect"); strings.Contains(text, strings.TrimSpace(account)) {
+		t.Fatal("inspect printed the private key")
+	}
+}
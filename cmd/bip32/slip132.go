@@ -0,0 +1,93 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/islishude/bip32/v2/bip32secp256k1"
+	"github.com/islishude/bip32/v2/internal/base58"
+	"github.com/islishude/bip32/v2/internal/slip132"
+)
+
+var (
+	errUnknownVersion = errors.New("unknown extended key version")
+	errVersionKind    = errors.New("version does not match the key's network and kind")
+)
+
+func versionByName(name string) (slip132.Version, error) {
+	v, ok := slip132.ByName(name)
+	if !ok {
+		return slip132.Version{}, fmt.Errorf("%w: %q", errUnknownVersion, name)
+	}
+	return v, nil
+}
+
+// key is a parsed extended key together with the version it was written in.
+type key struct {
+	version slip132.Version
+	xprv    *bip32secp256k1.XPrv
+	xpub    *bip32secp256k1.XPub
+}
+
+// parseKey parses a Base58Check extended key in any SLIP-132 version.
+func parseKey(text string) (*key, error) {
+	payload, err := base58.CheckDecode(text, bip32secp256k1.SerializedKeySize, bip32secp256k1.ErrInvalidEncoding, bip32secp256k1.ErrInvalidChecksum)
+	if err != nil {
+		return nil, err
+	}
+	defer clear(payload)
+	v, ok := slip132.ByBytes([4]byte(payload[:4]))
+	if !ok {
+		return nil, fmt.Errorf("%w: %x", errUnknownVersion, payload[:4])
+	}
+	std := slip132.Standard(v.Network, v.Private)
+	copy(payload[:4], std.Bytes[:])
+	k := &key{version: v}
+	if v.Private {
+		k.xprv, err = bip32secp256k1.NewXPrvFromBytes(payload)
+	} else {
+		k.xpub, err = bip32secp256k1.NewXPubFromBytes(payload)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return k, nil
+}
+
+// encode serializes k in version v, which must have the same network and kind.
+func (k *key) encode(v slip132.Version) (string, error) {
+	var payload []byte
+	if k.xprv != nil {
+		payload = k.xprv.Bytes()
+	} else {
+		payload = k.xpub.Bytes()
+	}
+	defer clear(payload)
+	if v.Private != (k.xprv != nil) || v.Network != k.network() {
+		return "", fmt.Errorf("%w: %s", errVersionKind, v.Name)
+	}
+	copy(payload[:4], v.Bytes[:])
+	return base58.CheckEncode(payload), nil
+}
+
+func (k *key) network() bip32secp256k1.Network {
+	if k.xprv != nil {
+		return k.xprv.Network()
+	}
+	return k.xpub.Network()
+}
+
+// public returns the extended public key, neutering a private key.
+func (k *key) public() (*bip32secp256k1.XPub, error) {
+	if k.xpub != nil {
+		return k.xpub, nil
+	}
+	return k.xprv.XPub()
+}
+
+// wipe clears any private key held by k.
+func (k *key) wipe() {
+	if k.xprv != nil {
+		k.xprv.Wipe()
+	}
+}
@@ -0,0 +1,145 @@
+package main
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/islishude/bip32/v2/bip32ed25519"
+	"github.com/islishude/bip32/v2/internal/bech32"
+	"golang.org/x/crypto/blake2b"
+)
+
+// keyHashSize is the width of a BLAKE2b-224 key hash.
+const keyHashSize = 28
+
+// roles maps the CIP-1852 role index of a path's fourth level to the prefix of
+// keys derived there. 0 and 1 are the external and internal payment chains.
+var roles = map[uint32]bip32ed25519.Role{
+	0: bip32ed25519.RoleAddress,
+	1: bip32ed25519.RoleAddress,
+	2: bip32ed25519.RoleStake,
+	3: bip32ed25519.RoleDRep,
+	4: bip32ed25519.RoleCommitteeCold,
+	5: bip32ed25519.RoleCommitteeHot,
+}
+
+// kind is how a key is serialized.
+type kind string
+
+const (
+	kindXSK kind = "xsk" // kL || kR || chain code, 96 bytes
+	kindXVK kind = "xvk" // public key || chain code, 64 bytes
+	kindVK  kind = "vk"  // public key, 32 bytes
+	kindVKH kind = "vkh" // BLAKE2b-224 of the public key, 28 bytes
+)
+
+var kindSizes = map[kind]int{
+	kindXSK: bip32ed25519.XPrvSize,
+	kindXVK: bip32ed25519.XPubSize,
+	kindVK:  32,
+	kindVKH: keyHashSize,
+}
+
+var (
+	errUnknownPrefix = errors.New("unknown or unsupported bech32 prefix")
+	errKeyLength     = errors.New("key has the wrong length for its prefix")
+)
+
+// key is a decoded key. role is empty for hex input, which carries none.
+type key struct {
+	role  bip32ed25519.Role
+	kind  kind
+	bytes []byte
+}
+
+// hrp returns the CIP-5 prefix of k.
+func (k *key) hrp() string {
+	return string(k.role) + "_" + string(k.kind)
+}
+
+// encode writes k as bech32 or, for hex output or keys read from hex, as
+// lowercase hex.
+func (k *key) encode(asHex bool) (string, error) {
+	if asHex || k.role == "" {
+		return hex.EncodeToString(k.bytes), nil
+	}
+	data, ok := bech32.ConvertBits(k.bytes, 8, 5, true)
+	if !ok {
+		return "", errKeyLength
+	}
+	encoded, ok := bech32.Encode(k.hrp(), data, bech32.Bech32)
+	if !ok {
+		return "", errUnknownPrefix
+	}
+	return encoded, nil
+}
+
+// decodeKey parses a bech32 key with a known CIP-5 prefix, or a hex key whose
+// kind is inferred from its length. Hex input of 32 bytes is taken as a vk.
+func decodeKey(text string) (*key, error) {
+	if raw, err := hex.DecodeString(text); err == nil {
+		for _, kd := range []kind{kindXSK, kindXVK, kindVK} {
+			if len(raw) == kindSizes[kd] {
+				return &key{kind: kd, bytes: raw}, nil
+			}
+		}
+		return nil, errKeyLength
+	}
+
+	hrp, data, encoding, ok := bech32.Decode(text, bech32.MaxLengthCIP5)
+	if !ok || encoding != bech32.Bech32 {
+		return nil, errors.New("invalid bech32 string")
+	}
+	raw, ok := bech32.ConvertBits(data, 5, 8, false)
+	if !ok {
+		return nil, errors.New("invalid bech32 data")
+	}
+	sep := strings.LastIndexByte(hrp, '_')
+	if sep < 0 {
+		return nil, fmt.Errorf("%w: %q", errUnknownPrefix, hrp)
+	}
+	k := &key{role: bip32ed25519.Role(hrp[:sep]), kind: kind(hrp[sep+1:]), bytes: raw}
+	size, ok := kindSizes[k.kind]
+	if !ok || !k.role.Valid() {
+		return nil, fmt.Errorf("%w: %q", errUnknownPrefix, hrp)
+	}
+	if len(raw) != size {
+		return nil, fmt.Errorf("%w: %s has %d bytes", errKeyLength, hrp, len(raw))
+	}
+	return k, nil
+}
+
+// childRole returns the role of a key derived from a parent role along a path,
+// following cardano-address: from a root, three levels reach an account, five
+// reach the role named by the fourth level, and two reach a Byron address key;
+// from an account, two levels reach the role named by the first.
+func childRole(parent bip32ed25519.Role, indexes []uint32) (bip32ed25519.Role, error) {
+	switch {
+	case parent == bip32ed25519.RoleRoot && len(indexes) == 3:
+		return bip32ed25519.RoleAccount, nil
+	case parent == bip32ed25519.RoleRoot && len(indexes) == 5:
+		if r, ok := roles[indexes[3]]; ok {
+			return r, nil
+		}
+	case parent == bip32ed25519.RoleRoot && len(indexes) == 2:
+		return bip32ed25519.RoleAddress, nil
+	case parent == bip32ed25519.RoleAccount && len(indexes) == 2:
+		if r, ok := roles[indexes[0]]; ok {
+			return r, nil
+		}
+	}
+	return "", fmt.Errorf("cannot derive %d levels below %s keys; use root with 2, 3, or 5 levels or acct with 2", len(indexes), parent)
+}
+
+// keyHash returns the BLAKE2b-224 hash of a public key, the credential used in
+// Shelley addresses.
+func keyHash(pub []byte) []byte {
+	h, err := blake2b.New(keyHashSize, nil)
+	if err != nil {
+		panic(err)
+	}
+	_, _ = h.Write(pub)
+	return h.Sum(nil)
+}
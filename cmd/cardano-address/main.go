@@ -0,0 +1,421 @@
+// Command cardano-address derives Cardano keys with bip32ed25519, following
+// the key commands of the Haskell cardano-address tool.
+//
+// Usage:
+//
+//	cardano-address key from-recovery-phrase STYLE [-passphrase-file FILE] [-hex] < phrase
+//	cardano-address key child PATH [-scheme V1|V2] [-hex] < key
+//	cardano-address key public (--with-chain-code | --without-chain-code) [-scheme V1|V2] [-hex] < key
+//	cardano-address key hash [-hex] < key
+//
+// STYLE is Icarus, Shelley, Ledger, Trezor, or Byron. Icarus and Shelley
+// share the CIP-3 Icarus master key and differ only in the paths used below
+// it; Ledger and Trezor use the CIP-3 master keys of Cardano apps on those
+// hardware wallets, with the Shelley paths. Byron is the root of a legacy
+// Daedalus wallet, whose keys derive with the older V1 scheme. Nothing in a
+// key records its scheme, so child and public below a Byron root need
+// -scheme V1, as in
+//
+//	cardano-address key child -scheme V1 0H/1H < byron.xsk
+//
+// Keys are read from standard input and written to standard output as CIP-5
+// bech32 strings (root_xsk, acct_xsk, acct_xvk, addr_xvk, stake_vk, addr_vkh,
+// and so on) without a trailing newline, as cardano-address writes them, or as
+// hex with -hex. Paths use the cardano-address syntax, such as
+// 1852H/1815H/0H/0/0, and the prefix of a derived key follows from the
+// parent's prefix and the path: three levels below root_xsk give acct_xsk, and
+// the role at the fourth level (0 and 1 for addr, 2 for stake) names the key
+// below it. Hex input carries no prefix, so the output of a command given hex
+// is always hex.
+//
+// Recovery phrases and passphrases are read from standard input and files,
+// never from the command line.
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/islishude/bip32/v2/bip32ed25519"
+	"github.com/islishude/bip32/v2/internal/bip39"
+)
+
+// maxInput bounds what is read from standard input or a file.
+const maxInput = 64 << 10
+
+var errUsage = errors.New("usage")
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	if len(args) < 2 || args[0] != "key" {
+		usage(stderr)
+		return 2
+	}
+	var cmd func(args []string, stdin io.Reader, stdout, stderr io.Writer) error
+	switch args[1] {
+	case "from-recovery-phrase":
+		cmd = runFromRecoveryPhrase
+	case "child":
+		cmd = runChild
+	case "public":
+		cmd = runPublic
+	case "hash":
+		cmd = runHash
+	default:
+		fmt.Fprintf(stderr, "cardano-address: unknown command %q\n", "key "+args[1])
+		usage(stderr)
+		return 2
+	}
+	err := cmd(args[2:], stdin, stdout, stderr)
+	switch {
+	case err == nil:
+		return 0
+	case errors.Is(err, flag.ErrHelp), errors.Is(err, errUsage):
+		return 2
+	default:
+		fmt.Fprintf(stderr, "cardano-address key %s: %v\n", args[1], err)
+		return 1
+	}
+}
+
+func usage(w io.Writer) {
+	fmt.Fprintln(w, "usage:")
+	fmt.Fprintln(w, "  cardano-address key from-recovery-phrase (Icarus|Shelley|Ledger|Trezor|Byron) [-passphrase-file FILE] [-hex] < phrase")
+	fmt.Fprintln(w, "  cardano-address key child PATH [-scheme V1|V2] [-hex] < key")
+	fmt.Fprintln(w, "  cardano-address key public (--with-chain-code | --without-chain-code) [-scheme V1|V2] [-hex] < key")
+	fmt.Fprintln(w, "  cardano-address key hash [-hex] < key")
+}
+
+// parseFlags parses a command's flags, which may appear before or after its
+// single positional argument as they may for cardano-address, and returns the
+// positional arguments.
+func parseFlags(fs *flag.FlagSet, args []string, positional int) ([]string, error) {
+	var rest []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, err
+		}
+		if fs.NArg() == 0 {
+			break
+		}
+		rest = append(rest, fs.Arg(0))
+		args = fs.Args()[1:]
+	}
+	if len(rest) != positional {
+		fs.Usage()
+		return nil, errUsage
+	}
+	return rest, nil
+}
+
+func newFlagSet(name string, stderr io.Writer) (*flag.FlagSet, *bool) {
+	fs := flag.NewFlagSet("cardano-address key "+name, flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	asHex := fs.Bool("hex", false, "write hex instead of bech32")
+	return fs, asHex
+}
+
+// schemeFlag registers -scheme. Keys do not record their derivation scheme, so
+// commands reading keys below a Byron root must be told.
+func schemeFlag(fs *flag.FlagSet) *string {
+	return fs.String("scheme", "V2", "derivation `scheme` of the key: V2, or V1 for Byron keys")
+}
+
+// parseScheme reports whether name selects V1, whose keys are
+// bip32ed25519.XPrvV1 and XPubV1 values.
+func parseScheme(name string) (v1 bool, err error) {
+	switch name {
+	case "V2":
+		return false, nil
+	case "V1":
+		return true, nil
+	default:
+		return false, fmt.Errorf("unknown scheme %q; want V1 or V2", name)
+	}
+}
+
+// readAll reads stdin or the named file, up to maxInput bytes.
+func readAll(r io.Reader) ([]byte, error) {
+	data, err := io.ReadAll(io.LimitReader(r, maxInput+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > maxInput {
+		clear(data)
+		return nil, errors.New("input is too large")
+	}
+	return data, nil
+}
+
+// readKey reads and decodes one key from stdin.
+func readKey(stdin io.Reader) (*key, error) {
+	data, err := readAll(stdin)
+	if err != nil {
+		return nil, err
+	}
+	defer clear(data)
+	return decodeKey(string(bytes.TrimSpace(data)))
+}
+
+func writeKey(stdout io.Writer, k *key, asHex bool) error {
+	encoded, err := k.encode(asHex)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(stdout, encoded)
+	return err
+}
+
+func runFromRecoveryPhrase(args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	fs, asHex := newFlagSet("from-recovery-phrase", stderr)
+	passphraseFile := fs.String("passphrase-file", "", "read the second-factor passphrase from `file`")
+	rest, err := parseFlags(fs, args, 1)
+	if err != nil {
+		return err
+	}
+
+	var passphrase []byte
+	if *passphraseFile != "" {
+		f, err := os.Open(*passphraseFile)
+		if err != nil {
+			return err
+		}
+		passphrase, err = readAll(f)
+		f.Close()
+		if err != nil {
+			return err
+		}
+		defer clear(passphrase)
+		passphrase = bytes.TrimSuffix(bytes.TrimSuffix(passphrase, []byte("\n")), []byte("\r"))
+	}
+	phrase, err := readAll(stdin)
+	if err != nil {
+		return err
+	}
+	defer clear(phrase)
+
+	// Byron roots are XPrvV1 values, which serialize as XPrv values do.
+	var root interface {
+		Bytes() []byte
+		Wipe()
+	}
+	switch rest[0] {
+	case "Icarus", "Shelley":
+		entropy, err := bip39.Entropy(string(phrase))
+		if err != nil {
+			return err
+		}
+		defer clear(entropy)
+		root, err = bip32ed25519.NewMasterKeyIcarus(entropy, passphrase)
+		if err != nil {
+			return err
+		}
+	case "Ledger":
+		if root, err = bip32ed25519.NewMasterKeyLedger(string(phrase), passphrase); err != nil {
+			return err
+		}
+	case "Trezor":
+		if root, err = bip32ed25519.NewMasterKeyTrezor(string(phrase), passphrase); err != nil {
+			return err
+		}
+	case "Byron":
+		// Daedalus spending passwords encrypted the stored key; they are not
+		// an input to the root.
+		if passphrase != nil {
+			return errors.New("style Byron takes no passphrase")
+		}
+		if root, err = bip32ed25519.NewMasterKeyByron(string(phrase)); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("unknown style %q; want Icarus, Shelley, Ledger, Trezor, or Byron", rest[0])
+	}
+	defer root.Wipe()
+	raw := root.Bytes()
+	defer clear(raw)
+	return writeKey(stdout, &key{role: bip32ed25519.RoleRoot, kind: kindXSK, bytes: raw}, *asHex)
+}
+
+func runChild(args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	fs, asHex := newFlagSet("child", stderr)
+	schemeName := schemeFlag(fs)
+	rest, err := parseFlags(fs, args, 1)
+	if err != nil {
+		return err
+	}
+	v1, err := parseScheme(*schemeName)
+	if err != nil {
+		return err
+	}
+	indexes, err := bip32ed25519.ParseRelativePath(rest[0])
+	if err != nil {
+		return err
+	}
+	parent, err := readKey(stdin)
+	if err != nil {
+		return err
+	}
+	defer clear(parent.bytes)
+
+	child := &key{kind: parent.kind}
+	if parent.role != "" {
+		if child.role, err = childRole(parent.role, indexes); err != nil {
+			return err
+		}
+	}
+	switch parent.kind {
+	case kindXSK:
+		if child.bytes, err = deriveXSK(parent.bytes, rest[0], v1); err != nil {
+			return err
+		}
+		defer clear(child.bytes)
+	case kindXVK:
+		if child.bytes, err = deriveXVK(parent.bytes, rest[0], v1); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("cannot derive children from a %s key", parent.kind)
+	}
+	return writeKey(stdout, child, *asHex)
+}
+
+func runPublic(args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	fs, asHex := newFlagSet("public", stderr)
+	withChainCode := fs.Bool("with-chain-code", false, "write the extended public key (xvk)")
+	withoutChainCode := fs.Bool("without-chain-code", false, "write the public key alone (vk)")
+	schemeName := schemeFlag(fs)
+	if _, err := parseFlags(fs, args, 0); err != nil {
+		return err
+	}
+	v1, err := parseScheme(*schemeName)
+	if err != nil {
+		return err
+	}
+	if *withChainCode == *withoutChainCode {
+		return errors.New("pass exactly one of --with-chain-code and --without-chain-code")
+	}
+	private, err := readKey(stdin)
+	if err != nil {
+		return err
+	}
+	defer clear(private.bytes)
+	if private.kind != kindXSK {
+		return fmt.Errorf("want an extended private key, got %s", private.kind)
+	}
+	raw, err := publicXVK(private.bytes, v1)
+	if err != nil {
+		return err
+	}
+	public := &key{role: private.role, kind: kindXVK, bytes: raw}
+	if *withoutChainCode {
+		public.kind = kindVK
+		public.bytes = public.bytes[:kindSizes[kindVK]]
+	}
+	return writeKey(stdout, public, *asHex)
+}
+
+// deriveXSK derives the relative path below the extended private key raw and
+// returns the child's bytes.
+func deriveXSK(raw []byte, path string, v1 bool) ([]byte, error) {
+	if v1 {
+		xprv, err := bip32ed25519.NewXPrvV1FromBytes(raw)
+		if err != nil {
+			return nil, err
+		}
+		defer xprv.Wipe()
+		derived, err := xprv.DeriveRelativePath(path)
+		if err != nil {
+			return nil, err
+		}
+		defer derived.Wipe()
+		return derived.Bytes(), nil
+	}
+	xprv, err := bip32ed25519.NewXPrvFromBytes(raw)
+	if err != nil {
+		return nil, err
+	}
+	defer xprv.Wipe()
+	derived, err := xprv.DeriveRelativePath(path)
+	if err != nil {
+		return nil, err
+	}
+	defer derived.Wipe()
+	return derived.Bytes(), nil
+}
+
+// deriveXVK derives the soft relative path below the extended public key raw
+// and returns the child's bytes.
+func deriveXVK(raw []byte, path string, v1 bool) ([]byte, error) {
+	if v1 {
+		xpub, err := bip32ed25519.NewXPubV1FromBytes(raw)
+		if err != nil {
+			return nil, err
+		}
+		derived, err := xpub.DeriveRelativePath(path)
+		if err != nil {
+			return nil, err
+		}
+		return derived.Bytes(), nil
+	}
+	xpub, err := bip32ed25519.NewXPubFromBytes(raw)
+	if err != nil {
+		return nil, err
+	}
+	derived, err := xpub.DeriveRelativePath(path)
+	if err != nil {
+		return nil, err
+	}
+	return derived.Bytes(), nil
+}
+
+// publicXVK returns the extended public key of the extended private key raw.
+func publicXVK(raw []byte, v1 bool) ([]byte, error) {
+	if v1 {
+		xprv, err := bip32ed25519.NewXPrvV1FromBytes(raw)
+		if err != nil {
+			return nil, err
+		}
+		defer xprv.Wipe()
+		xpub, err := xprv.XPub()
+		if err != nil {
+			return nil, err
+		}
+		return xpub.Bytes(), nil
+	}
+	xprv, err := bip32ed25519.NewXPrvFromBytes(raw)
+	if err != nil {
+		return nil, err
+	}
+	defer xprv.Wipe()
+	xpub, err := xprv.XPub()
+	if err != nil {
+		return nil, err
+	}
+	return xpub.Bytes(), nil
+}
+
+func runHash(args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	fs, asHex := newFlagSet("hash", stderr)
+	if _, err := parseFlags(fs, args, 0); err != nil {
+		return err
+	}
+	public, err := readKey(stdin)
+	if err != nil {
+		return err
+	}
+	if public.kind != kindXVK && public.kind != kindVK {
+		return fmt.Errorf("want a public key, got %s", public.kind)
+	}
+	if public.role == bip32ed25519.RoleRoot || public.role == bip32ed25519.RoleAccount {
+		return fmt.Errorf("%s keys are not used as credentials and have no key hash", public.role)
+	}
+	hash := &key{role: public.role, kind: kindVKH, bytes: keyHash(public.bytes[:kindSizes[kindVK]])}
+	return writeKey(stdout, hash, *asHex)
+}
@@ -0,0 +1,288 @@
+package main
+
+import (
+	"bytes"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/islishude/bip32/v2/bip32ed25519"
+)
+
+const testMnemonic = "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+
+// runOK runs the command and returns its standard output.
+func runOK(t *testing.T, stdin string, args ...string) string {
+	t.Helper()
+	var stdout, stderr bytes.Buffer
+	if code := run(args, strings.NewReader(stdin), &stdout, &stderr); code != 0 {
+		t.Fatalf("cardano-address %s: exit %d: %s", strings.Join(args, " "), code, stderr.String())
+	}
+	return stdout.String()
+}
+
+// runFail runs the command, expects it to fail, and returns standard error.
+func runFail(t *testing.T, stdin string, args ...string) string {
+	t.Helper()
+	var stdout, stderr bytes.Buffer
+	if code := run(args, strings.NewReader(stdin), &stdout, &stderr); code == 0 {
+		t.Fatalf("cardano-address %s succeeded with %q", strings.Join(args, " "), stdout.String())
+	}
+	return stderr.String()
+}
+
+func TestFromRecoveryPhrase(t *testing.T) {
+	passphrase := filepath.Join(t.TempDir(), "passphrase")
+	if err := os.WriteFile(passphrase, []byte("foo\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	// The Icarus and Ledger root vectors of CIP-3. Shelley roots use the Icarus
+	// algorithm.
+	const icarusPhrase = "eight country switch draw meat scout mystery blade tip drift useless good keep usage title"
+	for _, tc := range []struct {
+		style, phrase, passphrase, want string
+	}{
+		{"Icarus", icarusPhrase, "", "c065afd2832cd8b087c4d9ab7011f481ee1e0721e78ea5dd609f3ab3f156d245d176bd8fd4ec60b4731c3918a2a72a0226c0cd119ec35b47e4d55884667f552a23f7fdcd4a10c6cd2c7393ac61d877873e248f417634aa3d812af327ffe9d620"},
+		{"Shelley", icarusPhrase, passphrase, "70531039904019351e1afb361cd1b312a4d0565d4ff9f8062d38acf4b15cce41d7b5738d9c893feea55512a3004acb0d222c35d3e3d5cde943a15a9824cbac59443cf67e589614076ba01e354b1a432e0e6db3b59e37fc56b5fb0222970a010e"},
+		{"Ledger", "recall grace sport punch exhibit mad harbor stand obey short width stem awkward used stairs wool ugly trap season stove worth toward congress jaguar", "", "a08cf85b564ecf3b947d8d4321fb96d70ee7bb760877e371899b14e2ccf88658104b884682b57efd97decbb318a45c05a527b9cc5c2f64f7352935a049ceea60680d52308194ccef2a18e6812b452a5815fbd7f5babc083856919aaf668fe7e4"},
+		{"Ledger", "correct cherry mammal bubble want mandate polar hazard crater better craft exotic choice fun tourist census gap lottery neglect address glow carry old business", "", "587c6774357ecbf840d4db6404ff7af016dace0400769751ad2abfc77b9a3844cc71702520ef1a4d1b68b91187787a9b8faab0a9bb6b160de541b6ee62469901fc0beda0975fe4763beabd83b7051a5fd5cbce5b88e82c4bbaca265014e524bd"},
+		{"Ledger", strings.Repeat("abandon ", 23) + "art", passphrase, "f053a1e752de5c26197b60f032a4809f08bb3e5d90484fe42024be31efcba7578d914d3ff992e21652fee6a4d99f6091006938fac2c0c0f9d2de0ba64b754e92a4f3723f23472077aa4cd4dd8a8a175dba07ea1852dad1cf268c61a2679c3890"},
+	} {
+		args := []string{"key", "from-recovery-phrase", tc.style, "-hex"}
+		if tc.passphrase != "" {
+			args = append(args, "-passphrase-file", tc.passphrase)
+		}
+		if got := runOK(t, tc.phrase+"\n", args...); got != tc.want {
+			t.Fatalf("%s root of %.20q = %s, want %s", tc.style, tc.phrase, got, tc.want)
+		}
+	}
+
+	if got := runOK(t, testMnemonic, "key", "from-recovery-phrase", "Shelley"); !strings.HasPrefix(got, "root_xsk1") || strings.HasSuffix(got, "\n") {
+		t.Fatalf("Shelley root = %q", got)
+	}
+	if runOK(t, testMnemonic, "key", "from-recovery-phrase", "Trezor") != runOK(t, testMnemonic, "key", "from-recovery-phrase", "Icarus") {
+		t.Fatal("12-word Trezor root differs from Icarus")
+	}
+
+	for _, tc := range []struct {
+		stdin string
+		args  []string
+		want  string
+	}{
+		{testMnemonic, []string{"Byron", "-passphrase-file", passphrase}, "takes no passphrase"},
+		{testMnemonic, []string{"Daedalus"}, "unknown style"},
+		{strings.Replace(testMnemonic, "about", "abandon", 1), []string{"Shelley"}, "checksum mismatch"},
+	} {
+		args := append([]string{"key", "from-recovery-phrase"}, tc.args...)
+		if stderr := runFail(t, tc.stdin, args...); !strings.Contains(stderr, tc.want) {
+			t.Fatalf("%v error = %q, want %q", tc.args, stderr, tc.want)
+		}
+	}
+}
+
+func TestCIP19KeyChain(t *testing.T) {
+	// The CIP-19 test vectors: the wallet of this phrase owns the reference
+	// payment key at 1852H/1815H/0H/0/0 and stake key at 1852H/1815H/1H/2/0,
+	// and the addresses below commit to their key hashes.
+	const (
+		phrase  = "test walk nut penalty hip pave soap entry language right filter choice"
+		addrVK  = "addr_vk1w0l2sr2zgfm26ztc6nl9xy8ghsk5sh6ldwemlpmp9xylzy4dtf7st80zhd"
+		stakeVK = "stake_vk1px4j0r2fk7ux5p23shz8f3y5y2qam7s954rgf3lg5merqcj6aetsft99wu"
+	)
+	addresses := []string{
+		"addr1qx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzer3n0d3vllmyqwsx5wktcd8cc3sq835lu7drv2xwl2wywfgse35a3x",
+		"addr1vx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzers66hrl8",
+		"stake1uyehkck0lajq8gr28t9uxnuvgcqrc6070x3k9r8048z8y5gh6ffgw",
+		"addr_test1qz2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzer3n0d3vllmyqwsx5wktcd8cc3sq835lu7drv2xwl2wywfgs68faae",
+		"addr_test1vz2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzerspjrlsz",
+		"stake_test1uqehkck0lajq8gr28t9uxnuvgcqrc6070x3k9r8048z8y5gssrtvn",
+	}
+
+	root := runOK(t, phrase, "key", "from-recovery-phrase", "Shelley")
+	if got := runOK(t, runOK(t, root, "key", "child", "1852H/1815H/0H/0/0"), "key", "public", "--without-chain-code"); got != addrVK {
+		t.Fatalf("payment key = %s, want %s", got, addrVK)
+	}
+	stakeXSK := runOK(t, runOK(t, root, "key", "child", "1852H/1815H/1H"), "key", "child", "2/0")
+	if got := runOK(t, stakeXSK, "key", "public", "--without-chain-code"); got != stakeVK {
+		t.Fatalf("stake key = %s, want %s", got, stakeVK)
+	}
+
+	// The same payment key through the account XVK and soft public derivation.
+	acctXVK := runOK(t, runOK(t, root, "key", "child", "1852H/1815H/0H"), "key", "public", "--with-chain-code")
+	paymentHash := runOK(t, runOK(t, acctXVK, "key", "child", "0/0"), "key", "hash", "-hex")
+	stakeHash := runOK(t, stakeVK, "key", "hash", "-hex")
+	for _, text := range addresses {
+		addr, err := bip32ed25519.ParseAddress(text)
+		if err != nil {
+			t.Fatalf("ParseAddress(%s): %v", text, err)
+		}
+		if addr.Type != bip32ed25519.RewardAddress && hex.EncodeToString(addr.Payment.Hash[:]) != paymentHash {
+			t.Fatalf("payment key hash %s is not the payment credential of %s", paymentHash, text)
+		}
+		if addr.Type != bip32ed25519.EnterpriseAddress && hex.EncodeToString(addr.Stake.Hash[:]) != stakeHash {
+			t.Fatalf("stake key hash %s is not the stake credential of %s", stakeHash, text)
+		}
+	}
+}
+
+func TestSerializationLibKeyChain(t *testing.T) {
+	// cardano-serialization-lib's root_key_15 wallet, whose entropy is
+	// 0ccb74f3…7c6412, and the addresses that library's tests derive from it:
+	// a Shelley base and enterprise address at 1852H/1815H/0H with the stake
+	// key at 2/0, and an Icarus Byron address at 44H/1815H/0H/0/0.
+	const (
+		phrase      = "art forum devote street sure rather head chuckle guard poverty release quote oak craft enemy"
+		baseAddr    = "addr_test1qpu5vlrf4xkxv2qpwngf6cjhtw542ayty80v8dyr49rf5ewvxwdrt70qlcpeeagscasafhffqsxy36t90ldv06wqrk2qum8x5w"
+		enterprise  = "addr_test1vpu5vlrf4xkxv2qpwngf6cjhtw542ayty80v8dyr49rf5eg57c2qv"
+		icarusByron = "Ae2tdPwUPEZHtBmjZBF4YpMkK9tMSPTE2ADEZTPN97saNkhG78TvXdp3GDk"
+		account     = "1852H/1815H/0H"
+	)
+	root := runOK(t, phrase, "key", "from-recovery-phrase", "Shelley")
+	acct := runOK(t, root, "key", "child", account)
+	vkHash := func(path string) string {
+		vk := runOK(t, runOK(t, acct, "key", "child", path), "key", "public", "--without-chain-code")
+		return runOK(t, vk, "key", "hash", "-hex")
+	}
+	paymentHash, stakeHash := vkHash("0/0"), vkHash("2/0")
+	for _, text := range []string{baseAddr, enterprise} {
+		addr, err := bip32ed25519.ParseAddress(text)
+		if err != nil {
+			t.Fatalf("ParseAddress(%s): %v", text, err)
+		}
+		if hex.EncodeToString(addr.Payment.Hash[:]) != paymentHash {
+			t.Fatalf("payment key hash %s is not the payment credential of %s", paymentHash, text)
+		}
+		if addr.Type == bip32ed25519.BaseAddress && hex.EncodeToString(addr.Stake.Hash[:]) != stakeHash {
+			t.Fatalf("stake key hash %s is not the stake credential of %s", stakeHash, text)
+		}
+	}
+
+	icarus := runOK(t, phrase, "key", "from-recovery-phrase", "Icarus")
+	xvk := runOK(t, runOK(t, icarus, "key", "child", "44H/1815H/0H/0/0"), "key", "public", "--with-chain-code", "-hex")
+	raw, err := hex.DecodeString(xvk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	xpub, err := bip32ed25519.NewXPubFromBytes(raw)
+	if err != nil {
+		t.Fatalf("NewXPubFromBytes: %v", err)
+	}
+	addr, err := bip32ed25519.NewByronAddress(xpub, bip32ed25519.ByronMainnetProtocolMagic)
+	if err != nil {
+		t.Fatalf("NewByronAddress: %v", err)
+	}
+	if got, _ := addr.Encode(); got != icarusByron {
+		t.Fatalf("Icarus Byron address = %s, want %s", got, icarusByron)
+	}
+}
+
+func TestShelleyKeyChain(t *testing.T) {
+	root := runOK(t, testMnemonic, "key", "from-recovery-phrase", "Shelley")
+	acct := runOK(t, root, "key", "child", "1852H/1815H/0H")
+	acctXVK := runOK(t, acct, "key", "public", "--with-chain-code")
+
+	// Soft derivation commutes with taking the public key, and a path from the
+	// root reaches the same key as the same path split at the account.
+	addrXSK := runOK(t, root, "key", "child", "1852H/1815H/0H/0/0")
+	addrXVK := runOK(t, acctXVK, "key", "child", "0/0")
+	if !strings.HasPrefix(addrXSK, "addr_xsk1") || runOK(t, addrXSK, "key", "public", "--with-chain-code") != addrXVK {
+		t.Fatalf("addr keys disagree: %q, %q", addrXSK, addrXVK)
+	}
+	if runOK(t, acct, "key", "child", "0/0") != addrXSK {
+		t.Fatal("child of account differs from child of root")
+	}
+
+	// Hex input yields hex output at every step.
+	hexRoot := runOK(t, testMnemonic, "key", "from-recovery-phrase", "Shelley", "-hex")
+	hexAddr := runOK(t, hexRoot, "key", "child", "1852H/1815H/0H/0/0")
+	if want := runOK(t, addrXSK, "key", "public", "--with-chain-code", "-hex"); runOK(t, hexAddr, "key", "public", "--with-chain-code") != want {
+		t.Fatal("hex derivation disagrees with bech32 derivation")
+	}
+}
+
+func TestByronKeyChain(t *testing.T) {
+	// Neither the cardano-address binary nor any other implementation of the
+	// Byron root and V1 derivation is available to these tests, and no
+	// published vector gives a Byron root together with its phrase. These
+	// values are the V1 vectors of the bip32ed25519 tests, so they catch
+	// regressions and disagreement between the tool and the library but do
+	// not show agreement with cardano-address.
+	const (
+		phrase   = "roast crime bounce convince core happy pitch safe brush exit basic among"
+		rootHex  = "60f6e2b12f4c51ed2a42163935fd95a6c39126e88571fe5ffd0332a4924e5e5e9ceda72e3e526a625ea86d16151957d45747fff0f8fcd00e394b132155dfdfc2918019cda35f1df96dd5a798da4c40a2f382358496e6468e4e276db5ec35235f"
+		childHex = "e8c7ce0ac3325c1d8655edda9c47f7b91a835f711e920f4906c5b2ec1b10f70fdfc4e97565a49f9a117974de373fe6b6ca93628d275f089170ca2f2bbbfa8a844fd543b338943010f54335c323bc8439f53bd3fea66b3ef2482f62bb38d1f364"
+	)
+	root := runOK(t, phrase, "key", "from-recovery-phrase", "Byron")
+	if got := runOK(t, root, "key", "child", "-hex", "-scheme", "V1", "0H/1H"); got != childHex {
+		t.Fatalf("V1 child = %s, want %s", got, childHex)
+	}
+	if got := runOK(t, phrase, "key", "from-recovery-phrase", "Byron", "-hex"); got != rootHex {
+		t.Fatalf("Byron root = %s, want %s", got, rootHex)
+	}
+
+	// The scheme carries through every step, including public derivation.
+	addr := runOK(t, root, "key", "child", "-scheme", "V1", "0H/1H")
+	if !strings.HasPrefix(addr, "addr_xsk1") {
+		t.Fatalf("Byron address key = %q", addr)
+	}
+	if runOK(t, root, "key", "child", "0H/1H") == addr {
+		t.Fatal("V2 derivation of a Byron root matches V1")
+	}
+	// Hex keys carry no role, so any depth may be derived from them.
+	parent := runOK(t, rootHex, "key", "child", "-scheme", "V1", "0H")
+	parentXVK := runOK(t, parent, "key", "public", "-scheme", "V1", "--with-chain-code")
+	soft := runOK(t, parent, "key", "child", "-scheme", "V1", "7")
+	if runOK(t, soft, "key", "public", "-scheme", "V1", "--with-chain-code") != runOK(t, parentXVK, "key", "child", "-scheme", "V1", "7") {
+		t.Fatal("V1 private and public derivation disagree")
+	}
+
+	if stderr := runFail(t, root, "key", "child", "-scheme", "V3", "0H"); !strings.Contains(stderr, "unknown scheme") {
+		t.Fatalf("unknown scheme error = %q", stderr)
+	}
+}
+
+func TestHash(t *testing.T) {
+	// CIP-19 reference payment and stake verification keys.
+	for _, tc := range []struct {
+		vk, want string
+	}{
+		{"addr_vk1w0l2sr2zgfm26ztc6nl9xy8ghsk5sh6ldwemlpmp9xylzy4dtf7st80zhd", "9493315cd92eb5d8c4304e67b7e16ae36d61d34502694657811a2c8e"},
+		{"stake_vk1px4j0r2fk7ux5p23shz8f3y5y2qam7s954rgf3lg5merqcj6aetsft99wu", "337b62cfff6403a06a3acbc34f8c46003c69fe79a3628cefa9c47251"},
+	} {
+		if got := runOK(t, tc.vk, "key", "hash", "-hex"); got != tc.want {
+			t.Fatalf("hash(%s) = %s, want %s", tc.vk, got, tc.want)
+		}
+	}
+	if got := runOK(t, "stake_vk1px4j0r2fk7ux5p23shz8f3y5y2qam7s954rgf3lg5merqcj6aetsft99wu", "key", "hash"); !strings.HasPrefix(got, "stake_vkh1") {
+		t.Fatalf("stake key hash = %q", got)
+	}
+}
+
+func TestKeyErrors(t *testing.T) {
+	root := runOK(t, testMnemonic, "key", "from-recovery-phrase", "Shelley")
+	acctXVK := runOK(t, runOK(t, root, "key", "child", "1852H/1815H/0H"), "key", "public", "--with-chain-code")
+
+	for _, tc := range []struct {
+		stdin string
+		args  []string
+		want  string
+	}{
+		{root, []string{"child", "1852H"}, "use root with 2, 3, or 5 levels"},
+		{root, []string{"child", "1852H/1815H/0H/9/0"}, "cannot derive 5 levels"},
+		{acctXVK, []string{"child", "0/0H"}, "hardened"},
+		{acctXVK, []string{"hash"}, "have no key hash"},
+		{root, []string{"hash"}, "want a public key"},
+		{root, []string{"public"}, "exactly one of"},
+		{acctXVK, []string{"public", "--with-chain-code"}, "want an extended private key"},
+		{"addr_xsk1qqqq", []string{"public", "--without-chain-code"}, "invalid bech32"},
+		{"00", []string{"hash"}, "wrong length"},
+	} {
+		args := append([]string{"key"}, tc.args...)
+		if stderr := runFail(t, tc.stdin, args...); !strings.Contains(stderr, tc.want) {
+			t.Fatalf("%v error = %q, want %q", tc.args, stderr, tc.want)
+		}
+	}
+}
@@ -1,4 +1,5 @@
-// Package bip32 provides scheme-independent BIP-32 constants and child-path
-// helpers. Curve-specific extended keys live in the bip32ed25519 and
+// Package bip32 provides scheme-independent BIP-32 constants, child-path
+// helpers, and generic and type-erased interfaces over extended keys of
+// either curve. Curve-specific extended keys live in the bip32ed25519 and
 // bip32secp256k1 subpackages.
 package bip32
@@ -6,3 +6,5 @@ require (
 	filippo.io/edwards25519 v1.2.0
 	golang.org/x/crypto v0.54.0
 )
+
+require golang.org/x/sys v0.47.0 // indirect
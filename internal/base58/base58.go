@@ -0,0 +1,148 @@
+// Package base58 implements Bitcoin's Base58 and Base58Check encodings, shared
+// by extended-key serialization, legacy addresses, and the command-line tools.
+//
+// Decode reports failure with a boolean, and CheckDecode takes the caller's
+// error values, so that callers can return their own package's errors.
+package base58
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+)
+
+// ChecksumSize is the width of the Base58Check checksum.
+const ChecksumSize = 4
+
+const alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+var indexes = func() [256]int16 {
+	var indexes [256]int16
+	for i := range indexes {
+		indexes[i] = -1
+	}
+	for i := range alphabet {
+		indexes[alphabet[i]] = int16(i)
+	}
+	return indexes
+}()
+
+// CheckEncode appends the double-SHA-256 checksum to a payload of any length
+// and encodes the result.
+func CheckEncode(payload []byte) string {
+	checksum := sha256d(payload)
+	full := make([]byte, 0, len(payload)+ChecksumSize)
+	full = append(full, payload...)
+	full = append(full, checksum[:ChecksumSize]...)
+	encoded := Encode(full)
+	clear(full)
+	return encoded
+}
+
+// CheckDecode strictly decodes a canonical Base58Check string carrying a
+// payload of exactly size bytes and returns the payload without the checksum.
+// It returns invalidEncoding for malformed or non-canonical text and
+// invalidChecksum for a checksum mismatch.
+func CheckDecode(encoded string, size int, invalidEncoding, invalidChecksum error) ([]byte, error) {
+	full, ok := Decode(encoded)
+	if !ok || len(full) != size+ChecksumSize {
+		clear(full)
+		return nil, invalidEncoding
+	}
+	if Encode(full) != encoded {
+		clear(full)
+		return nil, invalidEncoding
+	}
+	checksum := sha256d(full[:size])
+	if subtle.ConstantTimeCompare(full[size:], checksum[:ChecksumSize]) != 1 {
+		clear(full)
+		return nil, invalidChecksum
+	}
+	out := make([]byte, size)
+	copy(out, full[:size])
+	clear(full)
+	return out, nil
+}
+
+// Encode encodes input with one leading '1' per leading zero byte.
+func Encode(input []byte) string {
+	if len(input) == 0 {
+		return ""
+	}
+	zeros := 0
+	for zeros < len(input) && input[zeros] == 0 {
+		zeros++
+	}
+
+	size := (len(input)-zeros)*138/100 + 1
+	digits := make([]byte, size)
+	length := 0
+	for _, b := range input[zeros:] {
+		carry := int(b)
+		used := 0
+		for j := len(digits) - 1; (carry != 0 || used < length) && j >= 0; j-- {
+			carry += 256 * int(digits[j])
+			digits[j] = byte(carry % 58)
+			carry /= 58
+			used++
+		}
+		length = used
+	}
+
+	start := len(digits) - length
+	out := make([]byte, zeros+length)
+	for i := range zeros {
+		out[i] = alphabet[0]
+	}
+	for i, digit := range digits[start:] {
+		out[zeros+i] = alphabet[digit]
+	}
+	clear(digits)
+	return string(out)
+}
+
+// Decode decodes input and reports whether it contained only Base58
+// characters.
+func Decode(input string) ([]byte, bool) {
+	if input == "" {
+		return nil, true
+	}
+	zeros := 0
+	for zeros < len(input) && input[zeros] == alphabet[0] {
+		zeros++
+	}
+
+	size := (len(input)-zeros)*733/1000 + 1
+	decoded := make([]byte, size)
+	length := 0
+	for i := zeros; i < len(input); i++ {
+		value := indexes[input[i]]
+		if value < 0 {
+			clear(decoded)
+			return nil, false
+		}
+		carry := int(value)
+		used := 0
+		for j := len(decoded) - 1; (carry != 0 || used < length) && j >= 0; j-- {
+			carry += 58 * int(decoded[j])
+			decoded[j] = byte(carry)
+			carry >>= 8
+			used++
+		}
+		if carry != 0 {
+			clear(decoded)
+			return nil, false
+		}
+		length = used
+	}
+
+	start := len(decoded) - length
+	out := make([]byte, zeros+length)
+	copy(out[zeros:], decoded[start:])
+	clear(decoded)
+	return out, true
+}
+
+func sha256d(data []byte) [32]byte {
+	first := sha256.Sum256(data)
+	return sha256.Sum256(first[:])
+}
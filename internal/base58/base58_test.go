@@ -0,0 +1,62 @@
+package base58
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"testing"
+)
+
+var (
+	errEncoding = errors.New("encoding")
+	errChecksum = errors.New("checksum")
+)
+
+func TestEncodeDecode(t *testing.T) {
+	for _, tc := range []struct {
+		hex, text string
+	}{
+		{"", ""},
+		{"00", "1"},
+		{"0000", "11"},
+		{"61", "2g"},
+		{"626262", "a3gV"},
+		{"00000000000000000000", "1111111111"},
+		{"516b6fcd0f", "ABnLTmg"},
+		{"572e4794", "3EFU7m"},
+		{"10c8511e", "Rt5zm"},
+	} {
+		raw, _ := hex.DecodeString(tc.hex)
+		if got := Encode(raw); got != tc.text {
+			t.Fatalf("Encode(%s) = %q, want %q", tc.hex, got, tc.text)
+		}
+		got, ok := Decode(tc.text)
+		if !ok || !bytes.Equal(got, raw) {
+			t.Fatalf("Decode(%q) = %x, %v", tc.text, got, ok)
+		}
+	}
+	if _, ok := Decode("0OIl"); ok {
+		t.Fatal("Decode accepted characters outside the alphabet")
+	}
+}
+
+func TestCheckDecode(t *testing.T) {
+	// The P2PKH address of the compressed public key for private key 1.
+	const addr = "1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH"
+	payload, err := CheckDecode(addr, 21, errEncoding, errChecksum)
+	if err != nil {
+		t.Fatalf("CheckDecode: %v", err)
+	}
+	if CheckEncode(payload) != addr {
+		t.Fatal("CheckEncode did not round-trip")
+	}
+	if _, err := CheckDecode(addr, 20, errEncoding, errChecksum); err != errEncoding {
+		t.Fatalf("wrong size error = %v", err)
+	}
+	if _, err := CheckDecode(addr[:len(addr)-1]+"J", 21, errEncoding, errChecksum); err != errChecksum {
+		t.Fatalf("bad checksum error = %v", err)
+	}
+	if _, err := CheckDecode("0"+addr[1:], 21, errEncoding, errChecksum); err != errEncoding {
+		t.Fatalf("invalid character error = %v", err)
+	}
+}
@@ -0,0 +1,231 @@
+// Package bech32 implements the BIP-173 Bech32 and BIP-350 Bech32m checksummed
+// base-32 encodings shared by segwit addresses and Cardano CIP-5 strings.
+//
+// Functions report failure with a boolean so that callers can return their own
+// package's error values.
+package bech32
+
+import "strings"
+
+// Encoding selects the checksum constant.
+type Encoding uint8
+
+const (
+	// Bech32 is the original BIP-173 checksum, used by segwit v0 addresses and
+	// Cardano CIP-5.
+	Bech32 Encoding = iota + 1
+	// Bech32m is the BIP-350 checksum, used by segwit v1 and later.
+	Bech32m
+)
+
+// MaxLength is the BIP-173 limit on the total length of an encoded string.
+// Cardano CIP-5 lifts it, so Decode takes the limit as a parameter.
+const MaxLength = 90
+
+// MaxLengthCIP5 bounds CIP-5 strings, whose 96-byte extended private keys
+// already exceed MaxLength. It is the length up to which the checksum keeps
+// its error-detection guarantees.
+const MaxLengthCIP5 = 1023
+
+const charset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+
+var charsetIndexes = func() [256]int8 {
+	var indexes [256]int8
+	for i := range indexes {
+		indexes[i] = -1
+	}
+	for i := range charset {
+		indexes[charset[i]] = int8(i)
+	}
+	return indexes
+}()
+
+func checksumConstant(encoding Encoding) uint32 {
+	if encoding == Bech32m {
+		return 0x2bc830a3
+	}
+	return 1
+}
+
+func polymod(values []byte) uint32 {
+	generator := [5]uint32{0x3b6a57b2, 0x26508e6d, 0x1ea119fa, 0x3d4233dd, 0x2a1462b3}
+	chk := uint32(1)
+	for _, v := range values {
+		top := chk >> 25
+		chk = (chk&0x1ffffff)<<5 ^ uint32(v)
+		for i := range generator {
+			if (top>>i)&1 == 1 {
+				chk ^= generator[i]
+			}
+		}
+	}
+	return chk
+}
+
+func hrpExpand(hrp string) []byte {
+	out := make([]byte, 0, 2*len(hrp)+1)
+	for i := range len(hrp) {
+		out = append(out, hrp[i]>>5)
+	}
+	out = append(out, 0)
+	for i := range len(hrp) {
+		out = append(out, hrp[i]&31)
+	}
+	return out
+}
+
+func createChecksum(hrp string, data []byte, encoding Encoding) [6]byte {
+	values := append(hrpExpand(hrp), data...)
+	values = append(values, 0, 0, 0, 0, 0, 0)
+	mod := polymod(values) ^ checksumConstant(encoding)
+	var out [6]byte
+	for i := range out {
+		out[i] = byte(mod>>(5*(5-i))) & 31
+	}
+	return out
+}
+
+// Encode returns the lowercase encoding of hrp and 5-bit data values. It
+// reports false for an empty or non-printable hrp or a value above 31. It does
+// not enforce MaxLength.
+func Encode(hrp string, data []byte, encoding Encoding) (string, bool) {
+	if !validHRP(hrp) || hrp != strings.ToLower(hrp) {
+		return "", false
+	}
+	var b strings.Builder
+	b.Grow(len(hrp) + 1 + len(data) + 6)
+	b.WriteString(hrp)
+	b.WriteByte('1')
+	for _, v := range data {
+		if v > 31 {
+			return "", false
+		}
+		b.WriteByte(charset[v])
+	}
+	checksum := createChecksum(hrp, data, encoding)
+	for _, v := range checksum {
+		b.WriteByte(charset[v])
+	}
+	return b.String(), true
+}
+
+// Decode parses a Bech32 or Bech32m string of at most maxLength characters
+// and returns its lowercase hrp, its 5-bit data values without the checksum,
+// and the checksum variant that matched. Mixed-case input is rejected.
+func Decode(s string, maxLength int) (string, []byte, Encoding, bool) {
+	if len(s) > maxLength || (strings.ToLower(s) != s && strings.ToUpper(s) != s) {
+		return "", nil, 0, false
+	}
+	s = strings.ToLower(s)
+	sep := strings.LastIndexByte(s, '1')
+	if sep < 1 || sep+7 > len(s) {
+		return "", nil, 0, false
+	}
+	hrp := s[:sep]
+	if !validHRP(hrp) {
+		return "", nil, 0, false
+	}
+	data := make([]byte, 0, len(s)-sep-1)
+	for i := sep + 1; i < len(s); i++ {
+		v := charsetIndexes[s[i]]
+		if v < 0 {
+			return "", nil, 0, false
+		}
+		data = append(data, byte(v))
+	}
+
+	var encoding Encoding
+	switch polymod(append(hrpExpand(hrp), data...)) {
+	case checksumConstant(Bech32):
+		encoding = Bech32
+	case checksumConstant(Bech32m):
+		encoding = Bech32m
+	default:
+		return "", nil, 0, false
+	}
+	return hrp, data[:len(data)-6], encoding, true
+}
+
+func validHRP(hrp string) bool {
+	if hrp == "" {
+		return false
+	}
+	for i := range len(hrp) {
+		if hrp[i] < 33 || hrp[i] > 126 {
+			return false
+		}
+	}
+	return true
+}
+
+// ConvertBits regroups data from fromBits-wide values into toBits-wide values.
+// With pad, a final partial group is zero-padded; without it, leftover bits
+// must be fewer than fromBits and zero, as BIP-173 requires when decoding.
+func ConvertBits(data []byte, fromBits, toBits uint, pad bool) ([]byte, bool) {
+	var acc uint32
+	var bits uint
+	maxValue := uint32(1)<<toBits - 1
+	out := make([]byte, 0, len(data)*int(fromBits)/int(toBits)+1)
+	for _, v := range data {
+		if uint32(v)>>fromBits != 0 {
+			return nil, false
+		}
+		acc = acc<<fromBits | uint32(v)
+		bits += fromBits
+		for bits >= toBits {
+			bits -= toBits
+			out = append(out, byte(acc>>bits&maxValue))
+		}
+	}
+	if pad {
+		if bits > 0 {
+			out = append(out, byte(acc<<(toBits-bits)&maxValue))
+		}
+	} else if bits >= fromBits || acc<<(toBits-bits)&maxValue != 0 {
+		return nil, false
+	}
+	return out, true
+}
+
+// EncodeSegWit returns the segwit address for a witness version and program,
+// choosing Bech32 for version 0 and Bech32m otherwise as BIP-350 requires.
+func EncodeSegWit(hrp string, version byte, program []byte) (string, bool) {
+	if !validWitnessProgram(version, program) {
+		return "", false
+	}
+	converted, _ := ConvertBits(program, 8, 5, true)
+	encoding := Bech32m
+	if version == 0 {
+		encoding = Bech32
+	}
+	addr, ok := Encode(hrp, append([]byte{version}, converted...), encoding)
+	if !ok || len(addr) > MaxLength {
+		return "", false
+	}
+	return addr, true
+}
+
+// DecodeSegWit parses a segwit address with the expected hrp and returns its
+// witness version and program.
+func DecodeSegWit(hrp, addr string) (byte, []byte, bool) {
+	gotHRP, data, encoding, ok := Decode(addr, MaxLength)
+	if !ok || gotHRP != hrp || len(data) < 1 {
+		return 0, nil, false
+	}
+	version := data[0]
+	if version > 16 || (version == 0) != (encoding == Bech32) {
+		return 0, nil, false
+	}
+	program, ok := ConvertBits(data[1:], 5, 8, false)
+	if !ok || !validWitnessProgram(version, program) {
+		return 0, nil, false
+	}
+	return version, program, true
+}
+
+func validWitnessProgram(version byte, program []byte) bool {
+	if version > 16 || len(program) < 2 || len(program) > 40 {
+		return false
+	}
+	return version != 0 || len(program) == 20 || len(program) == 32
+}
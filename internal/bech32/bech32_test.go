@@ -0,0 +1,91 @@
+package bech32
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+)
+
+func TestChecksumVectors(t *testing.T) {
+	valid := map[string]Encoding{
+		"A12UEL5L": Bech32,
+		"a12uel5l": Bech32,
+		"an83characterlonghumanreadablepartthatcontainsthenumber1andtheexcludedcharactersbio1tt5tgs": Bech32,
+		"abcdef1qpzry9x8gf2tvdw0s3jn54khce6mua7lmqqqxw":                                              Bech32,
+		"?1ezyfcl": Bech32,
+		"A1LQFN3A": Bech32m,
+		"a1lqfn3a": Bech32m,
+		"abcdef1l7aum6echk45nj3s0wdvt2fg8x9yrzpqzd3ryx": Bech32m,
+		"?1v759aa": Bech32m,
+	}
+	for s, want := range valid {
+		hrp, data, encoding, ok := Decode(s, MaxLength)
+		if !ok || encoding != want {
+			t.Fatalf("Decode(%q) = %v, %v; want %v", s, encoding, ok, want)
+		}
+		encoded, ok := Encode(hrp, data, encoding)
+		if !ok || encoded != strings.ToLower(s) {
+			t.Fatalf("Encode round trip of %q = %q", s, encoded)
+		}
+	}
+
+	for _, s := range []string{
+		"pzry9x0s0muk",  // no separator
+		"1pzry9x0s0muk", // empty hrp
+		"x1b4n0q5v",     // invalid data character
+		"li1dgmt3",      // checksum too short
+		"A1G7SGD8",      // checksum computed with uppercase hrp
+		"10a06t8",       // empty hrp
+		"1qzzfhee",      // empty hrp
+		"a12UEL5L",      // mixed case
+		"abcdef1qpzry9x8gf2tvdw0s3jn54khce6mua7lmqqqxx", // bad checksum
+	} {
+		if _, _, _, ok := Decode(s, MaxLength); ok {
+			t.Fatalf("Decode(%q) succeeded", s)
+		}
+	}
+	long := "an84characterslonghumanreadablepartthatcontainsthenumber1andtheexcludedcharactersbio1569pvx"
+	if _, _, _, ok := Decode(long, MaxLength); ok {
+		t.Fatal("Decode accepted a string over MaxLength")
+	}
+}
+
+func TestSegWitVectors(t *testing.T) {
+	for _, v := range []struct{ hrp, addr, script string }{
+		{"bc", "BC1QW508D6QEJXTDG4Y5R3ZARVARY0C5XW7KV8F3T4", "0014751e76e8199196d454941c45d1b3a323f1433bd6"},
+		{"tb", "tb1qrp33g0q5c5txsp9arysrx4k6zdkfs4nce4xj0gdcccefvpysxf3q0sl5k7", "00201863143c14c5166804bd19203356da136c985678cd4d27a1b8c6329604903262"},
+		{"bc", "bc1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vqzk5jj0", "512079be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798"},
+	} {
+		version, program, ok := DecodeSegWit(v.hrp, v.addr)
+		if !ok {
+			t.Fatalf("DecodeSegWit(%q) failed", v.addr)
+		}
+		script, _ := hex.DecodeString(v.script)
+		if version != witnessVersion(script[0]) || hex.EncodeToString(program) != v.script[4:] {
+			t.Fatalf("DecodeSegWit(%q) = %d, %x", v.addr, version, program)
+		}
+		addr, ok := EncodeSegWit(v.hrp, version, program)
+		if !ok || addr != strings.ToLower(v.addr) {
+			t.Fatalf("EncodeSegWit = %q, want %q", addr, strings.ToLower(v.addr))
+		}
+	}
+
+	for _, addr := range []string{
+		"bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kemeawh",                     // v0 with Bech32m checksum
+		"bc1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vqh2y7hd", // v1 with Bech32 checksum
+		"tb1z0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vq",       // wrong hrp
+		"bc1gmk9yu",                            // empty program
+		"BC1QR508D6QEJXTDG4Y5R3ZARVARYV98GJ9P", // invalid v0 program length
+	} {
+		if _, _, ok := DecodeSegWit("bc", addr); ok {
+			t.Fatalf("DecodeSegWit(%q) succeeded", addr)
+		}
+	}
+}
+
+func witnessVersion(opcode byte) byte {
+	if opcode == 0 {
+		return 0
+	}
+	return opcode - 0x50
+}
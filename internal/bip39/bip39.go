@@ -0,0 +1,129 @@
+// Package bip39 decodes English BIP-39 mnemonics for the command-line tools
+// and the hardware-wallet master-key variants that start from a mnemonic.
+//
+// Only the English wordlist is supported, so a valid mnemonic is ASCII and
+// Unicode NFKD normalization is the identity for it. Passphrases, which may be
+// any text, must be ASCII for the same reason.
+package bip39
+
+import (
+	"crypto/sha256"
+	"crypto/sha512"
+	_ "embed"
+	"errors"
+	"strings"
+
+	"golang.org/x/crypto/pbkdf2"
+)
+
+// english.txt is the BIP-39 English wordlist, byte for byte.
+//
+//go:embed english.txt
+var englishWordlist string
+
+var englishIndexes = func() map[string]int {
+	words := strings.Fields(englishWordlist)
+	if len(words) != 2048 {
+		panic("bip39: wordlist must have 2048 words")
+	}
+	indexes := make(map[string]int, len(words))
+	for i, word := range words {
+		indexes[word] = i
+	}
+	return indexes
+}()
+
+var (
+	// ErrLength reports a word count other than 12, 15, 18, 21, or 24.
+	ErrLength = errors.New("bip39: mnemonic must have 12, 15, 18, 21, or 24 words")
+	// ErrWord reports a word outside the English wordlist.
+	ErrWord = errors.New("bip39: mnemonic contains a word outside the English wordlist")
+	// ErrChecksum reports a mnemonic whose checksum bits do not match.
+	ErrChecksum = errors.New("bip39: mnemonic checksum mismatch")
+	// ErrNonASCII reports a passphrase that would need NFKD normalization.
+	ErrNonASCII = errors.New("bip39: non-ASCII passphrases need Unicode NFKD normalization, which is not implemented")
+)
+
+// Entropy checks mnemonic and returns the entropy it encodes. Words may be
+// separated by any whitespace.
+func Entropy(mnemonic string) ([]byte, error) {
+	bits, words, err := decode(mnemonic)
+	if err != nil {
+		return nil, err
+	}
+	defer clear(bits)
+	out := make([]byte, words/3*4)
+	copy(out, bits)
+	return out, nil
+}
+
+// EntropyWithChecksum checks mnemonic and returns its entropy followed by its
+// checksum bits, zero-padded to a whole byte. For 24 words that is exactly 33
+// bytes, which Trezor's Cardano firmware used in place of the entropy.
+func EntropyWithChecksum(mnemonic string) ([]byte, error) {
+	bits, _, err := decode(mnemonic)
+	if err != nil {
+		return nil, err
+	}
+	return bits, nil
+}
+
+// Seed checks mnemonic and returns its 64-byte BIP-39 seed,
+// PBKDF2-HMAC-SHA512(mnemonic, "mnemonic" || passphrase, 2048).
+func Seed(mnemonic string, passphrase []byte) ([]byte, error) {
+	bits, _, err := decode(mnemonic)
+	if err != nil {
+		return nil, err
+	}
+	clear(bits)
+	for _, c := range passphrase {
+		if c >= 0x80 {
+			return nil, ErrNonASCII
+		}
+	}
+	normalized := []byte(strings.Join(strings.Fields(mnemonic), " "))
+	defer clear(normalized)
+	salt := append([]byte("mnemonic"), passphrase...)
+	defer clear(salt)
+	return pbkdf2.Key(normalized, salt, 2048, 64, sha512.New), nil
+}
+
+// decode returns the mnemonic's bits, entropy first and checksum last, and
+// its word count.
+func decode(mnemonic string) ([]byte, int, error) {
+	words := strings.Fields(mnemonic)
+	switch len(words) {
+	case 12, 15, 18, 21, 24:
+	default:
+		return nil, 0, ErrLength
+	}
+
+	// Each word carries 11 bits; the last len(words)/3 bits are the checksum.
+	bits := make([]byte, (len(words)*11+7)/8)
+	for i, word := range words {
+		index, ok := englishIndexes[word]
+		if !ok {
+			clear(bits)
+			return nil, 0, ErrWord
+		}
+		for b := range 11 {
+			if index&(1<<(10-b)) != 0 {
+				pos := i*11 + b
+				bits[pos/8] |= 0x80 >> (pos % 8)
+			}
+		}
+	}
+	checksumBits := len(words) / 3
+	entropy := bits[:checksumBits*4]
+	sum := sha256.Sum256(entropy)
+	for b := range checksumBits {
+		pos := len(entropy)*8 + b
+		got := bits[pos/8] & (0x80 >> (pos % 8))
+		want := sum[b/8] & (0x80 >> (b % 8))
+		if (got == 0) != (want == 0) {
+			clear(bits)
+			return nil, 0, ErrChecksum
+		}
+	}
+	return bits, len(words), nil
+}
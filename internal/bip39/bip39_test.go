@@ -0,0 +1,76 @@
+package bip39
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestEntropyAndSeed(t *testing.T) {
+	// Vectors from the BIP-39 reference implementation, all with passphrase
+	// "TREZOR".
+	for _, tc := range []struct {
+		entropy, mnemonic, seed string
+	}{
+		{
+			"00000000000000000000000000000000",
+			"abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about",
+			"c55257c360c07c72029aebc1b53c05ed0362ada38ead3e3e9efa3708e53495531f09a6987599d18264c1e1c92f2cf141630c7a3c4ab7c81b2f001698e7463b04",
+		},
+		{
+			"7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f",
+			"legal winner thank year wave sausage worth useful legal winner thank yellow",
+			"2e8905819b8723fe2c1d161860e5ee1830318dbf49a83bd451cfb8440c28bd6fa457fe1296106559a3c80937a1c1069be3a3a5bd381ee6260e8d9739fce1f607",
+		},
+		{
+			"ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff",
+			"zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo vote",
+			"dd48c104698c30cfe2b6142103248622fb7bb0ff692eebb00089b32d22484e1613912f0a5b694407be899ffd31ed3992c456cdf60f5d4564b8ba3f05a69890ad",
+		},
+	} {
+		entropy, err := Entropy(tc.mnemonic)
+		if err != nil || hex.EncodeToString(entropy) != tc.entropy {
+			t.Fatalf("Entropy(%q) = %x, %v", tc.mnemonic, entropy, err)
+		}
+		withChecksum, err := EntropyWithChecksum(tc.mnemonic)
+		if err != nil || !bytes.HasPrefix(withChecksum, entropy) || len(withChecksum) != len(entropy)+1 {
+			t.Fatalf("EntropyWithChecksum(%q) = %x, %v", tc.mnemonic, withChecksum, err)
+		}
+		seed, err := Seed(strings.ReplaceAll(tc.mnemonic, " ", "\n "), []byte("TREZOR"))
+		if err != nil || hex.EncodeToString(seed) != tc.seed {
+			t.Fatalf("Seed(%q) = %x, %v", tc.mnemonic, seed, err)
+		}
+	}
+}
+
+func TestEntropyWithChecksum(t *testing.T) {
+	// The last word, "vote", is index 1967: three entropy bits and the
+	// eight-bit checksum 0xaf, the first byte of SHA-256 of the entropy.
+	mnemonic := strings.Repeat("zoo ", 23) + "vote"
+	got, err := EntropyWithChecksum(mnemonic)
+	if err != nil || len(got) != 33 || got[32] != 0xaf {
+		t.Fatalf("EntropyWithChecksum = %x, %v", got, err)
+	}
+}
+
+func TestInvalidMnemonics(t *testing.T) {
+	const valid = "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+	for _, tc := range []struct {
+		mnemonic string
+		want     error
+	}{
+		{"abandon " + valid, ErrLength},
+		{"", ErrLength},
+		{strings.Replace(valid, "about", "abandon", 1), ErrChecksum},
+		{strings.Replace(valid, "about", "About", 1), ErrWord},
+	} {
+		if _, err := Entropy(tc.mnemonic); !errors.Is(err, tc.want) {
+			t.Fatalf("Entropy(%q) error = %v, want %v", tc.mnemonic, err, tc.want)
+		}
+	}
+	if _, err := Seed(valid, []byte("café")); !errors.Is(err, ErrNonASCII) {
+		t.Fatalf("non-ASCII passphrase error = %v", err)
+	}
+}
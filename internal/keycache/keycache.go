@@ -0,0 +1,171 @@
+// Package keycache contains the bounded derivation cache shared by the
+// curve-specific Keychain types.
+package keycache
+
+import (
+	"container/list"
+	"encoding/binary"
+	"sync"
+)
+
+// Stats reports cache activity. Hits and Misses count path lookups, not
+// individual nodes.
+type Stats struct {
+	Hits      uint64
+	Misses    uint64
+	Evictions uint64
+	Len       int
+}
+
+// Ops supplies the key operations needed by Chain. Clone must return an
+// independent copy, and Wipe must clear secret material in place.
+type Ops[K any] struct {
+	Derive func(K, uint32) (K, error)
+	Clone  func(K) K
+	Wipe   func(K)
+}
+
+// Chain memoizes nodes below a root key in a least-recently-used cache keyed by
+// the full index path. Cached nodes never leave the cache: lookups return
+// clones, so a caller wiping its result cannot corrupt later lookups.
+type Chain[K any] struct {
+	ops Ops[K]
+
+	mu        sync.Mutex
+	root      K
+	capacity  int
+	order     *list.List
+	items     map[string]*list.Element
+	hits      uint64
+	misses    uint64
+	evictions uint64
+}
+
+type entry[K any] struct {
+	key  string
+	node K
+}
+
+// New returns a Chain that owns root and holds at most capacity derived nodes.
+// The caller must ensure capacity is positive.
+func New[K any](root K, capacity int, ops Ops[K]) *Chain[K] {
+	return &Chain[K]{
+		ops:      ops,
+		root:     root,
+		capacity: capacity,
+		order:    list.New(),
+		items:    make(map[string]*list.Element),
+	}
+}
+
+// Derive returns a caller-owned copy of the node at indexes. Intermediate nodes
+// derived on a miss are inserted into the cache.
+func (c *Chain[K]) Derive(indexes []uint32) (K, error) {
+	key := pathKey(indexes)
+
+	c.mu.Lock()
+	if len(indexes) == 0 {
+		c.hits++
+		out := c.ops.Clone(c.root)
+		c.mu.Unlock()
+		return out, nil
+	}
+	if elem, ok := c.items[key]; ok {
+		c.hits++
+		c.order.MoveToFront(elem)
+		out := c.ops.Clone(elem.Value.(*entry[K]).node)
+		c.mu.Unlock()
+		return out, nil
+	}
+	c.misses++
+
+	// Resume from the longest cached ancestor. The clone is taken under the lock
+	// because an eviction may wipe the cached node once the lock is released.
+	start := 0
+	node := c.ops.Clone(c.root)
+	for i := len(indexes) - 1; i > 0; i-- {
+		if elem, ok := c.items[key[:4*i]]; ok {
+			c.ops.Wipe(node)
+			node = c.ops.Clone(elem.Value.(*entry[K]).node)
+			start = i
+			break
+		}
+	}
+	c.mu.Unlock()
+
+	for i := start; i < len(indexes); i++ {
+		next, err := c.ops.Derive(node, indexes[i])
+		c.ops.Wipe(node)
+		if err != nil {
+			var zero K
+			return zero, err
+		}
+		node = next
+		c.insert(key[:4*(i+1)], node)
+	}
+	return node, nil
+}
+
+// Stats returns a snapshot of the cache counters.
+func (c *Chain[K]) Stats() Stats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return Stats{
+		Hits:      c.hits,
+		Misses:    c.misses,
+		Evictions: c.evictions,
+		Len:       c.order.Len(),
+	}
+}
+
+// Purge wipes and removes every cached node. The root is kept.
+func (c *Chain[K]) Purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.purgeLocked()
+}
+
+// Wipe wipes every cached node and the root. The Chain must not be used
+// afterwards.
+func (c *Chain[K]) Wipe() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.purgeLocked()
+	c.ops.Wipe(c.root)
+}
+
+func (c *Chain[K]) insert(key string, node K) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if elem, ok := c.items[key]; ok {
+		// A concurrent lookup cached the same node first.
+		c.order.MoveToFront(elem)
+		return
+	}
+	c.items[key] = c.order.PushFront(&entry[K]{key: key, node: c.ops.Clone(node)})
+	for c.order.Len() > c.capacity {
+		oldest := c.order.Back()
+		e := c.order.Remove(oldest).(*entry[K])
+		delete(c.items, e.key)
+		c.ops.Wipe(e.node)
+		c.evictions++
+	}
+}
+
+func (c *Chain[K]) purgeLocked() {
+	for elem := c.order.Front(); elem != nil; elem = elem.Next() {
+		c.ops.Wipe(elem.Value.(*entry[K]).node)
+	}
+	c.order.Init()
+	clear(c.items)
+}
+
+// pathKey packs indexes into a string so every prefix of the path is also a
+// prefix of the key at a multiple of four bytes.
+func pathKey(indexes []uint32) string {
+	out := make([]byte, 4*len(indexes))
+	for i, index := range indexes {
+		binary.BigEndian.PutUint32(out[4*i:], index)
+	}
+	return string(out)
+}
@@ -0,0 +1,105 @@
+package keycache
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+type node struct {
+	path  []uint32
+	wiped bool
+}
+
+func testOps(wiped *[]string) Ops[*node] {
+	return Ops[*node]{
+		Derive: func(parent *node, index uint32) (*node, error) {
+			if parent.wiped {
+				return nil, errors.New("derived from wiped node")
+			}
+			if index == 99 {
+				return nil, errors.New("invalid child")
+			}
+			return &node{path: append(append([]uint32(nil), parent.path...), index)}, nil
+		},
+		Clone: func(n *node) *node {
+			out := *n
+			out.path = append([]uint32(nil), n.path...)
+			return &out
+		},
+		Wipe: func(n *node) {
+			if wiped != nil && !n.wiped {
+				*wiped = append(*wiped, pathKey(n.path))
+			}
+			n.wiped = true
+		},
+	}
+}
+
+func TestChainMemoizesIntermediateNodes(t *testing.T) {
+	chain := New(&node{}, 8, testOps(nil))
+
+	got, err := chain.Derive([]uint32{1, 2, 3})
+	if err != nil || !reflect.DeepEqual(got.path, []uint32{1, 2, 3}) {
+		t.Fatalf("Derive = %v, %v", got, err)
+	}
+	if s := chain.Stats(); s.Hits != 0 || s.Misses != 1 || s.Len != 3 {
+		t.Fatalf("stats after first lookup = %+v", s)
+	}
+	got.wiped = true
+
+	for _, path := range [][]uint32{{1}, {1, 2}, {1, 2, 3}, {}} {
+		got, err := chain.Derive(path)
+		if err != nil || got.wiped || !reflect.DeepEqual(got.path, append([]uint32(nil), path...)) {
+			t.Fatalf("cached Derive(%v) = %+v, %v", path, got, err)
+		}
+	}
+	if s := chain.Stats(); s.Hits != 4 || s.Misses != 1 {
+		t.Fatalf("stats after cached lookups = %+v", s)
+	}
+
+	if _, err := chain.Derive([]uint32{1, 2, 99}); err == nil {
+		t.Fatal("derivation error was not returned")
+	}
+	if s := chain.Stats(); s.Len != 3 {
+		t.Fatalf("failed derivation changed cache size: %+v", s)
+	}
+}
+
+func TestChainEvictsLeastRecentlyUsedAndWipes(t *testing.T) {
+	var wiped []string
+	chain := New(&node{}, 2, testOps(&wiped))
+
+	if _, err := chain.Derive([]uint32{1}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := chain.Derive([]uint32{2}); err != nil {
+		t.Fatal(err)
+	}
+	// Touch 1 so 2 becomes the eviction candidate.
+	if _, err := chain.Derive([]uint32{1}); err != nil {
+		t.Fatal(err)
+	}
+	wiped = wiped[:0]
+	if _, err := chain.Derive([]uint32{3}); err != nil {
+		t.Fatal(err)
+	}
+	if s := chain.Stats(); s.Evictions != 1 || s.Len != 2 {
+		t.Fatalf("stats after eviction = %+v", s)
+	}
+	if _, ok := chain.items[pathKey([]uint32{2})]; ok {
+		t.Fatal("least recently used node was not evicted")
+	}
+	found := false
+	for _, key := range wiped {
+		found = found || key == pathKey([]uint32{2})
+	}
+	if !found {
+		t.Fatal("evicted node was not wiped")
+	}
+
+	chain.Wipe()
+	if s := chain.Stats(); s.Len != 0 || !chain.root.wiped {
+		t.Fatalf("Wipe left cached nodes or root: %+v", s)
+	}
+}
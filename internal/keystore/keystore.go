@@ -0,0 +1,318 @@
+// Package keystore implements the versioned, password-encrypted key file
+// format shared by the curve-specific SealKeystore and OpenKeystore functions.
+//
+// A keystore is a JSON object holding the format version, plaintext metadata,
+// the KDF and cipher parameters, and the sealed key. The version, metadata and
+// parameters are authenticated as AEAD additional data, so a file whose
+// metadata was edited fails to open exactly like one with a wrong password.
+// Functions return the error values in Format so that callers can report their
+// own package's errors.
+package keystore
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"math/bits"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/chacha20poly1305"
+	"golang.org/x/crypto/scrypt"
+)
+
+// Version is the only keystore format version.
+const Version = 1
+
+const (
+	keySize  = 32
+	saltSize = 32
+
+	maxSaltSize     = 64
+	maxScryptLogN   = 20
+	maxScryptR      = 32
+	maxScryptP      = 16
+	maxScryptMemory = 1 << 30 // bytes, 128·N·r
+	maxScryptWork   = 1 << 24 // N·r·p
+	maxArgon2Time   = 16
+	maxArgon2Memory = 1 << 20 // KiB, 1 GiB
+	maxArgon2Lanes  = 16
+)
+
+// KDF selects the password-based key derivation function.
+type KDF uint8
+
+const (
+	// Argon2id is RFC 9106 Argon2id.
+	Argon2id KDF = iota + 1
+	// Scrypt is RFC 7914 scrypt.
+	Scrypt
+)
+
+// Cipher selects the AEAD that seals the key.
+type Cipher uint8
+
+const (
+	// AES256GCM is AES-256 in Galois/Counter Mode with a 96-bit nonce.
+	AES256GCM Cipher = iota + 1
+	// XChaCha20Poly1305 is XChaCha20-Poly1305 with a 192-bit nonce.
+	XChaCha20Poly1305
+)
+
+// Params selects the KDF, its cost and the cipher for Seal.
+type Params struct {
+	KDF    KDF
+	Cipher Cipher
+
+	// ScryptN, ScryptR and ScryptP are the scrypt costs.
+	ScryptN, ScryptR, ScryptP int
+	// Argon2Time, Argon2Memory (in KiB) and Argon2Threads are the Argon2id
+	// costs.
+	Argon2Time, Argon2Memory uint32
+	Argon2Threads            uint8
+}
+
+// DefaultParams returns the KDF costs used for new keystores: the RFC 9106
+// second recommended Argon2id option (t=3, 64 MiB, p=4), or scrypt with
+// N=2^17, r=8, p=1.
+func DefaultParams(kdf KDF, c Cipher) Params {
+	params := Params{KDF: kdf, Cipher: c}
+	switch kdf {
+	case Argon2id:
+		params.Argon2Time, params.Argon2Memory, params.Argon2Threads = 3, 64*1024, 4
+	case Scrypt:
+		params.ScryptN, params.ScryptR, params.ScryptP = 1<<17, 8, 1
+	}
+	return params
+}
+
+// Metadata describes the sealed key. It is stored in plaintext and
+// authenticated.
+type Metadata struct {
+	Curve       string `json:"curve"`
+	Network     string `json:"network,omitempty"`
+	Variant     string `json:"variant,omitempty"`
+	Fingerprint string `json:"fingerprint"`
+	Created     int64  `json:"created"`
+}
+
+// Format binds the file format to one curve package and its error values.
+type Format struct {
+	Curve string
+	// Invalid reports a malformed file or one for another curve.
+	Invalid error
+	// Unsupported reports an unknown version, KDF or cipher, or KDF costs
+	// outside the accepted bounds.
+	Unsupported error
+	// Decryption reports a wrong password or an edited file.
+	Decryption error
+}
+
+type file struct {
+	header
+	Ciphertext string `json:"ciphertext"`
+}
+
+// header is the authenticated part of a file. Its JSON encoding, which Go
+// produces deterministically from the struct, is the AEAD additional data.
+type header struct {
+	Version  int          `json:"version"`
+	Metadata Metadata     `json:"metadata"`
+	KDF      kdfParams    `json:"kdf"`
+	Cipher   cipherParams `json:"cipher"`
+}
+
+type kdfParams struct {
+	Name    string `json:"name"`
+	Salt    string `json:"salt"`
+	N       int    `json:"n,omitempty"`
+	R       int    `json:"r,omitempty"`
+	P       int    `json:"p,omitempty"`
+	Time    uint32 `json:"time,omitempty"`
+	Memory  uint32 `json:"memory,omitempty"`
+	Threads uint8  `json:"threads,omitempty"`
+}
+
+type cipherParams struct {
+	Name  string `json:"name"`
+	Nonce string `json:"nonce"`
+}
+
+// Seal encrypts plaintext under password with fresh salt and nonce drawn from
+// r, or crypto/rand.Reader when r is nil, and returns the JSON keystore. The
+// Curve field of meta is set from f.
+func (f Format) Seal(r io.Reader, plaintext, password []byte, meta Metadata, params Params) ([]byte, error) {
+	if r == nil {
+		r = rand.Reader
+	}
+	meta.Curve = f.Curve
+	h := header{Version: Version, Metadata: meta}
+
+	salt := make([]byte, saltSize)
+	if _, err := io.ReadFull(r, salt); err != nil {
+		return nil, err
+	}
+	h.KDF = kdfParams{Salt: hex.EncodeToString(salt)}
+	switch params.KDF {
+	case Argon2id:
+		h.KDF.Name = "argon2id"
+		h.KDF.Time, h.KDF.Memory, h.KDF.Threads = params.Argon2Time, params.Argon2Memory, params.Argon2Threads
+	case Scrypt:
+		h.KDF.Name = "scrypt"
+		h.KDF.N, h.KDF.R, h.KDF.P = params.ScryptN, params.ScryptR, params.ScryptP
+	}
+	switch params.Cipher {
+	case AES256GCM:
+		h.Cipher.Name = "aes-256-gcm"
+	case XChaCha20Poly1305:
+		h.Cipher.Name = "xchacha20-poly1305"
+	}
+	if !validKDF(&h.KDF) || h.Cipher.Name == "" {
+		return nil, f.Unsupported
+	}
+
+	key, err := deriveKey(&h.KDF, salt, password)
+	if err != nil {
+		return nil, err
+	}
+	defer clear(key)
+	aead, err := newAEAD(h.Cipher.Name, key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(r, nonce); err != nil {
+		return nil, err
+	}
+	h.Cipher.Nonce = hex.EncodeToString(nonce)
+
+	ad, err := json.Marshal(h)
+	if err != nil {
+		return nil, err
+	}
+	sealed := aead.Seal(nil, nonce, plaintext, ad)
+	return json.Marshal(file{header: h, Ciphertext: hex.EncodeToString(sealed)})
+}
+
+// Open authenticates and decrypts a keystore. The caller owns and should
+// clear the returned plaintext. The returned Params carry the file's KDF and
+// cipher so that ChangePassword can reseal with the same choices.
+func (f Format) Open(data, password []byte) ([]byte, Metadata, Params, error) {
+	var in file
+	if err := json.Unmarshal(data, &in); err != nil {
+		return nil, Metadata{}, Params{}, f.Invalid
+	}
+	if in.Version != Version {
+		return nil, Metadata{}, Params{}, f.Unsupported
+	}
+	if in.Metadata.Curve != f.Curve {
+		return nil, Metadata{}, Params{}, f.Invalid
+	}
+	salt, err1 := hex.DecodeString(in.KDF.Salt)
+	nonce, err2 := hex.DecodeString(in.Cipher.Nonce)
+	sealed, err3 := hex.DecodeString(in.Ciphertext)
+	if err1 != nil || err2 != nil || err3 != nil || len(salt) < 16 || len(salt) > maxSaltSize {
+		return nil, Metadata{}, Params{}, f.Invalid
+	}
+	params, ok := paramsOf(&in.header)
+	if !ok || !validKDF(&in.KDF) {
+		return nil, Metadata{}, Params{}, f.Unsupported
+	}
+
+	key, err := deriveKey(&in.KDF, salt, password)
+	if err != nil {
+		return nil, Metadata{}, Params{}, f.Unsupported
+	}
+	defer clear(key)
+	aead, err := newAEAD(in.Cipher.Name, key)
+	if err != nil {
+		return nil, Metadata{}, Params{}, f.Unsupported
+	}
+	if len(nonce) != aead.NonceSize() {
+		return nil, Metadata{}, Params{}, f.Invalid
+	}
+	ad, err := json.Marshal(in.header)
+	if err != nil {
+		return nil, Metadata{}, Params{}, f.Invalid
+	}
+	plaintext, err := aead.Open(nil, nonce, sealed, ad)
+	if err != nil {
+		return nil, Metadata{}, Params{}, f.Decryption
+	}
+	return plaintext, in.Metadata, params, nil
+}
+
+// ChangePassword opens a keystore with oldPassword and reseals the same key
+// and metadata under newPassword with the same KDF costs and cipher and a
+// fresh salt and nonce.
+func (f Format) ChangePassword(r io.Reader, data, oldPassword, newPassword []byte) ([]byte, error) {
+	plaintext, meta, params, err := f.Open(data, oldPassword)
+	if err != nil {
+		return nil, err
+	}
+	defer clear(plaintext)
+	return f.Seal(r, plaintext, newPassword, meta, params)
+}
+
+func paramsOf(h *header) (Params, bool) {
+	var params Params
+	switch h.KDF.Name {
+	case "argon2id":
+		params.KDF = Argon2id
+		params.Argon2Time, params.Argon2Memory, params.Argon2Threads = h.KDF.Time, h.KDF.Memory, h.KDF.Threads
+	case "scrypt":
+		params.KDF = Scrypt
+		params.ScryptN, params.ScryptR, params.ScryptP = h.KDF.N, h.KDF.R, h.KDF.P
+	default:
+		return Params{}, false
+	}
+	switch h.Cipher.Name {
+	case "aes-256-gcm":
+		params.Cipher = AES256GCM
+	case "xchacha20-poly1305":
+		params.Cipher = XChaCha20Poly1305
+	default:
+		return Params{}, false
+	}
+	return params, true
+}
+
+// validKDF bounds the costs an opened file can demand, so that a hostile
+// keystore cannot exhaust memory or CPU. Each scrypt parameter is bounded, and
+// so are the memory and work they demand together.
+func validKDF(k *kdfParams) bool {
+	switch k.Name {
+	case "argon2id":
+		return k.Time >= 1 && k.Time <= maxArgon2Time &&
+			k.Threads >= 1 && k.Threads <= maxArgon2Lanes &&
+			k.Memory >= 8*uint32(k.Threads) && k.Memory <= maxArgon2Memory &&
+			k.N == 0 && k.R == 0 && k.P == 0
+	case "scrypt":
+		return k.N > 1 && k.N&(k.N-1) == 0 && bits.Len(uint(k.N))-1 <= maxScryptLogN &&
+			k.R >= 1 && k.R <= maxScryptR && k.P >= 1 && k.P <= maxScryptP &&
+			128*k.N*k.R <= maxScryptMemory && k.N*k.R*k.P <= maxScryptWork &&
+			k.Time == 0 && k.Memory == 0 && k.Threads == 0
+	default:
+		return false
+	}
+}
+
+func deriveKey(k *kdfParams, salt, password []byte) ([]byte, error) {
+	if k.Name == "argon2id" {
+		return argon2.IDKey(password, salt, k.Time, k.Memory, k.Threads, keySize), nil
+	}
+	return scrypt.Key(password, salt, k.N, k.R, k.P, keySize)
+}
+
+func newAEAD(name string, key []byte) (cipher.AEAD, error) {
+	if name == "xchacha20-poly1305" {
+		return chacha20poly1305.NewX(key)
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,151 @@
+package keystore
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+)
+
+var (
+	errInvalid     = errors.New("invalid")
+	errUnsupported = errors.New("unsupported")
+	errDecryption  = errors.New("decryption")
+
+	testFormat = Format{Curve: "test", Invalid: errInvalid, Unsupported: errUnsupported, Decryption: errDecryption}
+)
+
+// lightParams keeps the tests fast; production callers use DefaultParams.
+func lightParams(kdf KDF, c Cipher) Params {
+	params := DefaultParams(kdf, c)
+	if kdf == Scrypt {
+		params.ScryptN = 1 << 10
+	} else {
+		params.Argon2Time, params.Argon2Memory, params.Argon2Threads = 1, 64, 1
+	}
+	return params
+}
+
+func TestSealOpenRoundTrip(t *testing.T) {
+	plaintext := []byte("extended private key payload")
+	meta := Metadata{Network: "mainnet", Fingerprint: "3442193e", Created: 1700000000}
+	for _, kdf := range []KDF{Argon2id, Scrypt} {
+		for _, c := range []Cipher{AES256GCM, XChaCha20Poly1305} {
+			params := lightParams(kdf, c)
+			sealed, err := testFormat.Seal(nil, plaintext, []byte("pw"), meta, params)
+			if err != nil {
+				t.Fatalf("Seal(%d, %d): %v", kdf, c, err)
+			}
+			got, gotMeta, gotParams, err := testFormat.Open(sealed, []byte("pw"))
+			if err != nil || !bytes.Equal(got, plaintext) {
+				t.Fatalf("Open(%d, %d) = %q, %v", kdf, c, got, err)
+			}
+			wantMeta := meta
+			wantMeta.Curve = "test"
+			if gotMeta != wantMeta || gotParams != params {
+				t.Fatalf("Open(%d, %d) metadata = %+v, %+v", kdf, c, gotMeta, gotParams)
+			}
+			if _, _, _, err := testFormat.Open(sealed, []byte("wrong")); !errors.Is(err, errDecryption) {
+				t.Fatalf("wrong password error = %v", err)
+			}
+
+			changed, err := testFormat.ChangePassword(nil, sealed, []byte("pw"), []byte("new"))
+			if err != nil {
+				t.Fatalf("ChangePassword: %v", err)
+			}
+			if _, _, _, err := testFormat.Open(changed, []byte("pw")); !errors.Is(err, errDecryption) {
+				t.Fatalf("old password after change error = %v", err)
+			}
+			got, gotMeta, gotParams, err = testFormat.Open(changed, []byte("new"))
+			if err != nil || !bytes.Equal(got, plaintext) || gotMeta != wantMeta || gotParams != params {
+				t.Fatalf("Open after change = %q, %+v, %v", got, gotMeta, err)
+			}
+		}
+	}
+}
+
+func TestOpenRejectsTampering(t *testing.T) {
+	meta := Metadata{Network: "mainnet", Fingerprint: "3442193e", Created: 1700000000}
+	sealed, err := testFormat.Seal(nil, []byte("secret"), []byte("pw"), meta, lightParams(Argon2id, AES256GCM))
+	if err != nil {
+		t.Fatalf("Seal: %v", err)
+	}
+	edit := func(f func(map[string]any)) []byte {
+		var doc map[string]any
+		if err := json.Unmarshal(sealed, &doc); err != nil {
+			t.Fatal(err)
+		}
+		f(doc)
+		out, err := json.MarshalIndent(doc, "", "  ")
+		if err != nil {
+			t.Fatal(err)
+		}
+		return out
+	}
+	section := func(doc map[string]any, name string) map[string]any { return doc[name].(map[string]any) }
+
+	// Reformatting the JSON does not change the authenticated header.
+	if _, _, _, err := testFormat.Open(edit(func(map[string]any) {}), []byte("pw")); err != nil {
+		t.Fatalf("reformatted Open: %v", err)
+	}
+	for _, tc := range []struct {
+		name string
+		data []byte
+		want error
+	}{
+		{"not json", []byte("{"), errInvalid},
+		{"version", edit(func(d map[string]any) { d["version"] = 2 }), errUnsupported},
+		{"curve", edit(func(d map[string]any) { section(d, "metadata")["curve"] = "other" }), errInvalid},
+		{"network", edit(func(d map[string]any) { section(d, "metadata")["network"] = "testnet" }), errDecryption},
+		{"fingerprint", edit(func(d map[string]any) { section(d, "metadata")["fingerprint"] = "00000000" }), errDecryption},
+		{"created", edit(func(d map[string]any) { section(d, "metadata")["created"] = 1 }), errDecryption},
+		{"kdf name", edit(func(d map[string]any) { section(d, "kdf")["name"] = "pbkdf2" }), errUnsupported},
+		{"kdf cost", edit(func(d map[string]any) { section(d, "kdf")["memory"] = maxArgon2Memory * 2 }), errUnsupported},
+		{"kdf time", edit(func(d map[string]any) { section(d, "kdf")["time"] = 2 }), errDecryption},
+		{"cipher", edit(func(d map[string]any) { section(d, "cipher")["name"] = "xchacha20-poly1305" }), errInvalid},
+		{"salt", edit(func(d map[string]any) { section(d, "kdf")["salt"] = "00" }), errInvalid},
+		{"ciphertext", edit(func(d map[string]any) {
+			ct := d["ciphertext"].(string)
+			d["ciphertext"] = strings.Repeat("0", 2) + ct[2:]
+		}), errDecryption},
+	} {
+		if _, _, _, err := testFormat.Open(tc.data, []byte("pw")); !errors.Is(err, tc.want) {
+			t.Fatalf("%s: error = %v, want %v", tc.name, err, tc.want)
+		}
+	}
+
+	if _, err := testFormat.Seal(nil, nil, nil, meta, Params{}); !errors.Is(err, errUnsupported) {
+		t.Fatalf("zero params error = %v", err)
+	}
+	huge := lightParams(Scrypt, AES256GCM)
+	huge.ScryptN = 1 << 21
+	if _, err := testFormat.Seal(nil, nil, nil, meta, huge); !errors.Is(err, errUnsupported) {
+		t.Fatalf("excessive scrypt cost error = %v", err)
+	}
+}
+
+func TestValidKDFBoundsScryptCost(t *testing.T) {
+	for _, tc := range []struct {
+		n, r, p int
+		want    bool
+	}{
+		{1 << 18, 8, 1, true},   // go-ethereum's standard cost, 256 MiB
+		{1 << 20, 8, 1, true},   // 1 GiB
+		{1 << 20, 32, 1, false}, // 4 GiB, though each parameter is in range
+		{1 << 20, 1, 16, true},
+		{1 << 20, 8, 16, false}, // 2^27 BlockMix calls
+		{1 << 21, 1, 1, false},
+	} {
+		k := kdfParams{Name: "scrypt", N: tc.n, R: tc.r, P: tc.p}
+		if got := validKDF(&k); got != tc.want {
+			t.Fatalf("validKDF(N=%d, r=%d, p=%d) = %v, want %v", tc.n, tc.r, tc.p, got, tc.want)
+		}
+	}
+
+	huge := lightParams(Scrypt, AES256GCM)
+	huge.ScryptN, huge.ScryptR = 1<<20, 32
+	if _, err := testFormat.Seal(nil, nil, nil, Metadata{}, huge); !errors.Is(err, errUnsupported) {
+		t.Fatalf("N=2^20, r=32 error = %v", err)
+	}
+}
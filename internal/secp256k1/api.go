@@ -1,7 +1,8 @@
 // Copyright 2026 The bip32 Authors.
 
-// Package secp256k1 exposes only the curve operations required by BIP-32. It
-// intentionally does not provide signing or general-purpose point APIs.
+// Package secp256k1 exposes only the curve operations required by BIP-32, by
+// ECDH between derived keys, and by Bitcoin message and MuSig2 signatures. It
+// intentionally does not provide general-purpose point APIs.
 package secp256k1
 
 import (
@@ -34,19 +35,43 @@ func AddScalars(parent, tweak *[PrivateKeySize]byte) ([PrivateKeySize]byte, bool
 	return child.Bytes(), true
 }
 
+// SubScalars returns child-tweak mod n, undoing AddScalars. Child must be a
+// valid private key and tweak must be canonical. A zero result is rejected.
+func SubScalars(child, tweak *[PrivateKeySize]byte) ([PrivateKeySize]byte, bool) {
+	var c, t, parent scalar.Element
+	if !c.SetBytes(child) || c.IsZero() || !t.SetBytes(tweak) {
+		return [PrivateKeySize]byte{}, false
+	}
+	parent.Add(&c, t.Neg(&t))
+	if parent.IsZero() {
+		return [PrivateKeySize]byte{}, false
+	}
+	return parent.Bytes(), true
+}
+
 // PublicKeyFromScalar derives a compressed SEC 1 public key using the
 // constant-time fixed-base multiplication path.
 func PublicKeyFromScalar(key *[PrivateKeySize]byte) ([PublicKeySize]byte, bool) {
-	var k scalar.Element
-	if !k.SetBytes(key) || k.IsZero() {
+	p, ok := PublicPointFromScalar(key)
+	if !ok {
 		return [PublicKeySize]byte{}, false
 	}
-	p := scalarBaseMultProjective(&k)
-	x, y, ok := p.affine()
+	return p.Bytes(), true
+}
+
+// ECDH returns the x-coordinate of key*peer, the SEC 1 Diffie-Hellman shared
+// secret, using the constant-time variable-base multiplication path. Key must
+// be a valid private key and peer a canonical compressed point.
+func ECDH(key *[PrivateKeySize]byte, peer *[PublicKeySize]byte) ([32]byte, bool) {
+	p, ok := ParsePublicKey(peer)
 	if !ok {
-		return [PublicKeySize]byte{}, false
+		return [32]byte{}, false
 	}
-	return encodeAffine(&x, &y), true
+	shared, ok := p.ScalarMult(key)
+	if !ok {
+		return [32]byte{}, false
+	}
+	return shared.x.Bytes(), true
 }
 
 // ValidPublicKey reports whether key is a canonical compressed SEC 1 point.
@@ -89,6 +114,21 @@ func AddScalarBase(parent *[PublicKeySize]byte, tweak *[PrivateKeySize]byte) ([P
 	return encodeAffine(&x, &y), true
 }
 
+// AddScalarBaseVarTime returns the same result as AddScalarBase using
+// variable-time GLV/wNAF multiplication. Both inputs must be public; it exists
+// for extended-public-key derivation and must not be used with secret scalars.
+func AddScalarBaseVarTime(parent *[PublicKeySize]byte, tweak *[PrivateKeySize]byte) ([PublicKeySize]byte, bool) {
+	parentPoint, ok := ParsePublicKey(parent)
+	if !ok {
+		return [PublicKeySize]byte{}, false
+	}
+	child, ok := parentPoint.AddScalarBaseVarTime(tweak)
+	if !ok {
+		return [PublicKeySize]byte{}, false
+	}
+	return child.Bytes(), true
+}
+
 func parseCompressed(key *[PublicKeySize]byte) (point, bool) {
 	if key[0] != 0x02 && key[0] != 0x03 {
 		return point{}, false
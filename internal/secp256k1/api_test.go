@@ -36,6 +36,16 @@ func TestPublicKeyAndPointOperations(t *testing.T) {
 	if _, ok := AddScalars(&orderMinusOne, &one); ok {
 		t.Fatal("zero private child accepted")
 	}
+	if got, ok := SubScalars(&one, &two); !ok || got != orderMinusOne {
+		t.Fatalf("1 - 2 = %x, %v", got, ok)
+	}
+	if got, ok := SubScalars(&orderMinusOne, &orderMinusOne); ok {
+		t.Fatalf("zero parent accepted: %x", got)
+	}
+	child, _ := AddScalars(&orderMinusOne, &two)
+	if got, ok := SubScalars(&child, &two); !ok || got != orderMinusOne {
+		t.Fatalf("SubScalars did not undo AddScalars: %x, %v", got, ok)
+	}
 
 	invalidPoint := mustDecode33("020000000000000000000000000000000000000000000000000000000000000007")
 	if ValidPublicKey(&invalidPoint) {
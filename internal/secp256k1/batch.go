@@ -0,0 +1,277 @@
+// Copyright 2026 The bip32 Authors.
+
+package secp256k1
+
+import (
+	"sync"
+
+	"github.com/islishude/bip32/v2/internal/secp256k1/field"
+	"github.com/islishude/bip32/v2/internal/secp256k1/scalar"
+)
+
+const (
+	// combBatchMin is the smallest batch for which AddScalarBaseBatchVarTime
+	// sums comb table entries in affine coordinates. Each level of the
+	// summation pays one field inversion for the whole batch, so smaller
+	// batches are faster with one wNAF multiplication per tweak.
+	combBatchMin = 8
+
+	combWindow    = 8
+	combTableSize = 1 << (combWindow - 1)
+	// combWindows covers 256 bits plus the carry out of the top signed digit.
+	combWindows = 256/combWindow + 1
+)
+
+// combTable returns the multiples 1..128 of 2^(8w)*G for every window w. At
+// about 270 KiB it is too large to ship precomputed for a path only batches
+// use, so it is built on first use, which takes a few milliseconds.
+var combTable = sync.OnceValue(func() *[combWindows][combTableSize]affinePoint {
+	table := new([combWindows][combTableSize]affinePoint)
+	base := generatorAffineTableW5[0][0]
+	row := make([]point, combTableSize)
+	for w := range table {
+		row[0].setAffine(&base.x, &base.y)
+		for j := 1; j < len(row); j++ {
+			row[j].addAffineVarTime(&row[j-1], &base, false)
+		}
+		var next point
+		next.double(&row[len(row)-1])
+		affine, _ := batchAffine(row)
+		for j := range affine {
+			table[w][j] = affinePoint{x: affine[j].x, y: affine[j].y}
+		}
+		base.x, base.y, _ = next.affine()
+	}
+	return table
+})
+
+// AddScalarBaseBatchVarTime returns p + tweaks[i]*G for every tweak. ok[i] is
+// false when tweaks[i] is not canonical or the sum is the point at infinity.
+// Like AddScalarBaseVarTime, it must only be used with public inputs.
+//
+// Large batches avoid doublings altogether: every tweak is cut into signed
+// 8-bit windows, each selecting an entry of the comb table, and p plus those
+// entries are summed pairwise in affine coordinates. The additions of one
+// level across the whole batch share a single field inversion, so a child
+// costs about 33 affine additions and the batch six inversions.
+func (p *PublicPoint) AddScalarBaseBatchVarTime(tweaks [][PrivateKeySize]byte) ([]PublicPoint, []bool) {
+	if !p.valid {
+		return make([]PublicPoint, len(tweaks)), make([]bool, len(tweaks))
+	}
+	if len(tweaks) < combBatchMin {
+		return p.addScalarBaseEachVarTime(tweaks)
+	}
+
+	// terms holds, for each tweak, p and the table entry of every non-zero
+	// window. An entry whose valid flag is false is the point at infinity.
+	const width = combWindows + 1
+	table := combTable()
+	terms := make([]PublicPoint, len(tweaks)*width)
+	lengths := make([]int, len(tweaks))
+	for i := range tweaks {
+		var t scalar.Element
+		if !t.SetBytes(&tweaks[i]) {
+			continue
+		}
+		var digits [combWindows]int16
+		combDigits(&digits, &t)
+		row := terms[i*width : (i+1)*width]
+		row[0] = *p
+		n := 1
+		for w, d := range digits {
+			if d == 0 {
+				continue
+			}
+			q := &table[w][abs16(d)-1]
+			row[n] = PublicPoint{x: q.x, y: q.y, valid: true}
+			if d < 0 {
+				row[n].y.Neg(&q.y)
+			}
+			n++
+		}
+		lengths[i] = n
+	}
+
+	// Each level adds terms 2k and 2k+1 of every row into term 2k, then
+	// compacts the row to half its length.
+	pending := make([]int, 0, len(terms)/2)
+	dx := make([]field.Element, len(terms)/2)
+	prefix := make([]field.Element, len(terms)/2)
+	for {
+		pending = pending[:0]
+		var product field.Element
+		product.SetOne()
+		for i, n := range lengths {
+			for j := i * width; j+1 < i*width+n; j += 2 {
+				a, b := &terms[j], &terms[j+1]
+				switch {
+				case !b.valid:
+				case !a.valid:
+					*a = *b
+				default:
+					k := len(pending)
+					dx[k].Sub(&b.x, &a.x)
+					if dx[k].IsZero() {
+						a.addExceptional(b)
+						continue
+					}
+					prefix[k].Set(&product)
+					product.Mul(&product, &dx[k])
+					pending = append(pending, j)
+				}
+			}
+		}
+
+		if len(pending) > 0 {
+			var inv field.Element
+			inv.Inv(&product)
+			for k := len(pending) - 1; k >= 0; k-- {
+				// inv holds 1/(dx_0*...*dx_k); peel off dx_k using the prefix product.
+				var dxInv field.Element
+				dxInv.Mul(&inv, &prefix[k])
+				inv.Mul(&inv, &dx[k])
+				j := pending[k]
+				terms[j].addAffineWithInverse(&terms[j+1], &dxInv)
+			}
+		}
+
+		done := true
+		for i, n := range lengths {
+			if n <= 1 {
+				continue
+			}
+			row := terms[i*width : i*width+n]
+			for k := 1; 2*k < n; k++ {
+				row[k] = row[2*k]
+			}
+			lengths[i] = (n + 1) / 2
+			done = done && lengths[i] == 1
+		}
+		if done {
+			break
+		}
+	}
+
+	out := make([]PublicPoint, len(tweaks))
+	ok := make([]bool, len(tweaks))
+	for i, n := range lengths {
+		if n == 1 && terms[i*width].valid {
+			out[i] = terms[i*width]
+			ok[i] = true
+		}
+	}
+	return out, ok
+}
+
+// addScalarBaseEachVarTime is AddScalarBaseBatchVarTime for small batches:
+// one wNAF multiplication per tweak, with the results normalized by a single
+// field inversion.
+func (p *PublicPoint) addScalarBaseEachVarTime(tweaks [][PrivateKeySize]byte) ([]PublicPoint, []bool) {
+	var parent point
+	parent.setAffine(&p.x, &p.y)
+	points := make([]point, len(tweaks))
+	for i := range tweaks {
+		var t scalar.Element
+		if !t.SetBytes(&tweaks[i]) {
+			points[i].setInfinity()
+			continue
+		}
+		tweakPoint := scalarBaseMultVarTime(&t)
+		points[i].add(&parent, &tweakPoint)
+	}
+	return batchAffine(points)
+}
+
+// combDigits writes the signed 8-bit windows of k, least significant first.
+// Every digit lies in [-128, 128], so it selects an entry of the comb table or
+// its negation.
+func combDigits(out *[combWindows]int16, k *scalar.Element) {
+	words := k.Words()
+	var carry uint64
+	for i := range out {
+		var value uint64
+		if i < combWindows-1 {
+			value = uint64(fixedWindowDigit(&words, uint(i), combWindow))
+		}
+		value += carry
+		carry = (value + (1 << (combWindow - 1))) >> combWindow
+		out[i] = int16(int64(value) - int64(carry<<combWindow))
+	}
+}
+
+// addAffineWithInverse assigns p = p + q given dxInv = 1/(q.x - p.x), which
+// must be non-zero. Both points must be finite.
+func (p *PublicPoint) addAffineWithInverse(q *PublicPoint, dxInv *field.Element) {
+	var lambda, x3, y3 field.Element
+	lambda.Sub(&q.y, &p.y)
+	lambda.Mul(&lambda, dxInv)
+	x3.Square(&lambda)
+	x3.Sub(&x3, &p.x)
+	x3.Sub(&x3, &q.x)
+	y3.Sub(&p.x, &x3)
+	y3.Mul(&y3, &lambda)
+	y3.Sub(&y3, &p.y)
+	p.x, p.y = x3, y3
+}
+
+// addExceptional assigns p = p + q when p and q share an x coordinate, so the
+// sum is either a doubling or the point at infinity.
+func (p *PublicPoint) addExceptional(q *PublicPoint) {
+	if !p.y.Equal(&q.y) {
+		*p = PublicPoint{}
+		return
+	}
+	var r point
+	r.setAffine(&p.x, &p.y)
+	r.double(&r)
+	x, y, ok := r.affine()
+	*p = PublicPoint{x: x, y: y, valid: ok}
+}
+
+// batchAffine converts Jacobian points to affine coordinates with Montgomery's
+// simultaneous-inversion trick: one inversion of the product of all Z
+// coordinates, then a few multiplications per point to unwind it. Points at
+// infinity are skipped and reported as false.
+func batchAffine(points []point) ([]PublicPoint, []bool) {
+	out := make([]PublicPoint, len(points))
+	ok := make([]bool, len(points))
+	prefix := make([]field.Element, len(points))
+	var acc field.Element
+	acc.SetOne()
+	for i := range points {
+		prefix[i].Set(&acc)
+		if points[i].isInfinity() {
+			continue
+		}
+		acc.Mul(&acc, &points[i].z)
+	}
+	if acc.IsZero() {
+		return out, ok
+	}
+
+	var inv field.Element
+	inv.Inv(&acc)
+	for i := len(points) - 1; i >= 0; i-- {
+		if points[i].isInfinity() {
+			continue
+		}
+		// inv holds 1/(z_0*...*z_i); peel off z_i using the prefix product.
+		var zInv, z2, z3 field.Element
+		zInv.Mul(&inv, &prefix[i])
+		inv.Mul(&inv, &points[i].z)
+		z2.Square(&zInv)
+		z3.Mul(&z2, &zInv)
+		out[i].x.Mul(&points[i].x, &z2)
+		out[i].y.Mul(&points[i].y, &z3)
+		out[i].valid = true
+		ok[i] = true
+	}
+	return out, ok
+}
+
+func abs16(d int16) int {
+	if d < 0 {
+		return -int(d)
+	}
+	return int(d)
+}
@@ -0,0 +1,87 @@
+package secp256k1
+
+import "testing"
+
+func TestAddScalarBaseBatchVarTimeMatchesSingle(t *testing.T) {
+	generator := mustDecode33("0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798")
+	negGenerator := mustDecode33("0379be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798")
+	order := mustDecode32("fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364141")
+	tweaks := [][32]byte{
+		scalarBytes(0),
+		// With a generator parent, 1 doubles the running sum in the first
+		// window; with a negated one, 33 passes through infinity.
+		scalarBytes(1),
+		scalarBytes(33),
+		// n-1 cancels the generator parent and yields infinity.
+		mustDecode32("fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364140"),
+		order,
+		scalarBytes(7),
+	}
+	state := uint64(0x452821e638d01377)
+	for range 32 {
+		var tweak [32]byte
+		for i := range tweak {
+			state = state*6364136223846793005 + 1442695040888963407
+			tweak[i] = byte(state >> 56)
+		}
+		tweaks = append(tweaks, tweak)
+	}
+
+	for _, parent := range [][33]byte{generator, negGenerator} {
+		parentPoint, ok := ParsePublicKey(&parent)
+		if !ok {
+			t.Fatal("ParsePublicKey failed")
+		}
+		// The short batch takes the per-tweak path, the full one the comb.
+		for _, batch := range [][][32]byte{tweaks[:combBatchMin-1], tweaks} {
+			got, ok := parentPoint.AddScalarBaseBatchVarTime(batch)
+			if len(got) != len(batch) || len(ok) != len(batch) {
+				t.Fatalf("batch returned %d results for %d tweaks", len(got), len(batch))
+			}
+			for i := range batch {
+				want, wantOK := AddScalarBaseVarTime(&parent, &batch[i])
+				if ok[i] != wantOK || got[i].Bytes() != want {
+					t.Fatalf("batch of %d [%d] = %x, %v; want %x, %v", len(batch), i, got[i].Bytes(), ok[i], want, wantOK)
+				}
+			}
+			if ok[4] {
+				t.Fatal("non-canonical tweak reported as valid")
+			}
+			if parent == generator && ok[3] {
+				t.Fatal("infinity reported as valid")
+			}
+		}
+	}
+
+	var invalid PublicPoint
+	if _, ok := invalid.AddScalarBaseBatchVarTime(tweaks); ok[0] || ok[1] {
+		t.Fatal("invalid parent accepted")
+	}
+	parentPoint, _ := ParsePublicKey(&generator)
+	if got, ok := parentPoint.AddScalarBaseBatchVarTime(nil); len(got) != 0 || len(ok) != 0 {
+		t.Fatal("empty batch returned results")
+	}
+}
+
+func BenchmarkAddScalarBaseBatchVarTime(b *testing.B) {
+	generator := mustDecode33("0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798")
+	parent, _ := ParsePublicKey(&generator)
+	tweaks := make([][32]byte, 100)
+	state := uint64(0x13198a2e03707344)
+	for i := range tweaks {
+		for j := range tweaks[i] {
+			state = state*6364136223846793005 + 1442695040888963407
+			tweaks[i][j] = byte(state >> 56)
+		}
+	}
+	b.Run("Each", func(b *testing.B) {
+		for b.Loop() {
+			parent.addScalarBaseEachVarTime(tweaks)
+		}
+	})
+	b.Run("Comb", func(b *testing.B) {
+		for b.Loop() {
+			parent.AddScalarBaseBatchVarTime(tweaks)
+		}
+	})
+}
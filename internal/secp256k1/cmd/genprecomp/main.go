@@ -1,4 +1,5 @@
-// Command genprecomp generates the fixed-window secp256k1 base-point table.
+// Command genprecomp generates the fixed-window secp256k1 base-point table and
+// the odd-multiple tables used by variable-time public wNAF multiplication.
 package main
 
 import (
@@ -15,6 +16,11 @@ const (
 	baseWindow  = 5
 	baseWindows = 52
 	tableSize   = 16
+
+	wnafWindow    = 8
+	wnafTableSize = 1 << (wnafWindow - 2)
+	wnafChunkBits = 16
+	wnafChunks    = 128 / wnafChunkBits
 )
 
 var (
@@ -51,12 +57,24 @@ func main() {
 		}
 		body.WriteString("\t},\n")
 	}
+	body.WriteString("}\n\n")
+	fmt.Fprintf(&body, "var generatorOddMultiplesW%dWords = [%d][%d][8]uint64{\n", wnafWindow, wnafChunks, wnafTableSize)
+	for _, chunk := range buildOddMultiples() {
+		body.WriteString("\t{\n")
+		for _, point := range chunk {
+			x := montgomeryWords(point.x)
+			y := montgomeryWords(point.y)
+			fmt.Fprintf(&body, "\t\t{%#x, %#x, %#x, %#x, %#x, %#x, %#x, %#x},\n",
+				x[0], x[1], x[2], x[3], y[0], y[1], y[2], y[3])
+		}
+		body.WriteString("\t},\n")
+	}
 	body.WriteString("}\n")
 	dataHash := sha256.Sum256(body.Bytes())
 
 	var output bytes.Buffer
 	output.WriteString("// Code generated by cmd/genprecomp; DO NOT EDIT.\n")
-	fmt.Fprintf(&output, "// Parameters: base-window=%d.\n", baseWindow)
+	fmt.Fprintf(&output, "// Parameters: base-window=%d, wnaf-window=%d, wnaf-chunk-bits=%d.\n", baseWindow, wnafWindow, wnafChunkBits)
 	fmt.Fprintf(&output, "// Generator source SHA-256: %x.\n", sourceHash)
 	fmt.Fprintf(&output, "// Table data SHA-256: %x.\n\n", dataHash)
 	output.WriteString("package secp256k1\n\n")
@@ -86,6 +104,25 @@ func buildTable() [baseWindows][tableSize]affinePoint {
 	return table
 }
 
+// buildOddMultiples returns B, 3B, 5B, ... for signed wNAF digits, where B is
+// 2^(wnafChunkBits*j)*G for chunk j.
+func buildOddMultiples() [wnafChunks][wnafTableSize]affinePoint {
+	var table [wnafChunks][wnafTableSize]affinePoint
+	base := clone(generator)
+	for j := range table {
+		twice := double(base)
+		current := clone(base)
+		for i := range table[j] {
+			table[j][i] = clone(current)
+			current = add(current, twice)
+		}
+		for range wnafChunkBits {
+			base = double(base)
+		}
+	}
+	return table
+}
+
 func add(p, q affinePoint) affinePoint {
 	if p.infinity {
 		return clone(q)
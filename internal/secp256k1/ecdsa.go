@@ -0,0 +1,197 @@
+// Copyright 2026 The bip32 Authors.
+
+package secp256k1
+
+import (
+	"math/bits"
+
+	"github.com/islishude/bip32/v2/internal/secp256k1/field"
+	"github.com/islishude/bip32/v2/internal/secp256k1/scalar"
+)
+
+// SignatureSize is the byte length of a compact r || s ECDSA or BIP-340
+// signature.
+const SignatureSize = 64
+
+// SignECDSA signs a 32-byte message hash with a deterministic RFC 6979 nonce.
+// A non-nil extra is mixed into the nonce, which is how Bitcoin Core grinds for
+// low-R signatures. The signature is r || s with s normalized to the lower
+// half of the order, and recoveryID lets RecoverECDSA find the public key. The
+// nonce multiplication and all secret-dependent arithmetic are constant time.
+func SignECDSA(key, hash *[PrivateKeySize]byte, extra *[32]byte) (sig [SignatureSize]byte, recoveryID byte, ok bool) {
+	var d, z scalar.Element
+	if !d.SetBytes(key) || d.IsZero() {
+		return sig, 0, false
+	}
+	z.SetBytesReduced(hash)
+	h1 := z.Bytes()
+
+	var extraBytes []byte
+	if extra != nil {
+		extraBytes = extra[:]
+	}
+	nonces := newRFC6979(key, &h1, extraBytes)
+	defer nonces.wipe()
+	for {
+		candidate := nonces.next()
+		var k scalar.Element
+		if !k.SetBytes(&candidate) || k.IsZero() {
+			continue
+		}
+		clear(candidate[:])
+
+		R := scalarBaseMultProjective(&k)
+		x, y, _ := R.affine() // k is non-zero, so R is not infinity.
+		xBytes := x.Bytes()
+		var r scalar.Element
+		r.SetBytesReduced(&xBytes)
+		if r.IsZero() {
+			continue
+		}
+		recoveryID = 0
+		if y.IsOdd() {
+			recoveryID |= 1
+		}
+		if !scalar.LessThanOrder(&xBytes) {
+			recoveryID |= 2
+		}
+
+		// s = k^-1 * (z + r*d).
+		var s, kInv scalar.Element
+		kInv.Inv(&k)
+		s.Mul(&r, &d)
+		s.Add(&s, &z)
+		s.Mul(&s, &kInv)
+		if s.IsZero() {
+			continue
+		}
+		if s.IsHigh() {
+			s.Neg(&s)
+			recoveryID ^= 1
+		}
+
+		rBytes, sBytes := r.Bytes(), s.Bytes()
+		copy(sig[:32], rBytes[:])
+		copy(sig[32:], sBytes[:])
+		return sig, recoveryID, true
+	}
+}
+
+// VerifyECDSA reports whether sig is a valid r || s signature of hash by pub.
+// Like libsecp256k1 it rejects signatures whose s is in the upper half of the
+// order, which SignECDSA never produces.
+func VerifyECDSA(pub *[PublicKeySize]byte, hash *[32]byte, sig *[SignatureSize]byte) bool {
+	q, ok := ParsePublicKey(pub)
+	if !ok {
+		return false
+	}
+	r, s, ok := parseSignatureScalars(sig)
+	if !ok || s.IsHigh() {
+		return false
+	}
+	var z, sInv, u1, u2 scalar.Element
+	z.SetBytesReduced(hash)
+	sInv.Inv(&s)
+	u1.Mul(&z, &sInv)
+	u2.Mul(&r, &sInv)
+
+	R := linearCombination(&u1, &u2, &q)
+	x, _, ok := R.affine()
+	if !ok {
+		return false
+	}
+	xBytes := x.Bytes()
+	var xr scalar.Element
+	xr.SetBytesReduced(&xBytes)
+	return xr.Equal(&r)
+}
+
+// RecoverECDSA returns the public key that produced sig over hash, given the
+// recovery ID returned by SignECDSA. High-s signatures are accepted, as in
+// Bitcoin's signed-message recovery.
+func RecoverECDSA(hash *[32]byte, sig *[SignatureSize]byte, recoveryID byte) (PublicPoint, bool) {
+	if recoveryID > 3 {
+		return PublicPoint{}, false
+	}
+	r, s, ok := parseSignatureScalars(sig)
+	if !ok {
+		return PublicPoint{}, false
+	}
+
+	xBytes := [32]byte(sig[:32])
+	if recoveryID&2 != 0 {
+		// R.x was r + n; this is only possible when r + n < p.
+		words := bytesToWordsBE(&xBytes)
+		orderWords := bytesToWordsBE(&scalar.Order)
+		var carry uint64
+		for i := range words {
+			words[i], carry = bits.Add64(words[i], orderWords[i], carry)
+		}
+		if carry != 0 {
+			return PublicPoint{}, false
+		}
+		xBytes = wordsToBytesBE(words)
+		if !field.LessThanModulus(&xBytes) {
+			return PublicPoint{}, false
+		}
+	}
+	rx, ry, ok := affineFromXBytes(&xBytes, recoveryID&1 != 0)
+	if !ok {
+		return PublicPoint{}, false
+	}
+
+	// Q = r^-1 * (s*R - z*G).
+	var z, rInv, u1, u2 scalar.Element
+	z.SetBytesReduced(hash)
+	rInv.Inv(&r)
+	u1.Mul(&z, &rInv)
+	u1.Neg(&u1)
+	u2.Mul(&s, &rInv)
+	Q := linearCombination(&u1, &u2, &PublicPoint{x: rx, y: ry, valid: true})
+	x, y, ok := Q.affine()
+	if !ok {
+		return PublicPoint{}, false
+	}
+	return PublicPoint{x: x, y: y, valid: true}, true
+}
+
+// linearCombination returns a*G + b*q. It is used for verification, where all
+// inputs are public, but it is built from the constant-time multiplications
+// so that only one set of point formulas has to be trusted.
+func linearCombination(a, b *scalar.Element, q *PublicPoint) projectivePoint {
+	left := scalarBaseMultProjective(a)
+	p := projectivePoint{x: q.x, y: q.y}
+	p.z.SetOne()
+	right := scalarMultProjective(&p, b)
+	var sum projectivePoint
+	sum.addComplete(&left, &right)
+	return sum
+}
+
+func parseSignatureScalars(sig *[SignatureSize]byte) (r, s scalar.Element, ok bool) {
+	rBytes := [32]byte(sig[:32])
+	sBytes := [32]byte(sig[32:])
+	if !r.SetBytes(&rBytes) || r.IsZero() || !s.SetBytes(&sBytes) || s.IsZero() {
+		return r, s, false
+	}
+	return r, s, true
+}
+
+func bytesToWordsBE(b *[32]byte) [4]uint64 {
+	var words [4]uint64
+	for i := range words {
+		for j := range 8 {
+			words[i] |= uint64(b[31-8*i-j]) << (8 * j)
+		}
+	}
+	return words
+}
+
+func wordsToBytesBE(words [4]uint64) (out [32]byte) {
+	for i := range words {
+		for j := range 8 {
+			out[31-8*i-j] = byte(words[i] >> (8 * j))
+		}
+	}
+	return out
+}
@@ -0,0 +1,159 @@
+package secp256k1
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+)
+
+// ecdsaVectors were produced by btcec's RFC 6979 compact signer, which uses
+// no extra nonce data.
+var ecdsaVectors = []struct {
+	key, hash, sig string
+	recoveryID     byte
+}{
+	{"0000000000000000000000000000000000000000000000000000000000000001", "af2bdbe1aa9b6ec1e2ade1d694f41fc71a831d0268e9891562113d8a62add1bf", "58db657bcd631038bea07b4941172f0167aca98f12b55e3176bd1c35435d65013a78e73d8ff8ab554e13c10f6390d81a882f91945d6275493882676170b53a57", 1},
+	{"0000000000000000000000000000000000000000000000000000000000000001", "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855", "77c8d336572f6f466055b5f70f433851f8f535f6c4fc71133a6cfd71079d03b70ed9f5eb8aa5b266abac35d416c3207e7a538bf5f37649727d7a9823b1069577", 1},
+	{"c9afa9d845ba75166b5c215767b1d6934e50c3db36e89b127b8a622b120f6721", "af2bdbe1aa9b6ec1e2ade1d694f41fc71a831d0268e9891562113d8a62add1bf", "432310e32cb80eb6503a26ce83cc165c783b870845fb8aad6d970889fcd7a6c8530128b6b81c548874a6305d93ed071ca6e05074d85863d4056ce89b02bfab69", 0},
+	{"c9afa9d845ba75166b5c215767b1d6934e50c3db36e89b127b8a622b120f6721", "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08", "f2adcea7139057be6409855ee96d008e0e5b5f532333ec17448e26a36f47bcb2570c9d342779b40f513c0d75cbf93e3f3de7b01f6593f17bfc2ee87151414d64", 0},
+	{"fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364140", "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08", "65b79d53819915fe61f7f57d82134a73386e3f7fd0c791232f26fc1b942991e1093a2c182134be3c4f39ac1f06ada089fcfcdd5d50f116bcfdc9e5e76e22a026", 1},
+	{"fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364140", "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855", "ea045bf0962ecc4d5aa84c8e716c87c9d5f49fba8e1ff0300ab2631de3d83b4351270ec8105346fddf35da5958d99ff55a0c0f720d6ae7f3e3eadd40a9ccfe0e", 1},
+}
+
+func TestECDSAVectors(t *testing.T) {
+	for _, v := range ecdsaVectors {
+		key := mustDecode32(v.key)
+		hash := mustDecode32(v.hash)
+		sig, recoveryID, ok := SignECDSA(&key, &hash, nil)
+		if !ok || hex.EncodeToString(sig[:]) != v.sig || recoveryID != v.recoveryID {
+			t.Fatalf("SignECDSA(%s, %s) = %x/%d, want %s/%d", v.key, v.hash, sig, recoveryID, v.sig, v.recoveryID)
+		}
+		pub, _ := PublicKeyFromScalar(&key)
+		if !VerifyECDSA(&pub, &hash, &sig) {
+			t.Fatalf("VerifyECDSA rejected the signature for %s", v.key)
+		}
+		recovered, ok := RecoverECDSA(&hash, &sig, recoveryID)
+		if !ok || recovered.Bytes() != pub {
+			t.Fatalf("RecoverECDSA = %x, %v; want %x", recovered.Bytes(), ok, pub)
+		}
+		if other, ok := RecoverECDSA(&hash, &sig, recoveryID^1); ok && other.Bytes() == pub {
+			t.Fatal("wrong recovery ID recovered the signer")
+		}
+
+		hash[0] ^= 1
+		if VerifyECDSA(&pub, &hash, &sig) {
+			t.Fatal("VerifyECDSA accepted a signature over a different hash")
+		}
+	}
+}
+
+func TestECDSAExtraNonceAndHighS(t *testing.T) {
+	key := mustDecode32("c9afa9d845ba75166b5c215767b1d6934e50c3db36e89b127b8a622b120f6721")
+	hash := mustDecode32("af2bdbe1aa9b6ec1e2ade1d694f41fc71a831d0268e9891562113d8a62add1bf")
+	pub, _ := PublicKeyFromScalar(&key)
+	plain, _, _ := SignECDSA(&key, &hash, nil)
+	extra := [32]byte{0: 1}
+	sig, recoveryID, ok := SignECDSA(&key, &hash, &extra)
+	if !ok || sig == plain || !VerifyECDSA(&pub, &hash, &sig) {
+		t.Fatal("extra nonce data did not produce a different valid signature")
+	}
+
+	// Flipping s to n-s keeps the signature mathematically valid but high.
+	order := mustDecode32("fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364141")
+	var s, n, high [32]byte
+	copy(s[:], sig[32:])
+	copy(n[:], order[:])
+	var borrow int
+	for i := 31; i >= 0; i-- {
+		d := int(n[i]) - int(s[i]) - borrow
+		borrow = 0
+		if d < 0 {
+			d += 256
+			borrow = 1
+		}
+		high[i] = byte(d)
+	}
+	highSig := sig
+	copy(highSig[32:], high[:])
+	if VerifyECDSA(&pub, &hash, &highSig) {
+		t.Fatal("VerifyECDSA accepted a high-s signature")
+	}
+	if recovered, ok := RecoverECDSA(&hash, &highSig, recoveryID^1); !ok || recovered.Bytes() != pub {
+		t.Fatal("RecoverECDSA rejected a high-s signature")
+	}
+}
+
+// bip340Vectors are test vectors 0-3 and 5-7 from BIP-340.
+var bip340Vectors = []struct {
+	key, pub, aux, msg, sig string
+	valid                   bool
+}{
+	{"0000000000000000000000000000000000000000000000000000000000000003", "F9308A019258C31049344F85F89D5229B531C845836F99B08601F113BCE036F9", "0000000000000000000000000000000000000000000000000000000000000000", "0000000000000000000000000000000000000000000000000000000000000000", "E907831F80848D1069A5371B402410364BDF1C5F8307B0084C55F1CE2DCA821525F66A4A85EA8B71E482A74F382D2CE5EBEEE8FDB2172F477DF4900D310536C0", true},
+	{"B7E151628AED2A6ABF7158809CF4F3C762E7160F38B4DA56A784D9045190CFEF", "DFF1D77F2A671C5F36183726DB2341BE58FEAE1DA2DECED843240F7B502BA659", "0000000000000000000000000000000000000000000000000000000000000001", "243F6A8885A308D313198A2E03707344A4093822299F31D0082EFA98EC4E6C89", "6896BD60EEAE296DB48A229FF71DFE071BDE413E6D43F917DC8DCF8C78DE33418906D11AC976ABCCB20B091292BFF4EA897EFCB639EA871CFA95F6DE339E4B0A", true},
+	{"C90FDAA22168C234C4C6628B80DC1CD129024E088A67CC74020BBEA63B14E5C9", "DD308AFEC5777E13121FA72B9CC1B7CC0139715309B086C960E18FD969774EB8", "C87AA53824B4D7AE2EB035A2B5BBBCCC080E76CDC6D1692C4B0B62D798E6D906", "7E2D58D8B3BCDF1ABADEC7829054F90DDA9805AAB56C77333024B9D0A508B75C", "5831AAEED7B44BB74E5EAB94BA9D4294C49BCF2A60728D8B4C200F50DD313C1BAB745879A5AD954A72C45A91C3A51D3C7ADEA98D82F8481E0E1E03674A6F3FB7", true},
+	{"0B432B2677937381AEF05BB02A66ECD012773062CF3FA2549E44F58ED2401710", "25D1DFF95105F5253C4022F628A996AD3A0D95FBF21D468A1B33F8C160D8F517", "FFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFF", "FFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFF", "7EB0509757E246F19449885651611CB965ECC1A187DD51B64FDA1EDC9637D5EC97582B9CB13DB3933705B32BA982AF5AF25FD78881EBB32771FC5922EFC66EA3", true},
+	{"", "EEFDEA4CDB677750A420FEE807EACF21EB9898AE79B9768766E4FAA04A2D4A34", "", "243F6A8885A308D313198A2E03707344A4093822299F31D0082EFA98EC4E6C89", "6CFF5C3BA86C69EA4B7376F31A9BCB4F74C1976089B2D9963DA2E5543E17776969E89B4C5564D00349106B8497785DD7D1D713A8AE82B32FA79D5F7FC407D39B", false},
+	{"", "DFF1D77F2A671C5F36183726DB2341BE58FEAE1DA2DECED843240F7B502BA659", "", "243F6A8885A308D313198A2E03707344A4093822299F31D0082EFA98EC4E6C89", "FFF97BD5755EEEA420453A14355235D382F6472F8568A18B2F057A14602975563CC27944640AC607CD107AE10923D9EF7A73C643E166BE5EBEAFA34B1AC553E2", false},
+	{"", "DFF1D77F2A671C5F36183726DB2341BE58FEAE1DA2DECED843240F7B502BA659", "", "243F6A8885A308D313198A2E03707344A4093822299F31D0082EFA98EC4E6C89", "1FA62E331EDBC21C394792D2AB1100A7B432B013DF3F6FF4F99FCB33E0E1515F28890B3EDB6E7189B630448B515CE4F8622A954CFE545735AAEA5134FCCDB2BD", false},
+}
+
+func TestBIP340Vectors(t *testing.T) {
+	for i, v := range bip340Vectors {
+		pub := mustDecode32(strings.ToLower(v.pub))
+		msg := mustDecode32(strings.ToLower(v.msg))
+		var sig [SignatureSize]byte
+		decoded, _ := hex.DecodeString(v.sig)
+		copy(sig[:], decoded)
+
+		if v.key != "" {
+			key := mustDecode32(strings.ToLower(v.key))
+			aux := mustDecode32(strings.ToLower(v.aux))
+			got, ok := SignSchnorr(&key, &msg, &aux)
+			if !ok || got != sig {
+				t.Fatalf("vector %d: SignSchnorr = %X, want %s", i, got, v.sig)
+			}
+			compressed, _ := PublicKeyFromScalar(&key)
+			if [32]byte(compressed[1:]) != pub {
+				t.Fatalf("vector %d: x-only public key mismatch", i)
+			}
+		}
+		if got := VerifySchnorr(&pub, &msg, &sig); got != v.valid {
+			t.Fatalf("vector %d: VerifySchnorr = %v, want %v", i, got, v.valid)
+		}
+	}
+}
+
+func TestTaprootTweakMatchesOutputKey(t *testing.T) {
+	// BIP-86 test vector: first receiving address of the abandon... mnemonic.
+	internal := mustDecode32("cc8a4bc64d897bddc5fbc2f670f7a8ba0b386779106cf1223c6fc5d7cd6fc115")
+	want := mustDecode32("a60869f0dbcf1dc659c9cecbaf8050135ea9e8cdc487053f1dc6880949dc684c")
+	got, _, ok := TaprootOutputKey(&internal, nil)
+	if !ok || got != want {
+		t.Fatalf("TaprootOutputKey = %x, want %x", got, want)
+	}
+
+	key := mustDecode32("c9afa9d845ba75166b5c215767b1d6934e50c3db36e89b127b8a622b120f6721")
+	pub, _ := PublicKeyFromScalar(&key)
+	outputKey, _, ok := TaprootOutputKey((*[32]byte)(pub[1:]), nil)
+	if !ok {
+		t.Fatal("TaprootOutputKey failed")
+	}
+	tweaked, ok := TaprootTweakPrivateKey(&key, nil)
+	if !ok {
+		t.Fatal("TaprootTweakPrivateKey failed")
+	}
+	tweakedPub, _ := PublicKeyFromScalar(&tweaked)
+	if [32]byte(tweakedPub[1:]) != outputKey {
+		t.Fatal("tweaked private key does not match the output key")
+	}
+}
+
+func BenchmarkSignECDSA(b *testing.B) {
+	key := mustDecode32("c9afa9d845ba75166b5c215767b1d6934e50c3db36e89b127b8a622b120f6721")
+	hash := mustDecode32("af2bdbe1aa9b6ec1e2ade1d694f41fc71a831d0268e9891562113d8a62add1bf")
+	for b.Loop() {
+		if _, _, ok := SignECDSA(&key, &hash, nil); !ok {
+			b.Fatal("SignECDSA failed")
+		}
+	}
+}
@@ -0,0 +1,379 @@
+// Copyright 2026 The bip32 Authors.
+
+package secp256k1
+
+import (
+	"encoding/binary"
+
+	"github.com/islishude/bip32/v2/internal/secp256k1/field"
+	"github.com/islishude/bip32/v2/internal/secp256k1/scalar"
+)
+
+// BIP-327 MuSig2 encoding sizes.
+const (
+	MuSig2PubNonceSize = 2 * PublicKeySize
+	MuSig2SecNonceSize = 2*PrivateKeySize + PublicKeySize
+)
+
+// KeyAggContext is a BIP-327 key aggregation context: the aggregate point Q
+// of an ordered list of public keys, together with the accumulated sign gacc
+// and tweak tacc of any tweaks applied to it. The zero value is not valid.
+type KeyAggContext struct {
+	q          PublicPoint
+	gacc, tacc scalar.Element
+
+	pubs      [][PublicKeySize]byte
+	keysHash  [32]byte
+	secondKey [PublicKeySize]byte
+}
+
+// KeyAgg aggregates an ordered list of compressed public keys. When a key
+// fails to decode, its index is returned with ok = false; an aggregate at
+// infinity returns -1.
+func KeyAgg(pubs [][PublicKeySize]byte) (ctx KeyAggContext, bad int, ok bool) {
+	if len(pubs) == 0 {
+		return ctx, -1, false
+	}
+	ctx.pubs = append([][PublicKeySize]byte(nil), pubs...)
+	list := make([]byte, 0, len(pubs)*PublicKeySize)
+	for i := range pubs {
+		list = append(list, pubs[i][:]...)
+	}
+	ctx.keysHash = TaggedHash("KeyAgg list", list)
+	// The second distinct key gets coefficient 1, saving one multiplication
+	// per signer. All zero bytes never match a valid key.
+	for i := range pubs[1:] {
+		if pubs[i+1] != pubs[0] {
+			ctx.secondKey = pubs[i+1]
+			break
+		}
+	}
+
+	var sum projectivePoint
+	sum.setInfinity()
+	for i := range pubs {
+		p, ok := parseCompressed(&pubs[i])
+		if !ok {
+			return KeyAggContext{}, i, false
+		}
+		a := ctx.coefficient(&pubs[i])
+		term := scalarMultProjective(affineProjective(&p.x, &p.y), &a)
+		sum.addComplete(&sum, &term)
+	}
+	x, y, ok := sum.affine()
+	if !ok {
+		return KeyAggContext{}, -1, false
+	}
+	ctx.q = PublicPoint{x: x, y: y, valid: true}
+	ctx.gacc = scalarOne()
+	return ctx, -1, true
+}
+
+// coefficient returns KeyAggCoeff for pub, which must be one of the
+// aggregated keys.
+func (c *KeyAggContext) coefficient(pub *[PublicKeySize]byte) scalar.Element {
+	if *pub == c.secondKey {
+		return scalarOne()
+	}
+	h := TaggedHash("KeyAgg coefficient", c.keysHash[:], pub[:])
+	var a scalar.Element
+	a.SetBytesReduced(&h)
+	return a
+}
+
+func (c *KeyAggContext) contains(pub *[PublicKeySize]byte) bool {
+	for i := range c.pubs {
+		if c.pubs[i] == *pub {
+			return true
+		}
+	}
+	return false
+}
+
+// ApplyTweak adds tweak*G to the aggregate key. An x-only tweak first
+// negates Q when its y-coordinate is odd, as BIP-341 taproot tweaking
+// requires; a plain tweak is ordinary point addition, as in BIP-32 normal
+// derivation. It fails for a non-canonical tweak or an infinite result, in
+// which case c is unchanged.
+func (c *KeyAggContext) ApplyTweak(tweak *[32]byte, xOnly bool) bool {
+	var t scalar.Element
+	if !c.q.valid || !t.SetBytes(tweak) {
+		return false
+	}
+	q := c.q
+	negate := xOnly && q.y.IsOdd()
+	if negate {
+		q.y.Neg(&q.y)
+	}
+	one := scalarOne()
+	sum := linearCombination(&t, &one, &q)
+	x, y, ok := sum.affine()
+	if !ok {
+		return false
+	}
+	c.q = PublicPoint{x: x, y: y, valid: true}
+	if negate {
+		c.gacc.Neg(&c.gacc)
+		c.tacc.Neg(&c.tacc)
+	}
+	c.tacc.Add(&c.tacc, &t)
+	return true
+}
+
+// PublicKey returns the compressed aggregate key with all tweaks applied.
+func (c *KeyAggContext) PublicKey() [PublicKeySize]byte {
+	return c.q.Bytes()
+}
+
+// XOnlyPublicKey returns the BIP-340 x-only aggregate key.
+func (c *KeyAggContext) XOnlyPublicKey() [XOnlyPublicKeySize]byte {
+	return c.q.x.Bytes()
+}
+
+// MuSig2NonceGen runs BIP-327 NonceGen with 32 bytes of fresh randomness.
+// The secret key, aggregate key, message and extra input are optional and
+// only strengthen the nonce against a weak random source; msg is treated as
+// absent when nil. The secret nonce embeds pk so that signing can check it
+// is used with the matching key.
+func MuSig2NonceGen(rand *[32]byte, sk *[PrivateKeySize]byte, pk *[PublicKeySize]byte, aggpk *[XOnlyPublicKeySize]byte, msg, extra []byte) (secnonce [MuSig2SecNonceSize]byte, pubnonce [MuSig2PubNonceSize]byte, ok bool) {
+	seed := *rand
+	defer clear(seed[:])
+	if sk != nil {
+		mask := TaggedHash("MuSig/aux", rand[:])
+		for i := range seed {
+			seed[i] = sk[i] ^ mask[i]
+		}
+	}
+	var aggpkBytes []byte
+	if aggpk != nil {
+		aggpkBytes = aggpk[:]
+	}
+	msgPrefixed := []byte{0}
+	if msg != nil {
+		msgPrefixed = binary.BigEndian.AppendUint64([]byte{1}, uint64(len(msg)))
+		msgPrefixed = append(msgPrefixed, msg...)
+	}
+	extraLen := binary.BigEndian.AppendUint32(nil, uint32(len(extra)))
+
+	for i := range 2 {
+		h := TaggedHash("MuSig/nonce", seed[:], []byte{PublicKeySize}, pk[:], []byte{byte(len(aggpkBytes))}, aggpkBytes, msgPrefixed, extraLen, extra, []byte{byte(i)})
+		var k scalar.Element
+		k.SetBytesReduced(&h)
+		clear(h[:])
+		if k.IsZero() {
+			clear(secnonce[:])
+			return secnonce, pubnonce, false
+		}
+		R := scalarBaseMultProjective(&k)
+		x, y, _ := R.affine()
+		encoded := encodeAffine(&x, &y)
+		copy(pubnonce[i*PublicKeySize:], encoded[:])
+		kBytes := k.Bytes()
+		copy(secnonce[i*PrivateKeySize:], kBytes[:])
+		clear(kBytes[:])
+	}
+	copy(secnonce[2*PrivateKeySize:], pk[:])
+	return secnonce, pubnonce, true
+}
+
+// MuSig2NonceAgg sums the signers' public nonces. When a nonce fails to
+// decode, its index is returned with ok = false. A sum at infinity is encoded
+// as 33 zero bytes, as BIP-327 specifies.
+func MuSig2NonceAgg(pubnonces [][MuSig2PubNonceSize]byte) (aggnonce [MuSig2PubNonceSize]byte, bad int, ok bool) {
+	if len(pubnonces) == 0 {
+		return aggnonce, -1, false
+	}
+	for j := range 2 {
+		var sum projectivePoint
+		sum.setInfinity()
+		for i := range pubnonces {
+			p, ok := parseCompressed((*[PublicKeySize]byte)(pubnonces[i][j*PublicKeySize:]))
+			if !ok {
+				return [MuSig2PubNonceSize]byte{}, i, false
+			}
+			sum.addComplete(&sum, affineProjective(&p.x, &p.y))
+		}
+		if x, y, ok := sum.affine(); ok {
+			encoded := encodeAffine(&x, &y)
+			copy(aggnonce[j*PublicKeySize:], encoded[:])
+		}
+	}
+	return aggnonce, -1, true
+}
+
+// MuSig2Session holds the values every signer derives from the key
+// aggregation context, the aggregate nonce and the message.
+type MuSig2Session struct {
+	ctx   KeyAggContext
+	b, e  scalar.Element
+	rx    [32]byte
+	rOdd  bool
+	qOdd  bool
+	valid bool
+}
+
+// NewMuSig2Session computes the BIP-327 session values for signing msg.
+func NewMuSig2Session(ctx *KeyAggContext, aggnonce *[MuSig2PubNonceSize]byte, msg []byte) (MuSig2Session, bool) {
+	if !ctx.q.valid {
+		return MuSig2Session{}, false
+	}
+	r1, ok1 := parseCompressedExt((*[PublicKeySize]byte)(aggnonce[:PublicKeySize]))
+	r2, ok2 := parseCompressedExt((*[PublicKeySize]byte)(aggnonce[PublicKeySize:]))
+	if !ok1 || !ok2 {
+		return MuSig2Session{}, false
+	}
+
+	s := MuSig2Session{ctx: *ctx, qOdd: ctx.q.y.IsOdd(), valid: true}
+	qx := ctx.XOnlyPublicKey()
+	bHash := TaggedHash("MuSig/noncecoef", aggnonce[:], qx[:], msg)
+	s.b.SetBytesReduced(&bHash)
+
+	R := scalarMultProjective(&r2, &s.b)
+	R.addComplete(&R, &r1)
+	x, y, ok := R.affine()
+	if !ok {
+		// An infinite nonce sum can only be caused by a dishonest signer and
+		// is replaced by G so the protocol can still finish and blame them.
+		g := scalarOne()
+		R = scalarBaseMultProjective(&g)
+		x, y, _ = R.affine()
+	}
+	s.rx, s.rOdd = x.Bytes(), y.IsOdd()
+	eHash := TaggedHash("BIP0340/challenge", s.rx[:], qx[:], msg)
+	s.e.SetBytesReduced(&eHash)
+	return s, true
+}
+
+// Sign produces a partial signature with the secret key sk and a secret
+// nonce from MuSig2NonceGen. The nonce's k values are zeroed whether or not
+// signing succeeds, so a secret nonce can never be used twice.
+func (s *MuSig2Session) Sign(secnonce *[MuSig2SecNonceSize]byte, sk *[PrivateKeySize]byte) ([32]byte, bool) {
+	k1Bytes := [32]byte(secnonce[:32])
+	k2Bytes := [32]byte(secnonce[32:64])
+	clear(secnonce[:2*PrivateKeySize])
+	defer clear(k1Bytes[:])
+	defer clear(k2Bytes[:])
+
+	var k1, k2, d scalar.Element
+	if !s.valid || !k1.SetBytes(&k1Bytes) || k1.IsZero() || !k2.SetBytes(&k2Bytes) || k2.IsZero() {
+		return [32]byte{}, false
+	}
+	if !d.SetBytes(sk) || d.IsZero() {
+		return [32]byte{}, false
+	}
+	P := scalarBaseMultProjective(&d)
+	px, py, _ := P.affine()
+	pk := encodeAffine(&px, &py)
+	if pk != [PublicKeySize]byte(secnonce[2*PrivateKeySize:]) || !s.ctx.contains(&pk) {
+		return [32]byte{}, false
+	}
+	if s.rOdd {
+		k1.Neg(&k1)
+		k2.Neg(&k2)
+	}
+
+	// d = g * gacc * d', where g negates for an odd aggregate key.
+	a := s.ctx.coefficient(&pk)
+	d.Mul(&d, &s.ctx.gacc)
+	if s.qOdd {
+		d.Neg(&d)
+	}
+	var sig, t scalar.Element
+	sig.Mul(&s.e, &a)
+	sig.Mul(&sig, &d)
+	t.Mul(&s.b, &k2)
+	sig.Add(&sig, &t)
+	sig.Add(&sig, &k1)
+	return sig.Bytes(), true
+}
+
+// VerifyPartial reports whether psig is a valid partial signature by the
+// aggregated key pk with public nonce pubnonce.
+func (s *MuSig2Session) VerifyPartial(psig *[32]byte, pubnonce *[MuSig2PubNonceSize]byte, pk *[PublicKeySize]byte) bool {
+	var sig scalar.Element
+	if !s.valid || !sig.SetBytes(psig) || !s.ctx.contains(pk) {
+		return false
+	}
+	p, ok := ParsePublicKey(pk)
+	if !ok {
+		return false
+	}
+	r1, ok1 := parseCompressed((*[PublicKeySize]byte)(pubnonce[:PublicKeySize]))
+	r2, ok2 := parseCompressed((*[PublicKeySize]byte)(pubnonce[PublicKeySize:]))
+	if !ok1 || !ok2 {
+		return false
+	}
+	re := scalarMultProjective(affineProjective(&r2.x, &r2.y), &s.b)
+	re.addComplete(&re, affineProjective(&r1.x, &r1.y))
+	if s.rOdd {
+		re.y.Neg(&re.y)
+	}
+
+	// Check s*G - e*a*g*gacc*P == Re.
+	a := s.ctx.coefficient(pk)
+	var c scalar.Element
+	c.Mul(&s.e, &a)
+	c.Mul(&c, &s.ctx.gacc)
+	if !s.qOdd {
+		c.Neg(&c)
+	}
+	lhs := linearCombination(&sig, &c, &p)
+	lx, ly, lok := lhs.affine()
+	rx, ry, rok := re.affine()
+	return lok && rok && lx.Equal(&rx) && ly.Equal(&ry)
+}
+
+// Aggregate sums partial signatures into a BIP-340 signature for the
+// aggregate key. When a partial signature is out of range, its index is
+// returned with ok = false.
+func (s *MuSig2Session) Aggregate(psigs [][32]byte) (sig [SignatureSize]byte, bad int, ok bool) {
+	if !s.valid {
+		return sig, -1, false
+	}
+	var sum scalar.Element
+	for i := range psigs {
+		var si scalar.Element
+		if !si.SetBytes(&psigs[i]) {
+			return sig, i, false
+		}
+		sum.Add(&sum, &si)
+	}
+	var et scalar.Element
+	et.Mul(&s.e, &s.ctx.tacc)
+	if s.qOdd {
+		et.Neg(&et)
+	}
+	sum.Add(&sum, &et)
+	sBytes := sum.Bytes()
+	copy(sig[:32], s.rx[:])
+	copy(sig[32:], sBytes[:])
+	return sig, -1, true
+}
+
+// parseCompressedExt decodes a compressed point, mapping 33 zero bytes to
+// the point at infinity.
+func parseCompressedExt(b *[PublicKeySize]byte) (projectivePoint, bool) {
+	var p projectivePoint
+	if *b == [PublicKeySize]byte{} {
+		p.setInfinity()
+		return p, true
+	}
+	q, ok := parseCompressed(b)
+	if !ok {
+		return p, false
+	}
+	return *affineProjective(&q.x, &q.y), true
+}
+
+func affineProjective(x, y *field.Element) *projectivePoint {
+	p := &projectivePoint{x: *x, y: *y}
+	p.z.SetOne()
+	return p
+}
+
+func scalarOne() scalar.Element {
+	var one scalar.Element
+	oneBytes := [scalar.Size]byte{scalar.Size - 1: 1}
+	one.SetBytes(&oneBytes)
+	return one
+}
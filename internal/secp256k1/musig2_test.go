@@ -0,0 +1,336 @@
+package secp256k1
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+)
+
+// The vectors below are from the BIP-327 reference implementation.
+
+func TestMuSig2KeyAggVectors(t *testing.T) {
+	pubs := decode33s(
+		"02F9308A019258C31049344F85F89D5229B531C845836F99B08601F113BCE036F9",
+		"03DFF1D77F2A671C5F36183726DB2341BE58FEAE1DA2DECED843240F7B502BA659",
+		"023590A94E768F8E1815C2F24B4D80A8E3149316C3518CE7B7AD338368D038CA66",
+		"020000000000000000000000000000000000000000000000000000000000000005",
+		"02FFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFEFFFFFC30",
+		"04F9308A019258C31049344F85F89D5229B531C845836F99B08601F113BCE036F9",
+		"03935F972DA013F80AE011890FA89B67A27B7BE6CCB24D3274D18B2D4067F261A9",
+	)
+	for _, tc := range []struct {
+		keys []int
+		want string
+	}{
+		{[]int{0, 1, 2}, "90539EEDE565F5D054F32CC0C220126889ED1E5D193BAF15AEF344FE59D4610C"},
+		{[]int{2, 1, 0}, "6204DE8B083426DC6EAF9502D27024D53FC826BF7D2012148A0575435DF54B2B"},
+		{[]int{0, 0, 0}, "B436E3BAD62B8CD409969A224731C193D051162D8C5AE8B109306127DA3AA935"},
+		{[]int{0, 0, 1, 1}, "69BC22BFA5D106306E48A20679DE1D7389386124D07571D0D872686028C26A3E"},
+	} {
+		ctx, _, ok := KeyAgg(pick(pubs, tc.keys))
+		if !ok {
+			t.Fatalf("KeyAgg(%v) failed", tc.keys)
+		}
+		if got := ctx.XOnlyPublicKey(); !strings.EqualFold(hex.EncodeToString(got[:]), tc.want) {
+			t.Fatalf("KeyAgg(%v) = %x", tc.keys, got)
+		}
+	}
+	for _, tc := range []struct {
+		keys []int
+		bad  int
+	}{{[]int{0, 3}, 1}, {[]int{0, 4}, 1}, {[]int{5, 0}, 0}} {
+		if _, bad, ok := KeyAgg(pick(pubs, tc.keys)); ok || bad != tc.bad {
+			t.Fatalf("KeyAgg(%v) = bad %d, %v", tc.keys, bad, ok)
+		}
+	}
+
+	ctx, _, _ := KeyAgg(pick(pubs, []int{0, 1}))
+	order := mustDecode32("FFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFEBAAEDCE6AF48A03BBFD25E8CD0364141")
+	if ctx.ApplyTweak(&order, true) {
+		t.Fatal("ApplyTweak accepted tweak = n")
+	}
+	ctx, _, _ = KeyAgg(pick(pubs, []int{6}))
+	tweak := mustDecode32("252E4BD67410A76CDF933D30EAA1608214037F1B105A013ECCD3C5C184A6110B")
+	if ctx.ApplyTweak(&tweak, false) {
+		t.Fatal("ApplyTweak accepted an infinite result")
+	}
+}
+
+func TestMuSig2NonceGenVectors(t *testing.T) {
+	sk := mustDecode32("0202020202020202020202020202020202020202020202020202020202020202")
+	pk := mustDecode33("024D4B6CD1361032CA9BD2AEB9D900AA4D45D9EAD80AC9423374C451A7254D0766")
+	aggpk := mustDecode32("0707070707070707070707070707070707070707070707070707070707070707")
+	extra := mustHex("0808080808080808080808080808080808080808080808080808080808080808")
+	var rand [32]byte
+	for _, tc := range []struct {
+		msg  []byte
+		want string
+	}{
+		{mustHex("0101010101010101010101010101010101010101010101010101010101010101"), "227243DCB40EF2A13A981DB188FA433717B506BDFA14B1AE47D5DC027C9C3B9EF2370B2AD206E724243215137C86365699361126991E6FEC816845F837BDDAC3024D4B6CD1361032CA9BD2AEB9D900AA4D45D9EAD80AC9423374C451A7254D0766"},
+		{[]byte{}, "CD0F47FE471D6788FF3243F47345EA0A179AEF69476BE8348322EF39C2723318870C2065AFB52DEDF02BF4FDBF6D2F442E608692F50C2374C08FFFE57042A61C024D4B6CD1361032CA9BD2AEB9D900AA4D45D9EAD80AC9423374C451A7254D0766"},
+		{mustHex("2626262626262626262626262626262626262626262626262626262626262626262626262626"), "011F8BC60EF061DEEF4D72A0A87200D9994B3F0CD9867910085C38D5366E3E6B9FF03BC0124E56B24069E91EC3F162378983F194E8BD0ED89BE3059649EAE262024D4B6CD1361032CA9BD2AEB9D900AA4D45D9EAD80AC9423374C451A7254D0766"},
+	} {
+		secnonce, _, ok := MuSig2NonceGen(&rand, &sk, &pk, &aggpk, tc.msg, extra)
+		if !ok || !strings.EqualFold(hex.EncodeToString(secnonce[:]), tc.want) {
+			t.Fatalf("NonceGen(msg %x) = %x, %v", tc.msg, secnonce, ok)
+		}
+	}
+	pk = mustDecode33("02F9308A019258C31049344F85F89D5229B531C845836F99B08601F113BCE036F9")
+	secnonce, pubnonce, ok := MuSig2NonceGen(&rand, nil, &pk, nil, nil, nil)
+	if want := "890E83616A3BC4640AB9B6374F21C81FF89CDDDBAFAA7475AE2A102A92E3EDB29FD7E874E23342813A60D9646948242646B7951CA046B4B36D7D6078506D3C9402F9308A019258C31049344F85F89D5229B531C845836F99B08601F113BCE036F9"; !ok || !strings.EqualFold(hex.EncodeToString(secnonce[:]), want) {
+		t.Fatalf("NonceGen without optional inputs = %x, %v", secnonce, ok)
+	}
+	k1 := [32]byte(secnonce[:32])
+	if want, _ := PublicKeyFromScalar(&k1); [33]byte(pubnonce[:33]) != want {
+		t.Fatalf("public nonce does not match secret nonce")
+	}
+}
+
+func TestMuSig2NonceAggVectors(t *testing.T) {
+	nonces := decode66s(
+		"020151C80F435648DF67A22B749CD798CE54E0321D034B92B709B567D60A42E66603BA47FBC1834437B3212E89A84D8425E7BF12E0245D98262268EBDCB385D50641",
+		"03FF406FFD8ADB9CD29877E4985014F66A59F6CD01C0E88CAA8E5F3166B1F676A60248C264CDD57D3C24D79990B0F865674EB62A0F9018277A95011B41BFC193B833",
+		"020151C80F435648DF67A22B749CD798CE54E0321D034B92B709B567D60A42E6660279BE667EF9DCBBAC55A06295CE870B07029BFCDB2DCE28D959F2815B16F81798",
+		"03FF406FFD8ADB9CD29877E4985014F66A59F6CD01C0E88CAA8E5F3166B1F676A60379BE667EF9DCBBAC55A06295CE870B07029BFCDB2DCE28D959F2815B16F81798",
+		"04FF406FFD8ADB9CD29877E4985014F66A59F6CD01C0E88CAA8E5F3166B1F676A60248C264CDD57D3C24D79990B0F865674EB62A0F9018277A95011B41BFC193B833",
+		"03FF406FFD8ADB9CD29877E4985014F66A59F6CD01C0E88CAA8E5F3166B1F676A60248C264CDD57D3C24D79990B0F865674EB62A0F9018277A95011B41BFC193B831",
+		"03FF406FFD8ADB9CD29877E4985014F66A59F6CD01C0E88CAA8E5F3166B1F676A602FFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFEFFFFFC30",
+	)
+	for _, tc := range []struct {
+		nonces []int
+		want   string
+	}{
+		{[]int{0, 1}, "035FE1873B4F2967F52FEA4A06AD5A8ECCBE9D0FD73068012C894E2E87CCB5804B024725377345BDE0E9C33AF3C43C0A29A9249F2F2956FA8CFEB55C8573D0262DC8"},
+		{[]int{2, 3}, "035FE1873B4F2967F52FEA4A06AD5A8ECCBE9D0FD73068012C894E2E87CCB5804B000000000000000000000000000000000000000000000000000000000000000000"},
+	} {
+		got, _, ok := MuSig2NonceAgg(pick(nonces, tc.nonces))
+		if !ok || !strings.EqualFold(hex.EncodeToString(got[:]), tc.want) {
+			t.Fatalf("NonceAgg(%v) = %x, %v", tc.nonces, got, ok)
+		}
+	}
+	for _, tc := range []struct {
+		nonces []int
+		bad    int
+	}{{[]int{0, 4}, 1}, {[]int{5, 1}, 0}, {[]int{6, 1}, 0}} {
+		if _, bad, ok := MuSig2NonceAgg(pick(nonces, tc.nonces)); ok || bad != tc.bad {
+			t.Fatalf("NonceAgg(%v) = bad %d, %v", tc.nonces, bad, ok)
+		}
+	}
+}
+
+var (
+	muSig2SignKey   = mustDecode32("7FB9E0E687ADA1EEBF7ECFE2F21E73EBDB51A7D450948DFE8D76D7F2D1007671")
+	muSig2SecNonce  = "508B81A611F100A6B2B6B29656590898AF488BCF2E1F55CF22E5CFB84421FE61FA27FD49B1D50085B481285E1CA205D55C82CC1B31FF5CD54A489829355901F703935F972DA013F80AE011890FA89B67A27B7BE6CCB24D3274D18B2D4067F261A9"
+	muSig2SignPubs  = decode33s("03935F972DA013F80AE011890FA89B67A27B7BE6CCB24D3274D18B2D4067F261A9", "02F9308A019258C31049344F85F89D5229B531C845836F99B08601F113BCE036F9", "02DFF1D77F2A671C5F36183726DB2341BE58FEAE1DA2DECED843240F7B502BA661", "020000000000000000000000000000000000000000000000000000000000000007")
+	muSig2SignNonce = decode66s(
+		"0337C87821AFD50A8644D820A8F3E02E499C931865C2360FB43D0A0D20DAFE07EA0287BF891D2A6DEAEBADC909352AA9405D1428C15F4B75F04DAE642A95C2548480",
+		"0279BE667EF9DCBBAC55A06295CE870B07029BFCDB2DCE28D959F2815B16F817980279BE667EF9DCBBAC55A06295CE870B07029BFCDB2DCE28D959F2815B16F81798",
+		"032DE2662628C90B03F5E720284EB52FF7D71F4284F627B68A853D78C78E1FFE9303E4C5524E83FFE1493B9077CF1CA6BEB2090C93D930321071AD40B2F44E599046",
+		"0237C87821AFD50A8644D820A8F3E02E499C931865C2360FB43D0A0D20DAFE07EA0387BF891D2A6DEAEBADC909352AA9405D1428C15F4B75F04DAE642A95C2548480",
+	)
+)
+
+func TestMuSig2SignVerifyVectors(t *testing.T) {
+	msgs := [][]byte{mustHex("F95466D086770E689964664219266FE5ED215C92AE20BAB5C9D79ADDDDF3C0CF"), {}, mustHex("2626262626262626262626262626262626262626262626262626262626262626262626262626")}
+	for _, tc := range []struct {
+		keys, nonces []int
+		msg, signer  int
+		want         string
+	}{
+		{[]int{0, 1, 2}, []int{0, 1, 2}, 0, 0, "012ABBCB52B3016AC03AD82395A1A415C48B93DEF78718E62A7A90052FE224FB"},
+		{[]int{1, 0, 2}, []int{1, 0, 2}, 0, 1, "9FF2F7AAA856150CC8819254218D3ADEEB0535269051897724F9DB3789513A52"},
+		{[]int{1, 2, 0}, []int{1, 2, 0}, 0, 2, "FA23C359F6FAC4E7796BB93BC9F0532A95468C539BA20FF86D7C76ED92227900"},
+		{[]int{0, 1}, []int{0, 3}, 0, 0, "AE386064B26105404798F75DE2EB9AF5EDA5387B064B83D049CB7C5E08879531"},
+		{[]int{0, 1, 2}, []int{0, 1, 2}, 1, 0, "D7D63FFD644CCDA4E62BC2BC0B1D02DD32A1DC3030E155195810231D1037D82D"},
+		{[]int{0, 1, 2}, []int{0, 1, 2}, 2, 0, "E184351828DA5094A97C79CABDAAA0BFB87608C32E8829A4DF5340A6F243B78C"},
+	} {
+		session := muSig2TestSession(t, muSig2SignPubs, tc.keys, tc.nonces, nil, nil, msgs[tc.msg])
+		secnonce := [MuSig2SecNonceSize]byte(mustHex(muSig2SecNonce))
+		psig, ok := session.Sign(&secnonce, &muSig2SignKey)
+		if !ok || !strings.EqualFold(hex.EncodeToString(psig[:]), tc.want) {
+			t.Fatalf("Sign(%v, msg %d) = %x, %v", tc.keys, tc.msg, psig, ok)
+		}
+		if secnonce != [MuSig2SecNonceSize]byte(append(make([]byte, 64), muSig2SignPubs[0][:]...)) {
+			t.Fatal("Sign did not zero the secret nonce")
+		}
+		if _, ok := session.Sign(&secnonce, &muSig2SignKey); ok {
+			t.Fatal("Sign reused a zeroed secret nonce")
+		}
+		nonce := muSig2SignNonce[tc.nonces[tc.signer]]
+		if !session.VerifyPartial(&psig, &nonce, &muSig2SignPubs[0]) {
+			t.Fatalf("VerifyPartial(%v, msg %d) rejected", tc.keys, tc.msg)
+		}
+		psig[31] ^= 1
+		if session.VerifyPartial(&psig, &nonce, &muSig2SignPubs[0]) {
+			t.Fatal("VerifyPartial accepted a modified signature")
+		}
+	}
+
+	session := muSig2TestSession(t, muSig2SignPubs, []int{1, 2}, []int{0, 1}, nil, nil, msgs[0])
+	secnonce := [MuSig2SecNonceSize]byte(mustHex(muSig2SecNonce))
+	if _, ok := session.Sign(&secnonce, &muSig2SignKey); ok {
+		t.Fatal("Sign accepted a key outside the aggregate")
+	}
+	ctx, _, _ := KeyAgg(pick(muSig2SignPubs, []int{1, 2, 0}))
+	for _, aggnonce := range []string{
+		"048465FCF0BBDBCF443AABCCE533D42B4B5A10966AC09A49655E8C42DAAB8FCD61037496A3CC86926D452CAFCFD55D25972CA1675D549310DE296BFF42F72EEEA8C9",
+		"028465FCF0BBDBCF443AABCCE533D42B4B5A10966AC09A49655E8C42DAAB8FCD61020000000000000000000000000000000000000000000000000000000000000009",
+		"028465FCF0BBDBCF443AABCCE533D42B4B5A10966AC09A49655E8C42DAAB8FCD6102FFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFEFFFFFC30",
+	} {
+		if _, ok := NewMuSig2Session(&ctx, (*[MuSig2PubNonceSize]byte)(mustHex(aggnonce)), msgs[0]); ok {
+			t.Fatalf("NewMuSig2Session accepted aggregate nonce %s", aggnonce)
+		}
+	}
+}
+
+func TestMuSig2TweakVectors(t *testing.T) {
+	pubs := append(muSig2SignPubs[:2:2], mustDecode33("02DFF1D77F2A671C5F36183726DB2341BE58FEAE1DA2DECED843240F7B502BA659"))
+	tweaks := [][32]byte{
+		mustDecode32("E8F791FF9225A2AF0102AFFF4A9A723D9612A682A25EBE79802B263CDFCD83BB"),
+		mustDecode32("AE2EA797CC0FE72AC5B97B97F3C6957D7E4199A167A58EB08BCAFFDA70AC0455"),
+		mustDecode32("F52ECBC565B3D8BEA2DFD5B75A4F457E54369809322E4120831626F290FA87E0"),
+		mustDecode32("1969AD73CC177FA0B4FCED6DF1F7BF9907E665FDE9BA196A74FED0A3CF5AEF9D"),
+	}
+	msg := mustHex("F95466D086770E689964664219266FE5ED215C92AE20BAB5C9D79ADDDDF3C0CF")
+	for _, tc := range []struct {
+		tweaks []int
+		xOnly  []bool
+		want   string
+	}{
+		{[]int{0}, []bool{true}, "E28A5C66E61E178C2BA19DB77B6CF9F7E2F0F56C17918CD13135E60CC848FE91"},
+		{[]int{0}, []bool{false}, "38B0767798252F21BF5702C48028B095428320F73A4B14DB1E25DE58543D2D2D"},
+		{[]int{0, 1}, []bool{false, true}, "408A0A21C4A0F5DACAF9646AD6EB6FECD7F7A11F03ED1F48DFFF2185BC2C2408"},
+		{[]int{0, 1, 2, 3}, []bool{false, false, true, true}, "45ABD206E61E3DF2EC9E264A6FEC8292141A633C28586388235541F9ADE75435"},
+		{[]int{0, 1, 2, 3}, []bool{true, false, true, false}, "B255FDCAC27B40C7CE7848E2D3B7BF5EA0ED756DA81565AC804CCCA3E1D5D239"},
+	} {
+		session := muSig2TestSession(t, pubs, []int{1, 2, 0}, []int{1, 2, 0}, pick(tweaks, tc.tweaks), tc.xOnly, msg)
+		secnonce := [MuSig2SecNonceSize]byte(mustHex(muSig2SecNonce))
+		psig, ok := session.Sign(&secnonce, &muSig2SignKey)
+		if !ok || !strings.EqualFold(hex.EncodeToString(psig[:]), tc.want) {
+			t.Fatalf("Sign(tweaks %v %v) = %x, %v", tc.tweaks, tc.xOnly, psig, ok)
+		}
+		if !session.VerifyPartial(&psig, &muSig2SignNonce[0], &muSig2SignPubs[0]) {
+			t.Fatalf("VerifyPartial(tweaks %v %v) rejected", tc.tweaks, tc.xOnly)
+		}
+	}
+}
+
+func TestMuSig2SigAggVectors(t *testing.T) {
+	pubs := decode33s(
+		"03935F972DA013F80AE011890FA89B67A27B7BE6CCB24D3274D18B2D4067F261A9",
+		"02D2DC6F5DF7C56ACF38C7FA0AE7A759AE30E19B37359DFDE015872324C7EF6E05",
+		"03C7FB101D97FF930ACD0C6760852EF64E69083DE0B06AC6335724754BB4B0522C",
+		"02352433B21E7E05D3B452B81CAE566E06D2E003ECE16D1074AABA4289E0E3D581",
+	)
+	tweaks := [][32]byte{
+		mustDecode32("B511DA492182A91B0FFB9A98020D55F260AE86D7ECBD0399C7383D59A5F2AF7C"),
+		mustDecode32("A815FE049EE3C5AAB66310477FBC8BCCCAC2F3395F59F921C364ACD78A2F48DC"),
+		mustDecode32("75448A87274B056468B977BE06EB1E9F657577B7320B0A3376EA51FD420D18A8"),
+	}
+	var psigs [][32]byte
+	for _, psig := range []string{
+		"B15D2CD3C3D22B04DAE438CE653F6B4ECF042F42CFDED7C41B64AAF9B4AF53FB",
+		"6193D6AC61B354E9105BBDC8937A3454A6D705B6D57322A5A472A02CE99FCB64",
+		"9A87D3B79EC67228CB97878B76049B15DBD05B8158D17B5B9114D3C226887505",
+		"66F82EA90923689B855D36C6B7E032FB9970301481B99E01CDB4D6AC7C347A15",
+		"4F5AEE41510848A6447DCD1BBC78457EF69024944C87F40250D3EF2C25D33EFE",
+		"DDEF427BBB847CC027BEFF4EDB01038148917832253EBC355FC33F4A8E2FCCE4",
+		"97B890A26C981DA8102D3BC294159D171D72810FDF7C6A691DEF02F0F7AF3FDC",
+		"53FA9E08BA5243CBCB0D797C5EE83BC6728E539EB76C2D0BF0F971EE4E909971",
+		"FFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFEBAAEDCE6AF48A03BBFD25E8CD0364141",
+	} {
+		psigs = append(psigs, mustDecode32(psig))
+	}
+	msg := mustDecode32("599C67EA410D005B9DA90817CF03ED3B1C868E4DA4EDF00A5880B0082C237869")
+	for _, tc := range []struct {
+		aggnonce    string
+		keys, psigs []int
+		tweaks      []int
+		xOnly       []bool
+		want        string
+	}{
+		{"0341432722C5CD0268D829C702CF0D1CBCE57033EED201FD335191385227C3210C03D377F2D258B64AADC0E16F26462323D701D286046A2EA93365656AFD9875982B", []int{0, 1}, []int{0, 1}, nil, nil, "041DA22223CE65C92C9A0D6C2CAC828AAF1EEE56304FEC371DDF91EBB2B9EF0912F1038025857FEDEB3FF696F8B99FA4BB2C5812F6095A2E0004EC99CE18DE1E"},
+		{"0224AFD36C902084058B51B5D36676BBA4DC97C775873768E58822F87FE437D792028CB15929099EEE2F5DAE404CD39357591BA32E9AF4E162B8D3E7CB5EFE31CB20", []int{0, 2}, []int{2, 3}, nil, nil, "1069B67EC3D2F3C7C08291ACCB17A9C9B8F2819A52EB5DF8726E17E7D6B52E9F01800260A7E9DAC450F4BE522DE4CE12BA91AEAF2B4279219EF74BE1D286ADD9"},
+		{"0208C5C438C710F4F96A61E9FF3C37758814B8C3AE12BFEA0ED2C87FF6954FF186020B1816EA104B4FCA2D304D733E0E19CEAD51303FF6420BFD222335CAA402916D", []int{0, 2}, []int{4, 5}, []int{0}, []bool{false}, "5C558E1DCADE86DA0B2F02626A512E30A22CF5255CAEA7EE32C38E9A71A0E9148BA6C0E6EC7683B64220F0298696F1B878CD47B107B81F7188812D593971E0CC"},
+		{"02B5AD07AFCD99B6D92CB433FBD2A28FDEB98EAE2EB09B6014EF0F8197CD58403302E8616910F9293CF692C49F351DB86B25E352901F0E237BAFDA11F1C1CEF29FFD", []int{0, 3}, []int{6, 7}, []int{0, 1, 2}, []bool{true, false, true}, "839B08820B681DBA8DAF4CC7B104E8F2638F9388F8D7A555DC17B6E6971D7426CE07BF6AB01F1DB50E4E33719295F4094572B79868E440FB3DEFD3FAC1DB589E"},
+	} {
+		ctx := muSig2TestContext(t, pick(pubs, tc.keys), pick(tweaks, tc.tweaks), tc.xOnly)
+		session, ok := NewMuSig2Session(&ctx, (*[MuSig2PubNonceSize]byte)(mustHex(tc.aggnonce)), msg[:])
+		if !ok {
+			t.Fatalf("NewMuSig2Session(%v) failed", tc.keys)
+		}
+		sig, _, ok := session.Aggregate(pick(psigs, tc.psigs))
+		if !ok || !strings.EqualFold(hex.EncodeToString(sig[:]), tc.want) {
+			t.Fatalf("Aggregate(%v) = %x, %v", tc.psigs, sig, ok)
+		}
+		xonly := ctx.XOnlyPublicKey()
+		if !VerifySchnorr(&xonly, &msg, &sig) {
+			t.Fatalf("aggregate signature %v does not verify", tc.psigs)
+		}
+		if tc.tweaks != nil {
+			if _, bad, ok := session.Aggregate(pick(psigs, []int{7, 8})); ok || bad != 1 {
+				t.Fatalf("Aggregate with out-of-range signature = bad %d, %v", bad, ok)
+			}
+		}
+	}
+}
+
+func muSig2TestContext(t *testing.T, pubs [][33]byte, tweaks [][32]byte, xOnly []bool) KeyAggContext {
+	t.Helper()
+	ctx, _, ok := KeyAgg(pubs)
+	if !ok {
+		t.Fatal("KeyAgg failed")
+	}
+	for i := range tweaks {
+		if !ctx.ApplyTweak(&tweaks[i], xOnly[i]) {
+			t.Fatalf("ApplyTweak(%d) failed", i)
+		}
+	}
+	return ctx
+}
+
+func muSig2TestSession(t *testing.T, pubs [][33]byte, keys, nonces []int, tweaks [][32]byte, xOnly []bool, msg []byte) MuSig2Session {
+	t.Helper()
+	ctx := muSig2TestContext(t, pick(pubs, keys), tweaks, xOnly)
+	aggnonce, _, ok := MuSig2NonceAgg(pick(muSig2SignNonce, nonces))
+	if !ok {
+		t.Fatal("NonceAgg failed")
+	}
+	session, ok := NewMuSig2Session(&ctx, &aggnonce, msg)
+	if !ok {
+		t.Fatal("NewMuSig2Session failed")
+	}
+	return session
+}
+
+func pick[T any](values []T, indexes []int) []T {
+	out := make([]T, len(indexes))
+	for i, index := range indexes {
+		out[i] = values[index]
+	}
+	return out
+}
+
+func mustHex(value string) []byte {
+	decoded, err := hex.DecodeString(value)
+	if err != nil {
+		panic("invalid hex test value")
+	}
+	return decoded
+}
+
+func decode33s(values ...string) [][33]byte {
+	out := make([][33]byte, len(values))
+	for i, value := range values {
+		out[i] = [33]byte(mustHex(value))
+	}
+	return out
+}
+
+func decode66s(values ...string) [][66]byte {
+	out := make([][66]byte, len(values))
+	for i, value := range values {
+		out[i] = [66]byte(mustHex(value))
+	}
+	return out
+}
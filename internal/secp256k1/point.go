@@ -220,6 +220,85 @@ func (p *projectivePoint) addCompleteMixed(p1 *projectivePoint, p2 *affinePoint)
 	return p
 }
 
+// addComplete implements the complete projective addition formula for
+// j-invariant 0 curves (Renes-Costello-Batina, algorithm 7), specialized to
+// b = 7. It has no exceptional cases, so it is safe for secret inputs.
+func (p *projectivePoint) addComplete(p1, p2 *projectivePoint) *projectivePoint {
+	var t0, t1, t2, t3, t4 field.Element
+	var x3, y3, z3 field.Element
+
+	t0.Mul(&p1.x, &p2.x)
+	t1.Mul(&p1.y, &p2.y)
+	t2.Mul(&p1.z, &p2.z)
+	t3.Add(&p1.x, &p1.y)
+	t4.Add(&p2.x, &p2.y)
+	t3.Mul(&t3, &t4)
+	t4.Add(&t0, &t1)
+	t3.Sub(&t3, &t4)
+	t4.Add(&p1.y, &p1.z)
+	x3.Add(&p2.y, &p2.z)
+	t4.Mul(&t4, &x3)
+	x3.Add(&t1, &t2)
+	t4.Sub(&t4, &x3)
+	x3.Add(&p1.x, &p1.z)
+	y3.Add(&p2.x, &p2.z)
+	x3.Mul(&x3, &y3)
+	y3.Add(&t0, &t2)
+	y3.Sub(&x3, &y3)
+	x3.Add(&t0, &t0)
+	t0.Add(&x3, &t0)
+	t2.MulByB3(&t2)
+	z3.Add(&t1, &t2)
+	t1.Sub(&t1, &t2)
+	y3.MulByB3(&y3)
+	x3.Mul(&t4, &y3)
+	t2.Mul(&t3, &t1)
+	x3.Sub(&t2, &x3)
+	y3.Mul(&y3, &t0)
+	t1.Mul(&t1, &z3)
+	y3.Add(&t1, &y3)
+	t0.Mul(&t0, &t3)
+	z3.Mul(&z3, &t4)
+	z3.Add(&z3, &t0)
+
+	p.x.Set(&x3)
+	p.y.Set(&y3)
+	p.z.Set(&z3)
+	return p
+}
+
+// doubleComplete implements the complete projective doubling formula for
+// j-invariant 0 curves (Renes-Costello-Batina, algorithm 9), specialized to
+// b = 7.
+func (p *projectivePoint) doubleComplete(q *projectivePoint) *projectivePoint {
+	var t0, t1, t2 field.Element
+	var x3, y3, z3 field.Element
+
+	t0.Square(&q.y)
+	z3.Double(&t0)
+	z3.Double(&z3)
+	z3.Double(&z3)
+	t1.Mul(&q.y, &q.z)
+	t2.Square(&q.z)
+	t2.MulByB3(&t2)
+	x3.Mul(&t2, &z3)
+	y3.Add(&t0, &t2)
+	z3.Mul(&t1, &z3)
+	t1.Double(&t2)
+	t2.Add(&t1, &t2)
+	t0.Sub(&t0, &t2)
+	y3.Mul(&t0, &y3)
+	y3.Add(&x3, &y3)
+	t1.Mul(&q.x, &q.y)
+	x3.Mul(&t0, &t1)
+	x3.Double(&x3)
+
+	p.x.Set(&x3)
+	p.y.Set(&y3)
+	p.z.Set(&z3)
+	return p
+}
+
 func (p *projectivePoint) affine() (field.Element, field.Element, bool) {
 	if p.z.IsZero() {
 		var x, y field.Element
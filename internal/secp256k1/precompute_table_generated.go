@@ -1,7 +1,7 @@
 // Code generated by cmd/genprecomp; DO NOT EDIT.
-// Parameters: base-window=5.
-// Generator source SHA-256: f6a72c748bb1012a1c234618af8267af77dbea1b353ef3d0860010408bb4cc82.
-// Table data SHA-256: b3fcaed6a2e8c1c979888c29da40aca38a18e1221043f912ca1863b36b1535a1.
+// Parameters: base-window=5, wnaf-window=8, wnaf-chunk-bits=16.
+// Generator source SHA-256: 3c2ac2f08a7cc4951c585cda1a5c5548b15cec74edf65c4b781bb1876ce8380b.
+// Table data SHA-256: e69d5b03fbea46f815e6617dd71e53155a6f007a38ee7127563dffe6773f4bea.
 
 package secp256k1
 
@@ -943,3 +943,534 @@ var generatorAffineTableW5Words = [52][16][8]uint64{
 		{0xc215a5a5bbecbfa0, 0x46d8aacaa7dafd51, 0xd04b708d2896d043, 0x9395cf109140b160, 0xfcbea159151c4d40, 0x4961c01c8c9b7cbf, 0x8b215d383cc12793, 0x9261e83c6c7ccad1},
 	},
 }
+
+var generatorOddMultiplesW8Words = [8][64][8]uint64{
+	{
+		{0xd7362e5a487e2097, 0x231e295329bc66db, 0x979f48c033fd129c, 0x9981e643e9089f48, 0xb15ea6d2d3dbabe2, 0x8dfc5d5d1f1dc64d, 0x70b6b59aac19c136, 0xcf3f851fd4a582d6},
+		{0x2379d4bbd5fea781, 0x66ceafb22eb7bc4, 0x5940d07385985972, 0x9497730fcdf4c0ad, 0xaf18b0b0613f55a9, 0xac4964cdc5a1f91f, 0xcc6048bd84885650, 0x3ec28dcd9215ec76},
+		{0x212347fcbea19bc6, 0x58d7334ddc284cda, 0x20ce358572dd41dd, 0x8ed284d3aae7f96f, 0x9e5e784800dfd9e7, 0x59aaa8d8aad35cc5, 0x11d0b107f8dbfd2, 0x1fd437ae583630c0},
+		{0x7ece566caa4cb22, 0xca934f8716c087c4, 0x4da362224e1d6bd5, 0x5f402433d73866e0, 0x4777d1124a77d752, 0x879d7639f1097263, 0xf2fd13d87291ab04, 0xc8043a670ba1a73b},
+		{0x46cc6d26eafd5a74, 0x6edd9e7f1ed7f74c, 0x8cec72c7f64b253d, 0x87d71c6bf4d02a72, 0xb2a0d4ae268d25a4, 0xaec108c659794d80, 0xf0176bede6793574, 0x156339094cef97c},
+		{0x4f0c78f94a7a0aa, 0x349ebdf993493bb8, 0xd28558b5bb49a3c1, 0x9d888be8bce5a953, 0x434322e37beacf4c, 0x755db980f899acaa, 0x7cb76bd27b41572a, 0xe92c06d7705fac8},
+		{0xd59a06c4f5989088, 0xd35438e646aec93f, 0x5b370e50a02a9988, 0x7065f32baff18f7b, 0x14817536a5d44558, 0xf73d052948a3b41, 0xdb37e3a6c013f5af, 0x595e4c3399b24984},
+		{0xd51e8da318620cd4, 0xa9b174243ff3bffd, 0x8fe0d087f9180a0e, 0x329cf6f36a78a2b1, 0x364e94e68cf9083a, 0xd97359fb5ca29845, 0x1442e0ed9e703fc8, 0xf384d03b4965bc3e},
+		{0xd90bb8e11df00c43, 0x9b182865f3b25560, 0x69d7a2a822b91922, 0xe272a6a1f9ff59aa, 0x85352ea76f2a14c9, 0x99dc58b3753707e5, 0x4e936ddcc6b65cc3, 0xb9d1058538a1624e},
+		{0xd78ee564d62a7a38, 0x5f8bf03a727df8f4, 0xd8d133ada3023a4a, 0xdeb7636c4dd633a5, 0xdf15c738e0d36289, 0x91a29c6198b88bdc, 0x7ceef09600dda4f2, 0x444ec627d07c28ea},
+		{0x7287d563f76ce60a, 0x6ddb2dc674eacc1d, 0xe0a1107e6cb12ea3, 0x38d21ce5a746f598, 0xead831a434cae6f2, 0x439ddfdc4c4c0573, 0xd69265aeb8025098, 0x9a760529af0be6c8},
+		{0x710c24917d7868e2, 0xb7018dcca66fa9a0, 0xb2bb768f928ba6aa, 0x34b9089136060918, 0x1bb37e7d5a765cfa, 0x4e41eb805d5c130, 0xfabc5a81934fc6c9, 0x1ada75eb956c455f},
+		{0x43a8673a528eab6d, 0xcc7c3aff84257eee, 0x826674bbf7fb2c09, 0x4f13fd3db6487c5a, 0x1af88d5c37027b74, 0xd788f352bb5bb569, 0x315a735beaaa2d4c, 0xdaaf22e5b81ac1bd},
+		{0x4065de4bdf096d18, 0xf732d13664c270e8, 0x4a77f053b730aeba, 0x6753e5a765719926, 0xca06688145d3e40b, 0x5987c3f8ac9dcfb6, 0xfbfcc60cd3304a23, 0x5dace33180153d3b},
+		{0x78393cc1bc66aebf, 0x66de27b31124aa09, 0x8c4c7f2072cecebe, 0x30822abdd5a92b77, 0xa8c8e083f7cbcb31, 0xcb952fa172bd2977, 0xcdb3ab31afa8c27, 0x210ec24f6535ce88},
+		{0x3eeec4b21af2746f, 0x16f76f7b2686256, 0x730d41a484012e67, 0xf12c7e23f74ec811, 0xe5694678a89357dc, 0x10ac2b51ca2335dd, 0x5e0eda4ce149b499, 0x27964839bfa868aa},
+		{0xc789b0d904462042, 0x83266b6f7948d55c, 0x87e088c16ce63ae1, 0x37b49275a8134188, 0x55856bfe2b18110c, 0xf698b243c499bb93, 0x3dbb3810cef8e10, 0x79fb211f777f178a},
+		{0x8cc030b523eacd0a, 0xaf885b4de6947cc4, 0xa06b283acf667258, 0x581042a66f6f64c8, 0x3a31e3d79265f180, 0x4dc9de4c5eab6ebe, 0xbd0a4fadaeabe161, 0xc7ec3e2d5b411868},
+		{0x574d374d611e5431, 0x85755869ed7e7a80, 0x198bd86cd6081a8f, 0x621a29ddb92d0518, 0xf231989edd9866d8, 0xc1be6826da9fddb4, 0x8985db78e11526fa, 0xce9b6976d67d796d},
+		{0xabb6b74931ab84a, 0x8c686ce20af3d47a, 0x5e723a3d524477f9, 0x77de6bba5c326551, 0xfc5b9ec5929b6f64, 0xaeb7c6cde49d0496, 0xf5482095c7aafa37, 0xcf35e3ebf5a3d880},
+		{0xae480b9f53d193c, 0x86f719df0dc5b44e, 0x146dbbc189c1812d, 0x2e2bc80567214653, 0xfcbb7632be39d872, 0x4ba332cb7d576c11, 0x18e6c1b219f23c9b, 0xc00969e9383240ea},
+		{0x5f294ba4f83f38d0, 0x3cc9b7d466f160b7, 0xa93c93521597f957, 0x37c6a02e65a48803, 0xbe0809ba4c8dde97, 0xd90d585c5b5ad049, 0xf3df54f6d45360dc, 0x962e84ea6bea9faa},
+		{0xba3684679efa4969, 0xc20713edd5081d8f, 0x83c92f9d42552668, 0x2ca518ad5320b144, 0x5afa94ed90e0c05c, 0x3b30690ebe289c86, 0x854ec8102c544b3d, 0x578756f29e6ffca5},
+		{0x16a6d6de472adca1, 0x86ae5c96ae5cb8cf, 0x49cb06514d1fb544, 0x3233ef661cbf1211, 0x3b47757649e7e82b, 0x4fc6f53994c7e45a, 0xe4bc5f988ae74268, 0x3f188c560425b0d4},
+		{0xdef9f1d0d939d060, 0x7ee70afdde7df977, 0x6c08f60d4e310ef5, 0xa1482a269bb97205, 0xcdd9f40c80d483ec, 0xba1f50ed769367ab, 0x33364421d8a95257, 0x6db77a1a2e1da593},
+		{0x4fba1057d6d499e6, 0xc36b65f01919ed5f, 0xead948d305d0d2e9, 0x6e3c4f4ff2d3984e, 0x2b72b3fd133daef, 0xc22c7b5f8a4f8771, 0xd266f00b330ddbf7, 0x73f7d2d32d273a4b},
+		{0x29aba0f99b4ad7c2, 0xe5fd9e62f139f8c5, 0xde7b34cf7d19e5a2, 0xaf6ceb12f4eb9bb7, 0x980e43acd79eb786, 0x55c8772e06865b9b, 0x83dadee963e0338d, 0xf2edddf9b0e45726},
+		{0x51666ffc2e9be15e, 0x2483921e02fad43d, 0x8f1c07dc6c8c357e, 0xc7b06ee96985fced, 0xcc6e1536c6b46c3e, 0x5528e849bbb2db21, 0xf26df73f7be3572a, 0xba398de3910b0743},
+		{0x117a547cc70c9cbc, 0xb47a5180e1e6e3e4, 0xe4be3476e040dc88, 0x3130c25b4b0d1b30, 0x40d4f07cf2a1cef, 0x1bdbea3650d3fb9a, 0x877f57ddfe4216bc, 0xc6ea984d4b832e71},
+		{0x8fefc57423cf448a, 0xa154e2bd7761b2b0, 0x9500ddd625037408, 0xfb31e37630f720ed, 0xd1b658150b2b3069, 0x9c4d7e1a6b6d0049, 0x4c8df8bb5b38bc15, 0xcad37dfaab3dfe73},
+		{0x3b75bb5de9b540e1, 0x7f125440b549b012, 0x83f9faf08450e538, 0xa2930635cd40829a, 0x73f89d79daed83c2, 0x398e268e4889b425, 0x367fdcf5e6112ff9, 0x866090e27c885dc0},
+		{0xa4d44416639300be, 0xdea61ae4b9f7c408, 0xffda5b3f839c0518, 0x3539752caa4356f4, 0x37c0abf13bb54b7, 0xb3c48ffee367f08f, 0x56e45762d943dd47, 0x4f07938a8c07e9ee},
+		{0x2a1ea133e571a95, 0x3cd68054d7432e3d, 0x2f3b47013b4e2977, 0x36d8b419d044bd24, 0xdb0b2a21cddfc71, 0x3a26e050b3a10161, 0x70da8f43bf78dad, 0x2ace7645b103ccfc},
+		{0x5af0b2c7cd050b2b, 0x4a8ac3f656a949f1, 0x79d45a495e901a26, 0x2162592f52a01c5d, 0xd0cabb103ef1f86e, 0xee78f4f6ca315bf9, 0xeccf83a888e26c35, 0xbed6c8e5df026741},
+		{0xef95483192b2a69f, 0x59d66d12801eedae, 0x4a9a5c4ddb48ea55, 0x4720e9c27e14e2c8, 0xb7d8c06ee6dccf08, 0x48fe4ee540fd95d2, 0xda45a64aeed33d72, 0x75778bf12a856a1b},
+		{0x6bf4b50dcd619869, 0xcf2905d4ec378a7d, 0x6afa04b405dec19c, 0x4c45674a8097a072, 0x23030d37b66b5b0c, 0xc5821b2cd8d01bf2, 0x29bac38a5180b6dd, 0x3ce0002df92866f4},
+		{0xfba2d68a0cf9eadc, 0xde1e413624cecc2b, 0xc68bd2c0720a9dc7, 0x5ad2b748ea1a0526, 0x760527a416a9f536, 0xbf584a933ae7420d, 0xe1031ca78ea83ce4, 0x8a88d38b1d1488e8},
+		{0x22da94d0491da122, 0x1f0287d4a2765a7b, 0x2b1581dfc9405465, 0xc9fc1ec837b143ef, 0x5a5930b01f459de4, 0x91fabe0c608dc9af, 0xaa6f106d582ac2df, 0xbd490355a1f4e1e9},
+		{0xd1980100bf850344, 0x72b08d754fd1c352, 0xc000041d285f3d50, 0x7d04535324a74eb8, 0xcea2c0d9c12ac942, 0x6f00aa15029703f2, 0xcfada34ddae63039, 0xb568fd0d43abda38},
+		{0xc2e91568b53cdef5, 0x71c74e32c4544b5, 0xd38d1e50d11e6e6, 0xb1d8781a266290be, 0x3e1ff57629dc100c, 0x3d6da92752e2efc0, 0xb5445599b834f17e, 0xd5f3c5076d99980a},
+		{0x96a35fdeec8f107d, 0xc5e6771cdfb516ac, 0xbc394e810578790c, 0x78d17d21ed00b113, 0xff26c9bb087a082, 0x9c153535d1a0f801, 0x129a08ee3a004269, 0xf88e3c1357eab421},
+		{0xeeee8bae04bf455f, 0xad393cc8cdfdc187, 0xc34d882645d0d22, 0x4ab41ead7c206a26, 0xb95e05bf250636fb, 0xf6d418c3cbe3122a, 0x2d332583e9e06179, 0x87ca57a26b14c2a9},
+		{0xe7c17621e806af1e, 0x19f3afab09e7f65b, 0x552b03e72849ce9b, 0x90c281ced2db3693, 0xac1af46c276d98f3, 0x42659e2c1855f2a, 0x30849fc24718b1a6, 0x6d1dd3e13bd64a},
+		{0x688d0fccfe354ecc, 0xf5f07f1da60ea45f, 0x217823351a90d091, 0xc60a9e1562f0943f, 0x8893c0d324340ec3, 0x52bea81a88b310ff, 0xd1b308203b2381a7, 0x4e4c97ab192c6d66},
+		{0x66dc74f1f42749c4, 0xb4301ad10412432, 0xab4949b0165535b9, 0xb98e04223652a1fe, 0x6570ecca88d46bfa, 0x2faa12590bf86d7f, 0x5be618f033f0f556, 0x271d56887733dce9},
+		{0x54f465e4b0253e6c, 0x727e03d88ea4a784, 0x5a999d23ada1755d, 0x31091420599926f, 0x12b3997eca9dd88d, 0x8fe184671b8b04f7, 0x133370b5f4bd91eb, 0x20be2540e134c14d},
+		{0x815cc34b4e8c2de9, 0xaa341e7083a7ad9b, 0xf7888c9462fad69a, 0x74c744c5a8eb5d28, 0xf4e8fc85a66b8af0, 0x345214d358cd95a5, 0xae5bee6620ecc7fb, 0xd8c600bc83893aac},
+		{0x7c4822ed051aa891, 0x82a7de7292d69f74, 0x1e79560f5c5b19e4, 0xb6c11a599920ecee, 0x5606e84110c45826, 0x948a8f75061c6142, 0x8f079b9be579d8e2, 0xf4bf8cfefa096557},
+		{0x28c35b0a0fae4b5c, 0xf816773e7f3fbaf5, 0x37e33557a9ea73be, 0x7b33418ebcae9aaa, 0x970cc5fc873b8c15, 0x323d6c800833bd7f, 0xfbeaf192d21716b5, 0x702286eae41f1fcb},
+		{0x799fd863c0f6ed34, 0x677d88baba8d6d14, 0x51e35439319c0197, 0xf8e3fdd0b579184d, 0x949e5265f2348129, 0x4e91a3d8fcb122c9, 0x57014d8db1ae79dc, 0xd44fb03d2801167c},
+		{0xdb4114d67ec61a3b, 0x7b3f833f49cccd6d, 0x8a8e8d234bf4595a, 0xd89d979498b9fb2e, 0xc85f800fd546a62c, 0xfc5f363ccc3eb519, 0xd8f669316d6c66d9, 0x13200ef558081196},
+		{0x87c574bbce02bd3d, 0x3cb798c5c460de78, 0x61d2ef2f3b902020, 0x2b585df2fcc42fc7, 0x57e3d988e591d247, 0x7ea4af3cbd6d8815, 0x967316955e3eff33, 0x3b9940696390a6e3},
+		{0x2467d5648a9bca1c, 0x25f57d6b640cd8a6, 0x81234ebbd5a18a10, 0x603bdea2b954b9a2, 0xcb5fd9b02ba1b6dd, 0xdd7d81d238fc069d, 0xd4cb6c6b0eea0a62, 0xb79b392e48fed7b3},
+		{0x23991abc654f38ee, 0x2f42e36868518861, 0xbd1317141ddf24d8, 0xbb9beaa6eadefa4c, 0x68a5bdd85fac2cf8, 0x562a7e9961f74832, 0x2fdf74f6c3db010a, 0xb3f2c1bc829db1c},
+		{0x85c4a823b3657f13, 0x4737b28a88874213, 0xf51db69e5f32d986, 0x273dcd867ad654ba, 0xa0ca5f701cdb4a91, 0x9fb6b207450dc02c, 0x81017cf618d49e49, 0xd013d1f3f509d822},
+		{0xe526ae3bafdd5a82, 0x40cef62f53e4a596, 0xaf33fd74b96a578c, 0xbb1cc791ef1a0fca, 0xc2f393887c9e895a, 0x276064dbc55691e7, 0x145b768acbcf433c, 0x591d2068862bda78},
+		{0x325735da9c1009cd, 0xec4149175095d723, 0x2a123a290da52157, 0x88f7843dbaf5d1e1, 0xe096a6d01b558075, 0xe1b8b574fee10332, 0x642a3b5e43d58ee3, 0xb23e72459088b620},
+		{0xb416f805b08aedf0, 0xb39cadcc8763ab35, 0x2aad70dae2a1b547, 0xc4ccee26c41ea02d, 0x8cc4e0aea020e819, 0xce19c4d24e8b094e, 0x12a6e6fed741ccd5, 0xd8444c9cb8a9a505},
+		{0x1856a2bb3d3521e9, 0x4e7286a3e2cb5e49, 0xa9e0559a3507ef2d, 0x45b32b55a59aed58, 0xafb3864137badba6, 0xff34658f35c9ae57, 0xfbe7c81afc793937, 0x6b1f08433b40d522},
+		{0xd37d07b988c56185, 0xe1707d162054717f, 0x75ed1e208b5200c2, 0x23cbb12b1a7a32f4, 0x42dc3583000c2768, 0xcf27eae176c2ddcf, 0x2deb8f4d0a420bfa, 0x2488c77b116cd158},
+		{0x4eb9db8ad19b3efe, 0x5ccaa869b6fa2796, 0x83f018b81b84d2b5, 0xe1424153e8ccdaff, 0xd505efc2a6f0261e, 0xb0afd5d46995d247, 0xaef5854988024c31, 0xfe8a2e986226d446},
+		{0x13389b4d916d8b30, 0xbb61066c53e3adaf, 0x6a64de9c52a6e0a6, 0x9400c138587e8bc, 0x67167bcafd32b065, 0xb16e835c28fb7a84, 0x3dca95231cb482eb, 0xc421c2c93315ef83},
+		{0xc294ff9490c7d409, 0x541de59d4be862ff, 0xe6dc7a29b54c6637, 0xbe78f0cd4d69ad6e, 0x325caf3871246227, 0xfad6104ff566b8b4, 0xe4144a47c2fba04d, 0xacca6a353a3edee6},
+		{0xb4e72b874372f19c, 0x803f6f9b3471ddbd, 0x820f4df9ae4ba4d, 0xd9a3d69212937055, 0x287c1dc5e3d59a75, 0xc8fa4b0ebe05bab0, 0x4350e9029e89c05c, 0x2a4b1a408d421869},
+	},
+	{
+		{0x472e3968c233e953, 0x1c45ee21fc87d728, 0xb3e4b0ac7afd029c, 0x48a5c6c230aecdac, 0x43b7f34f5de465f9, 0x21a5ec7a4bc742b0, 0x1f22f9382ceb7a9b, 0xa0ae9d2208a0867e},
+		{0x8134a9bb81551783, 0x416d384f588330c0, 0x94876f60637f2c44, 0xd0bc50d23e297d5a, 0xb0c308c846755d74, 0xb9d36414b95404bd, 0xfe21d7cfdc18beb9, 0xfee4f74a02e57fc2},
+		{0x978b53855b298d61, 0x7cd10a2a10acbfde, 0xe225eb45328d5f02, 0x9fa229a17114557, 0x1ee45919c753e8be, 0x6cf8504518337823, 0xb63b8bfa6da4b3cd, 0xe08994c46dd396b},
+		{0x7f7dcb2989b86cac, 0x26856130cf34fe91, 0x22065842d82cb82c, 0x71992d80b4adceb6, 0xfece4806f9d88870, 0x7a0a1c353e7065a8, 0x513318471f77bc2a, 0xdb49c8ea5e8ab62f},
+		{0x1f1783c82eb67a1a, 0x1654f8c81eecd75d, 0x53c71934e21a0157, 0xefaabf6bd7dfb719, 0xfaca5796d8c18a37, 0x80638fb7e104c284, 0x58fe6abeaf950e1b, 0x1f7c81c6e4f9cde5},
+		{0x536f3593c4365e3f, 0x230ecb18a24aa8b0, 0xf3eda832732d6b28, 0xc4a2f4ac5000a3b5, 0xe4a33d971349ff18, 0x5bf1614f721e411c, 0x4c50900cca5a0557, 0x755ee1e14142afb9},
+		{0xe212c702666575d9, 0x63b9c02fa61d79e5, 0xb5b7b5137b568f9c, 0x33ddced3e4e3c92b, 0x69827e76dc608186, 0x41c04a50dfcd0d03, 0x878c0cdcb054605e, 0x20ac8958a0551aac},
+		{0xdd8ccd6963b01c33, 0xd5d0241f56f9f077, 0xdb1f6b5ad59daffa, 0x847fdd74a24893e8, 0x1703e8d378ebb3ca, 0xcc8e4f142365233, 0xa9e74aab6845a28b, 0xb36433edbec4023e},
+		{0xdd093d389265d2ce, 0xe22970215ee265fe, 0x323a036a61725ed4, 0x58cdc0e409defc1f, 0xd70cadf7b0ce1533, 0x809335b1dd286af7, 0xbd78f49595caf9f7, 0x10cc3a37ec87aa35},
+		{0xbec1e9c6169e549d, 0x2dc12e75a0305982, 0x9e549802f2b04556, 0x5e609fe3776022a2, 0xef1947f6f94dde11, 0x6a91714b9e8745e6, 0xccd9adae9a73bd11, 0xcad12d3abea0c8f},
+		{0xb9d68820d68722cd, 0x897064ed3d924f46, 0xadbdad3e0244093a, 0x8e47c7a0736b4d1b, 0x8a073e76c1f5ed11, 0xa5c83c4d53da1d2f, 0x14286e9062db34bb, 0x6840bbc6cdb03e78},
+		{0x1bde7e6c91b20b2a, 0xea8096599749acde, 0x4ec5ff78687f7918, 0x504f4e795e3fdd42, 0x6eea988965e87667, 0xe7709203b5797d46, 0xd0ea2014940e3b9f, 0x8f0de6c483f8b1d1},
+		{0x53abcd52f995a101, 0x77c9a3a0b83dddcd, 0xb5523c6d5326d84b, 0x7268f50202b94a53, 0xe364896d9ca0128, 0xa12f28308b88322c, 0x893eefcb73e0f31a, 0xede9e520a8ff58d6},
+		{0x55b83a359b7e83e3, 0x8b5d27c5be3b1159, 0x786db66b55a46f98, 0x58b2fa84a064da2, 0x2d1cc7ef68ca5689, 0xd009e43ee670dc43, 0xb63627560b70dcac, 0xa30e6ccf6d328264},
+		{0x15d0b3fa2d9934c7, 0xb712dce76efb6a0e, 0x9d6795f19cd874b5, 0xa29353629872c351, 0x1ef9a7ab3d8b6edd, 0x24d8db021cdc978, 0x80655e8b3bdd90c7, 0x508cc949752332a6},
+		{0xe3aa5fba9a8c8607, 0x5ffc72f2114d0fc0, 0x71dd7d0f8f1e02fe, 0xf494d77a3f1a6551, 0x4345cb7ab3ffc04d, 0x25e12f4c624f29f7, 0x691b857d28050131, 0x8bfb5e6f0b2ec64e},
+		{0x81bac0fc215189eb, 0x4c96a72c0d208d8e, 0xe418513571afbb2c, 0xf49d42eb9f05a447, 0x61f4694376324926, 0xdff3739712eabf42, 0x73f5f23d922fc567, 0x800747fa6a15ad8d},
+		{0xaaec51271aa8dc5a, 0x575586a93c7164a8, 0xa1cb11573fcfb6cd, 0xe44a06df746d168d, 0x663b0c42b12f60e6, 0x9a5db12f24d6432d, 0x7dbfc7943cf30d3e, 0x31b4368592228b38},
+		{0xfc4ce7daf449b1e7, 0x62677cab35294c3c, 0x5af7e39527d8b4b8, 0x207dcca9ab9ae726, 0xb924c715ed2fc14b, 0x893e5a50a3a7b9eb, 0xbd72cb9c3a572d0, 0x7e5b3b7814d34a83},
+		{0x7ed56d6a5f43df11, 0x4a2c0f57af19fdf6, 0xd449f381c7cbf3c8, 0x517f6977ab04f6ca, 0xc4354188ec399ebc, 0x2b5645d30fdd76c4, 0xcf70683c84112117, 0xab43a2e2f856a220},
+		{0x2dfeb7c512ed2335, 0x441101fccb1d75f8, 0xfd1a65e7c006bfa, 0x4a7529167c114a13, 0xe179c1da03af7c88, 0x20ac3762dd656b6f, 0x93f3d63f1b9b668d, 0x32d4252cf948819f},
+		{0x4e6d55104d1d86f7, 0x3b66dfc4fd2d340c, 0x4838423aeddf6e5f, 0xf9a36bcd9691f0f8, 0x567acebef3a9f738, 0x9d298cceb61375c5, 0xd5747ab01e707ce2, 0x91175b7168b1a6ba},
+		{0x64b3d855ae1e1acb, 0x86597fb4ba59ce40, 0xcd83ce27930e996, 0xd474e2ee8b1ec6c2, 0xf1c24eabaf1f47d6, 0xb278337b8959f202, 0xccacea6a90b7c85c, 0x784ee8cbaa8d2ce7},
+		{0xd741430b137857d9, 0xa027e807bbd255c, 0xae0f08f017eaaf50, 0x33fede2455f128ee, 0xaf4a2b6287421281, 0x6ab6d845ced9883d, 0x29d71f1702b39f2c, 0x21638b06615a4b63},
+		{0xd7c53bbad2e41fb2, 0xbc413dda6b53ece4, 0xf6f4876f0acdb77e, 0xa8ec2f3641fb1797, 0x317af8c3f2cae575, 0x4f2d76dd2a03765, 0xbdb5227caf0200d7, 0x847a2deddd13c98d},
+		{0xc979431a7df7671, 0x6885c866ca5ae253, 0x819eb3b887c54a07, 0x300285e9ba81c333, 0xca60e9f3058610c5, 0x8f472656d18910f, 0xefc92e89d5cab983, 0xb3b450204c9181f0},
+		{0x26395d3d02ea9a75, 0x3f1f82f208994ec8, 0x60d93b96afc9a850, 0x9fb6505dd2c33a3f, 0x780ee0d28917f60d, 0x6f4e7acfeb638547, 0xa3de0520cd3adeb9, 0xe3df911f6ff40fdb},
+		{0xb232d05ac0c12554, 0xc8de2c78e3e8f7e4, 0x3524d4f921ea5bca, 0xc8b24388961a80e3, 0x74a1bc1864215deb, 0x790f1f117f4abc8, 0xf38e1633217e8592, 0x40814ebe982e7da8},
+		{0xc4aa469a85a4eb42, 0x439e8011c67fda9, 0xc6d42f4da5bb9d82, 0xf2abffa0205997e8, 0x6f37279c781cb794, 0x1936f6cbb3a30c34, 0x11a3ef7bed784fd5, 0x26f9df064b0ef914},
+		{0x9d1a65547d88d3c, 0xebcfa3ed36a39a55, 0xf53b87e86e9cf8d, 0xffbcaf6f5afad35b, 0x9e84a6a18fd44038, 0xb8dfa2496c90ca40, 0x7651d052b40c5212, 0x3158c5efa03bacf7},
+		{0xaacb7b934be2f63d, 0xa52f6e94141db1a7, 0x6cd4acc839ebb78d, 0xaca6a0bdb896fcdc, 0xd506452af82930b1, 0xfb3ddc69b5703be3, 0x759f163987fc9800, 0x4e8bee686f601616},
+		{0xfae4e46178964850, 0x39537bf281a085e6, 0x5472e1c1cff82673, 0xbc5dc4a89ddcc78b, 0xb4a546160501d758, 0xd08f1e762dc6a60c, 0x3d9d136df4f68423, 0x1d675f39cd58dd4c},
+		{0x263ab1cf57608c0a, 0x8ebbc6e69d05c616, 0x7323a125a13e576d, 0xd1ace6a54538d1e6, 0x833e14d3590c8747, 0x1255b25df996aa4f, 0xf4e71c8f2376916d, 0x933c9e8bc757288f},
+		{0x20d0095cc2d77dd1, 0x57902ca9cc3a5da8, 0x3a236d1b91aaa0f6, 0x863be367913b807f, 0x3cb0603d6eb2a184, 0x1334790e862da12, 0x8647c3d44a3298f2, 0xa1b404a7752a5a2a},
+		{0xb3482e1083d64534, 0x45600294e967ba0d, 0xf9a20c717c145b3b, 0x445ec37f1fe8bdc8, 0xded302c7bc82663c, 0xccb93ac4fa2bf272, 0x11022909faa033c3, 0x20da71b9b454f485},
+		{0x61b8e3b72de05089, 0xcdeb86ef1ef3c750, 0x6ece63b82af9dfb7, 0x9f59a0af7d9aec9e, 0x2c8f6c64ae377910, 0x5bc51ee24260dae1, 0xb1db62797e73d048, 0x405c3a195f5bd5eb},
+		{0x5045b9dec8efd99f, 0xfdc4e3ae212d5e26, 0xeddc661041bb1546, 0xc381d14e518484c8, 0xd806c506e8e135be, 0x4cd427835a384d1c, 0xed00a19317430426, 0x49120038335b04c2},
+		{0xb5e35e962386309, 0x96a318c1751287ce, 0xb520802e1b94963, 0x3e58d6fae5963223, 0xaa7dc8c9a6b8dff7, 0x41108fc8714ef920, 0x5cd508e50b088fbf, 0xaf267c742bb8eb07},
+		{0x88d7bd8f78d610e6, 0x58c65ad29b6bfeeb, 0x51879a440144ef61, 0xd7bd64e54dc49505, 0xc5a93d44caf62819, 0x2f45e23946e35951, 0xa2e8829a5fde54c2, 0xa126ebb617f47462},
+		{0xdb2da878c9739b0b, 0xb3de4d92365a3404, 0x4eb176403fa7557, 0x3f8581caff3c1750, 0xe85604c5d8c39bf7, 0xf6a6e30ec55e80ac, 0xb7d0a5642964a9e0, 0x999e0bb8e9a1cb4f},
+		{0x3ac125a723e3f487, 0x1315704c314f3d3f, 0xf9682cc2e9e4ae17, 0x9fa473c95c79212b, 0xac2e24fec441b5fd, 0x183bbd9c7f04d134, 0x7e4ca1737e820a4d, 0xacf00b003d927bc9},
+		{0xea24802dc91bd3a3, 0xf38b437f849d3623, 0xae44b9fa29062bcb, 0xe8bae652f2af276d, 0xdace24effaedb72e, 0xe9e66f647cdf85bf, 0x6868075c7c769bff, 0x581e494dd18f0b14},
+		{0x12b607c465eec94b, 0xe3cf3919f88958be, 0x2cd906c5ed2f25db, 0x27ef187286a1fb3, 0xbb42b0450b733b4e, 0x2399e873e1398592, 0x8efafdd5a713c115, 0xf4e16b8c70fc70e6},
+		{0xca119d2c8e10951a, 0x9330c253153228d1, 0x3874654592c69148, 0xb519d9e862896498, 0x92b61ce6613410b9, 0x3168b277e9da7b8b, 0x91b1b0573159db15, 0x69e2c4796de021b5},
+		{0xfd79607ae07b73b9, 0xaf7c261a634a3c6f, 0xb9a05c4410301bb5, 0xee1ad3b85988bc1e, 0xbe74d9817bda191, 0xcdc5da5d95706525, 0x332975d2bd9c5b3d, 0x548cc5b15da8c07b},
+		{0x65a6f8383998c9b9, 0xff1ee527118660c8, 0x9d59e874d337cd3c, 0x841ea28ab450cc2a, 0x97193c04df4ad49c, 0xf9137e9bd6ba55ff, 0x6486f5c120736e1b, 0x9dceaabfcc007618},
+		{0x6ea8de87dc0b90f0, 0xcb8b81437046635, 0xa6df9d6d57ed3b68, 0x9940acd754604cc5, 0xe02ab0c40cd66f83, 0xb25427540ce2c037, 0x4fff4e89dcb31407, 0xf2824824267be539},
+		{0xe00c62bdcabb19ac, 0x3fbecf6f9bead799, 0x1d999a9d02a6f738, 0x5d5def03f5da5457, 0x52fde98555a27048, 0x8eb3a8ceb71d88, 0x2691f7775ee4c2d7, 0x38713525a5434bd6},
+		{0xef70714ced77eada, 0x4640898cfc9a408a, 0x9132f5363a2b46d2, 0x8ac0104b0cfba1a0, 0x902e897c4456a254, 0xb5f3c1fb28273e84, 0x6c68299d865b7ef5, 0x81c6a000a460448f},
+		{0x18eaa031120b816e, 0xe899840c73495ff4, 0xe00c3b84634b3e32, 0x49a282c013d6a078, 0x6109ca5ef2862482, 0xf2e32608a5c2d4da, 0xd9ddd73b4ad03f92, 0xa0caa6a3612ea06c},
+		{0x42971094cfdfc76a, 0x7ccdcf66fadd3455, 0xe462abaa3092a005, 0x594eaf4d4e2299ff, 0x4f566c1be37d32b1, 0xa94f1c24c8ae1aaa, 0x6b61050e7e221e6, 0xdf0fafab6acc3be0},
+		{0xe1d5131575562f4d, 0x4a06738e290fdaf8, 0x47bb77f5ee1eb055, 0x46bb64a35df5b12c, 0x94c451a703ad869c, 0xbbd35244217971db, 0x6a959a08496f002, 0x7d80ca98808c4ca3},
+		{0x977cdea7569cecef, 0xf389d8afeb6d8c39, 0x1a2cc6e8956dae2b, 0x3ec9390c7d9254e7, 0xd7b72dac1fb16680, 0x86576ebd9b9b4c34, 0x5c17ede9c02aa12d, 0xeaa1891e34e8b711},
+		{0x1256ca6b7dc743fd, 0x5ea12960a2f9003e, 0xac0a8cf11ecdd5ea, 0x4ba0d9562fe4437e, 0x5e53527f764daecd, 0xa0dbfae8db343d78, 0x1a5b04d791151399, 0xc041c272dde43271},
+		{0x3aa084255ceda523, 0x2e1551e88c4f4429, 0xe40ef469e2a94072, 0x5cd9bdd235a2481e, 0x200da048873b902f, 0x38862cc742ce334d, 0xd7f67f37293c5ec, 0x7554b28f951e5332},
+		{0xb89043d125e57841, 0xfbfa892ee91a6cb, 0xc323caee626ab836, 0xa3e73387fbdf7555, 0x28efd60f80587e2d, 0x4dc5120c7ea812a6, 0x86cceeb28a806478, 0xdb7adc56af5ab3b},
+		{0x1a83aa130200358f, 0x4802dfc0855a2026, 0xeed966c0bbcc89c2, 0xcbce225f86181c49, 0x7955d670b4e25d95, 0x260dffbfc6bfe968, 0x57793534fb3c98da, 0x5b0831c9d47cc6cc},
+		{0x14aa6c6f61c32cea, 0x3c4e1793a52f7488, 0x6fdd2b446ad29024, 0xc9c3606859c855e2, 0x65fc36d098c0c248, 0x9908a265f670f653, 0x47ce3f5bf7365a4f, 0x48ca45e52847f9ab},
+		{0x52d920b6ac64a425, 0xdf1eed55a34e6741, 0x8aad2ebab2094d8c, 0x1e707a8214c09383, 0xd920b13deb2bc125, 0xa5535373e8163d3a, 0x58061344e48f2f04, 0x90b5d60fccb0f77e},
+		{0x64fb6ea3ae6aa4b2, 0x357ac46b7757438f, 0xa9b54373a5596f5, 0xb9e6cb60e31c0e47, 0xc2c25f4430126066, 0xdb6944cc8523635f, 0x2f3084fe016089c5, 0xa613354e90a8f5aa},
+		{0xde9c0152e4b43c3e, 0x6491f0451690a952, 0x6d781e2e66c85023, 0x7a61f8196103d25e, 0x725fa3d8350ac7a1, 0xeeab29e2c053c20e, 0xbad0dcbd73b5f9b2, 0x107452d4252feca9},
+		{0x1477343676f1e4a7, 0x74de10ee4db97e54, 0xdada74392ec78c38, 0x6d7d6dba71a50a5, 0xe25b0d4986618739, 0xa4ea7edfc703d80d, 0x9edd1d1d59c67df1, 0x79d68ecd2e22de19},
+		{0x4b742f5bd7ddd82b, 0x279672a2223ae0ef, 0xc8fdbaf739fa9eaf, 0x181d28c997451acd, 0xa9336207d7e590f, 0xba202b595dc0193a, 0xf0993825f19959f0, 0x20d1f7d11614896a},
+		{0x1fec8a791f867ba2, 0x5307f84082166c41, 0xe80814a16d42a84, 0x172baeb2ffcc54fe, 0xa6178e277f8af677, 0x8cd86367e5f8e323, 0xb168464f82ad7f7, 0xf65ccd1b2a3fd29a},
+	},
+	{
+		{0x9f30222b47476977, 0xaf4095525fe1d2dd, 0x9ef9133702cd5d56, 0xb3b436f386109b4e, 0xb4f8ebaac8dc00c, 0x349b5f127619927f, 0x98f120c4a670b81d, 0x2f2479a7c394b509},
+		{0xc7155e11fc3ee5c2, 0x36e8efe11ae69f50, 0x498e34dbf80108da, 0xdb5dcc909d6ace8d, 0x177d4c2716e08e66, 0x867cbd65a22d77dc, 0x42383b71d75a980c, 0x3651d4a0fd9485cf},
+		{0x1d2efd2e8575f04b, 0xe0d550b82c63fb78, 0x8792b2790492c198, 0x7dea745b805e85cc, 0x34a03e6d0c5a24f5, 0x5131b06cbcf27dc5, 0x74b716f934562966, 0xff2cc3cbd33346b},
+		{0x7ae64bdd58931210, 0x11c83d9116bb3c63, 0x9a59df143feff2a8, 0xedb4899e63846539, 0x568f71ca6bdebcb5, 0x301608c3e16a0a89, 0x74412ac455c86cb8, 0x99dec8fa9584483c},
+		{0x2adf83b80f85d558, 0x94bfadc9b1b87f4a, 0xce69c8c8b5468146, 0x420b6d16a4378315, 0x5df3280f23ae71d5, 0x5ade360316b8fffd, 0xe5d43bb3c39a69a4, 0x15cefeeb0a50b93c},
+		{0xbde02e4fe009df84, 0x6abaa813cab86d45, 0xff52fbfa978e9485, 0xd9173cc82faf7541, 0x430384a1413e5e3b, 0xad73a6c6920c215a, 0xf8f3cea3b0e9832, 0xbd2a6f41a8c1250b},
+		{0x3c771963f9bc94a6, 0xac3da318201f2537, 0xfdde3151fcf67cd1, 0x4a6644c1577d9e03, 0xb9b4119bd4b5f340, 0xc13b745b11de5394, 0x53a2121079d42c74, 0x8a8df15505a5171f},
+		{0x516b67896aa0f16, 0x34b4d1a0554c1816, 0x7850578c869054c7, 0xdb91452fc5c18d2a, 0xe631905933bb4be6, 0xdc6d375636f6db67, 0x4a66906fd4b3bd57, 0x4fe0b161ba240858},
+		{0x5fbf8a8b360e54cc, 0xc51a3506ee1ac2be, 0x155991e9f8ff2e60, 0xab28dc48f1589701, 0x9598f507e0e23903, 0x483680a391100a76, 0x5726b1cae40eac51, 0x658fafe9ee04013b},
+		{0x83da9bbb35bb642, 0xe56cce4f8f4b503, 0x2684ac39900ebe37, 0xdbb99975e626d4c1, 0x972aa6416199f47e, 0x1f632d86ae1086ee, 0xd8db5c76c8865cfb, 0x5daf7979055f519f},
+		{0x21129506d6bdde13, 0x9402ec0ebf1963a2, 0xdd8da2f2d808e07f, 0xe887d4ef1eec8f47, 0xe6eac8d374e176bd, 0x11435bd6bb97f23b, 0xf03aee482911744, 0x5433ef4550d9b44f},
+		{0x94712087df5b10b5, 0x30798b556112f85, 0xa742eb08c255dc1f, 0xeabc4e2ae3d90a07, 0xdba39195e026e5fc, 0x934be8bdc4ffc93b, 0xfd1ec65bf2fc2d44, 0xd4497c6702671f22},
+		{0x41b787d9512519e1, 0x6a985cc97f044ea2, 0xbc8f6404c8976e53, 0xe235891dad77705, 0xb32d405b6b3a9205, 0xe5c0b37d85be9c44, 0x88054d8436a6c349, 0x45377df14dafb098},
+		{0x9c157190abc5302a, 0x3848777b7bd9501c, 0xa04339695d5c48c, 0xc2fb72e1363d4078, 0x55d795764c0ba40c, 0xec9ba00ea0ad3017, 0xf82dafecca78aeb9, 0xc4573a142d2ac7fa},
+		{0xeb81f857c2b53ccf, 0x4ade06b417c36bed, 0xb6ecb2169cbd3ed7, 0xaca176b18fb3ffa3, 0x6df1c38ce1c0eefa, 0xeeb516caf7411789, 0x735a64484bf09177, 0xbd37b4dcf6fe27aa},
+		{0x4c9febeee681fc57, 0x3ca15e1a7571da2d, 0x5bc44dd90c0933a0, 0x4b719c4b0e32ec23, 0x87a10456841cd070, 0x98ef30c7e63a46cd, 0xfb2a86d239dba643, 0x4dcc69a460568d97},
+		{0x2ceb2044a11f622b, 0x93e5990b8ca2cce8, 0x62a59d5ce2393384, 0x4e97170cdeafabe7, 0x4eaa072d98bf7067, 0x5577b2103cb92c04, 0x7732865de113d0d7, 0x5226d54d49ae077},
+		{0x49560f3643a69a0f, 0xf3caa5fdaef7d9ea, 0x23a5a6df7b7e689d, 0x8e45fd6052d2b9a6, 0xd68a71c98a6467ea, 0x81c8cc22fffcb9b2, 0xfe280ba0888c6aa8, 0x9b641c36f7471074},
+		{0x533c13d8e282e9b0, 0xb865b896edf2d260, 0x50babc32912cbde8, 0x503ed20937ac87e, 0xf0bbb32421a9c307, 0x2142e600c26c7ad9, 0x471fcb0c5b7f7064, 0x7a4b49bd5a1accab},
+		{0x846595643ec99f6, 0xfae2a43531d03d0b, 0xb0a8dbd7efb2238a, 0x1c212b690b91150a, 0xe90f38d23ec81224, 0x712f9ba33bcf07d8, 0xce19b841487a4daa, 0xe810666279980913},
+		{0x47ffc10cdb279690, 0x93db057aaa87cda4, 0x6541f7eac4830011, 0x772d30c9ef676db8, 0xadc954dae7d13ffc, 0x731fe50253034418, 0x995361f7148e75bf, 0x1bdbb592f469b754},
+		{0x93d09cc316c8014d, 0x2e4c88b0ead730bb, 0xd0bac14e4163e32e, 0xf52098d664450f71, 0x6a3bfeb1b96d8d31, 0xd5d6ffe4c375bc14, 0x4a12a0c2b373dd02, 0x249e02d79518c33a},
+		{0x3ca0b22085ebeaf2, 0x742895fd29029fb7, 0x9f119410a253fd83, 0x9daf9f58e1704b5, 0x930877e5c295a81a, 0xcb56bcd00f83d447, 0x2a63c24cb9e2fe68, 0x90ed4461183b75d4},
+		{0x7ddeaf2b7d882bed, 0x6c0fe7a87d9baec9, 0xab488161c0224e1a, 0x28cef9d994991ed5, 0xe1a962bb5b04c218, 0x987a2191a56f2279, 0x53c93354b5676215, 0x25ee030a35319763},
+		{0x6739673357ee0eff, 0x27ab0a57df414e3c, 0x587e2c91bd7b23a0, 0x4b13082fc1a1b8bd, 0x17f320f8c94dcbe2, 0x8ec20077386cc92c, 0xd89c2a86bb52a4a8, 0xc436620242370ab0},
+		{0xbe0b83dfac6eac67, 0x74e26ae7ddda0224, 0x1948d17241b50845, 0x60ee25322ca0d49a, 0xe79db987b82c3346, 0x29e5872f2df5ce5d, 0x131b2a31d099ce8e, 0xa51a8c5ab83ca58b},
+		{0xe57894dd3e6585ce, 0x43dad0c8e35f4804, 0x4195e0d10f72eba0, 0xa45a3a3f0898b2bb, 0xe42716f42146faee, 0x48b5f5383a1f3680, 0xf3204cf76c94f650, 0xdb384853db6d5b9a},
+		{0x876abf3c8bb76e73, 0xa96c0273b9633b88, 0x4d265faa3afaae6e, 0x65f19893d349eaf7, 0x9d109b402cfc1fa9, 0x1933ae6e9aad21e0, 0x2961eaa120c78254, 0x1267b62245cc935d},
+		{0x5dccf14b5ee7ab51, 0xa6be15635c02bcc1, 0xc683250209884514, 0x50020d0eb03acb4d, 0x7e25bfa859a2d633, 0x54ce2ab4b84b434e, 0x9c1c041f546e388e, 0x69a8c12ce649a189},
+		{0xf6f5671c97251e17, 0xa4f4f5ee6626374, 0x441eab67d390b5b7, 0x48987f7f4e12fd90, 0xcc4064692c0c3581, 0xb3ae2f6576ddd81c, 0x92b8652179aa7f21, 0x4d5b87502b5c07c0},
+		{0x9f57fa7f15e64d60, 0x5333ac9677d2322c, 0xe2fb98bbe2e3d7d0, 0xdb03dd6f56a82472, 0x13c3adef0553d281, 0x6e35d9820ecf99dd, 0x7aa970114f806cfe, 0xe6de104abad8deb6},
+		{0xb80af0906c8066e8, 0x97a22c7204b2467a, 0xbf4576c67eaeeb58, 0xe482e6b2776c9e90, 0x8d3202c7cf628469, 0xa09e939fe29ee3bf, 0xbc51f720cf896cd1, 0xd0a389eaa41700d1},
+		{0x334e37380136b7df, 0xc3100c41bec351a5, 0xc3221610fbf30d8c, 0xc2e263cd1e3f600c, 0xe3b407fb21cb6043, 0xbc19f2b35a976412, 0x3704cdda6d965d63, 0x849102c03ce62d0c},
+		{0xc1c521a912d2085f, 0xe90b0861134bb93d, 0xd2dbdf424bb270ca, 0x5bb7aaf0a20f897, 0x4826158a85bac07c, 0x908e5f77e90e2ce1, 0xc2f9281892fdb2, 0xd5fd22efc12d46e6},
+		{0xd1aa5323e3805844, 0xaa1e7344b156f780, 0xb880ed6a46fb87ea, 0x6e6854cb7ce153f6, 0xb19e064257a31fa4, 0x52f0df746e31ecc4, 0xa1e60ad9a9f4a58e, 0xb2f3365a68651cc6},
+		{0xa4e49e0ed591b62b, 0xae8cea35916b1c6d, 0xbe38c25f59837863, 0xc1461f7778e09d7a, 0xb9d08e3d0db3f0fb, 0x8ed630203af8e750, 0x8057196a8e7d5d10, 0x39e73d3991183347},
+		{0x2753b9537548ad0a, 0x330ce5446f61b1bc, 0x59f9cc5be72e6c29, 0xa0cb156092f6f0c7, 0x22222d76ff5248d0, 0xfcebcf73702a2087, 0xdfc751feab033ac9, 0x5e067b662fc1686e},
+		{0x2e3016c64c8b17e6, 0xb907fd4928deec1d, 0x897f2f2ab850b9a9, 0x4173ef37ffaeffb0, 0xaa6f1f4f141c3179, 0xbda0d12118755b2e, 0x9d0e9d655ca31b8f, 0xebe30f1d116b1b32},
+		{0xa89d485b1512f2dc, 0xd52ae66cc504a64, 0xb3c200d471cdeb92, 0xa18d99246c9a9800, 0x52ad62b71b891e07, 0xabd5832eb8857145, 0x69f8c661e6f00363, 0xe38175cad91ce096},
+		{0x5b62676f59e44538, 0xa8c0c67382e0d232, 0x3f591741d3ef96e9, 0xf25d272c8b2fb139, 0xd608de781e8ae333, 0xd5c589992d6f1d1c, 0xeaddd92b5d6b4f68, 0xfe7f100cf1b55cb9},
+		{0xc871a22eceed4bd8, 0x8b255603b5596ed9, 0x1d393bb4c9a84c00, 0x4f5a3b15b58605ad, 0xd2918082ad52f5eb, 0xa6d106970975a0fb, 0x5db490d6ff99d0ab, 0xc249dec8ab1b8712},
+		{0xfbb715f284a0fad8, 0x45f0ae447fe971f8, 0x604d55acc45861f2, 0x3c09c8cb9d5b50f2, 0xdbc018ff373cafd6, 0xa37121f7c8da6ad0, 0x8af23b4be99177c7, 0x47a7ea20ffe33d31},
+		{0x1c41bdd943c7088c, 0x15cfde5a73618070, 0x6d4c8e98eed78396, 0x518e7ca1ed34b24e, 0xa30c7b797bf11299, 0xffe246f0cefdf3fd, 0xca17d5e346045745, 0x6c0b7ae1072ebb76},
+		{0x7fb19941448ef18a, 0xc90a80899e524d28, 0xd89d7aed8f08de30, 0x75e3ff5a6c3a10df, 0x38aa270f669cdeeb, 0x687a7643627f9177, 0x736af1100c50062, 0x7de2e30c45b73df1},
+		{0x9f69755e500c3322, 0x5111ad793aded278, 0x70e9ad72923a2313, 0x4160793d40efe0d6, 0xd53b85742588c5bc, 0xc142452e1d2b49a4, 0x2f78ffc7e3e5cc7a, 0xd642a88e4ff97582},
+		{0xe02189ab69cce672, 0x3effe3da87a1648, 0x4b0faf673fee795d, 0x59bf70ff2561de9d, 0x7686b33828725e1e, 0xeca64093b2fe9e82, 0x72f6d036ca761896, 0x395886e490efd62a},
+		{0xa25e8f2715acbd53, 0x8463795b5626b1d8, 0x1db8eab6c4aa8c3f, 0x370689ff20b0e55c, 0x6c373db287ca9ea6, 0xd15fdddf5632bd0, 0x973e3b6633ae4db4, 0x9574cae0aef792f},
+		{0x61bc01464bbb60c0, 0x1d8ffdeae7def0ad, 0x162e2a8fb699d2ef, 0x888094a786248df6, 0x1f5b984b2f62cea3, 0xbc687bd183326731, 0x72cc1a9363a80685, 0x879f908b26c8eac2},
+		{0x86dd8dd9aa8bc994, 0xbb8e91ca9674ebd3, 0xead02b31c284d609, 0x80542cb6d340181a, 0x9e58cba120753e50, 0x5d83f934db851ee3, 0x8a19d66e25ab4d4b, 0x71d26b93aa1a094d},
+		{0x72f83ffdd522ef8a, 0x5ab96b93381a53da, 0xc689c790bd14708b, 0x639f7667d46a8dc8, 0x1ebbe6ccd1fa5259, 0x3e30c5c568f5311e, 0x8b2d5470ae147b81, 0x21493eddbd0318b5},
+		{0x24184c4eb4fd9ddf, 0x481d606a93dbf27b, 0xc878b3a8f57c1b6c, 0x5c5c929fdc27ee0e, 0x79aec515ca6f13c5, 0x73f8ab205e07c942, 0x2ce1eb7cd3f75d76, 0x7d5d1728f2b6750},
+		{0xa2331603c4894c88, 0x1099f1e4fafc6838, 0x13e84ae5596452f4, 0xca335073b522fa7a, 0x1042e76b8a2852fc, 0xe4d61046b6566805, 0xc4d44827cc10d1cb, 0xa4cb819e64595a1f},
+		{0x1b21e383ee3e5ef0, 0x351b2c90f5ab6c7c, 0x946567268d9f8793, 0xacb59f668199941c, 0x208c3d295d7c5ca6, 0x6aefff556e6777d, 0xc8a0b56ed8aae937, 0x61e170e70250b2c8},
+		{0x5d22d5473541d43b, 0xe811c6791db712be, 0x1a787217383024bb, 0x70900354175a8ad3, 0x101861265d608c93, 0xcd6520955be6274f, 0xf8ca5c2a81119024, 0x654e130b119b0393},
+		{0xc96b49ec50afc8cf, 0xdb5b44afde8c496c, 0x495cb946de134304, 0x371207e4e8016ce1, 0x8dd30a94b1b9b51d, 0x3b91f6bc3b73e026, 0xd5d24c7211f03978, 0xc5ec1ef02157f059},
+		{0xb0a0a6908f2208e4, 0x14b16c6b9b6fd64c, 0x4c68dda30281a244, 0x8bb57a7c4dd71410, 0xb4524a57e29842c, 0xafb6e67f78343757, 0x2752bbb2dd29fc47, 0x5c73d1de02d8396e},
+		{0x3b61d794fe2d574e, 0x42ea51f9d484c282, 0x7a49d9195390443a, 0x7bbb1eb8aa96ca9d, 0xababee31595fc985, 0xda1f1aeb61fb123e, 0x6da531eb24000e9c, 0x14c7c333be170c6d},
+		{0xf37475f2c5ba6f4, 0xddf088b789aa04d2, 0x3b827fdc674a4618, 0x65cbd7c5f0ed128a, 0xe7d1726c40cc7481, 0x8455ec65d4848a1f, 0xb8852f79610d114f, 0x60a716a3b53360cb},
+		{0x9919512106750444, 0xbb86892b15467622, 0x526f5a052c8aa4c8, 0x59960d39d3b7127c, 0xdf8f80a401379749, 0xa9299b648dd53b16, 0x45aaaeb47e850691, 0x82e0d0d0ca89a67},
+		{0xaf6046edae427193, 0x4cb3a481eb49c106, 0x975415072e46e17, 0xa6f4751fd51761b3, 0x8b06f1f6282d5c29, 0x5bb86b9187664e33, 0x8d50e38a31babda7, 0xa01e0b075830c139},
+		{0xcd54f06243d24eaa, 0xa06133ea9f8172fc, 0x7bbd1e19e933941a, 0x68b82a73235f3e72, 0xa7f89f0ec8cf1222, 0x4b5c517c9741028c, 0x3b1b4d1b3a7c5811, 0x36f4274ffbd807cc},
+		{0xd5cb72c80ddce0c0, 0xaf3fa02a90e396e1, 0x7f7a2f0e5b76b415, 0x38118043a832ec50, 0x4cb39eb415448999, 0x9596cf7ce34bf4af, 0x6901e9d920cf6e65, 0xf4e18e834386d23a},
+		{0x2ebc2d2a7afbf5ee, 0x148896d3716cec09, 0xf02040ce67672550, 0xbd98c68106de109c, 0xb9c2a1ed3c3926cf, 0x96a408cb87656ad7, 0x64e6d6b7e4d584f7, 0x593abfa232a48a90},
+		{0x759f20da04cd2062, 0x5d320f475525740b, 0xa251f3296128910e, 0x5a20c7c5f8d097c1, 0xd6bccbb9ecb3dff4, 0x575f7617a545f6bd, 0xc59416f09b5117e5, 0x8dfcdacf4a26565b},
+	},
+	{
+		{0xb6be30d6f8690928, 0x88b68810434ea2e8, 0x3bd453bf4619fa9d, 0xf4f354d265a85a7d, 0x91c6edb76254c4d6, 0xae770d165317107e, 0xccadedeaa81c00a8, 0xe646640ced889ed1},
+		{0x8244d9921bcf53a5, 0xc895dce20f4b77c7, 0xa04260ba53778ceb, 0xe309d31c9eaaaa3, 0x240597499194ea30, 0xfc23cf5034d6dd6e, 0x82ef6c7b810daad0, 0xc55aba338ed9675b},
+		{0x2f836f4bae9711a4, 0x4827e23c946948d4, 0x731a8af3a2f3bfb2, 0xaeb7decb048b4fef, 0xa80f051deda16508, 0x9f0473b70732396e, 0xc30d117f6d74fc, 0x2d2c25ffae9bed5f},
+		{0x577e5ced3e899cbb, 0x3d6888b5a8588455, 0x83de9e9fec08f299, 0x1a56e5f18cac68fb, 0x61651b669fb95ffe, 0x8859922459bb331b, 0x33488c0785fd724, 0xa06ea37368415cfe},
+		{0xede9eb372d4a2918, 0x89096f0ffc6c223e, 0xd7264a9b3aea7573, 0x3fb7e07aa89d09cd, 0x6e4cb09cdeb0ad5e, 0x93e07bc92ae9dd26, 0x570dcaf4764864a8, 0xd8fb757c0e643a34},
+		{0x363ae60ae25492d, 0x3390c418a1a306fc, 0x7202d60d20835f2c, 0x5796d9103e9b3b75, 0x530f8bdbf1273816, 0x3f52ce7811d3a2a4, 0x77f4965707ccf581, 0x336db2fc21faed0c},
+		{0xadbd97e7fd5b282d, 0xa0e356ad3ed65c04, 0x99c1d5c70d733e7, 0x1d7525077779e3dc, 0x184277a0eaa4ee89, 0xe530fe6bb0ea46c1, 0x8976c76d80b21c77, 0x4a30f9d6e06af5ba},
+		{0x218e4cf6ddd920e2, 0xb7ab6168e33fe3fc, 0xaf59936a70c73d92, 0xce6f2ebf7765e7d2, 0xfac7ca4af78310e, 0x28f2746f29c82141, 0x19acbb1fd3023fb1, 0x82c14fe3b020efd5},
+		{0x822288422004c9d0, 0xc2c8daed521bb9d, 0xd60ec04c5f919e5f, 0xcad312afdd1b652a, 0xd8fed6665f2597c6, 0x1bb990e3ea0c481c, 0x88e2c93ee7a81f43, 0xfe2559437b22d678},
+		{0x5cd697dc48ad6d7d, 0xe88fd6acde224a61, 0x84e347d9886ed1e5, 0x3d43dcb8e3082e7d, 0x170e361e6bc259ab, 0x2464fbbcec0ecc92, 0x31e134ffe7388151, 0xcc2d17f211362fbb},
+		{0xa36586aead8b0f9, 0x3217cc4644ceefe0, 0xbd03600accb0edec, 0xfc0b549d796470d, 0xd17e787546d8d5ff, 0x39b28f9bcb5ff667, 0x27b9196ed5abb239, 0xabf5973b5cc1fe6a},
+		{0x84913c7430e5af6f, 0xde044a8ad5eb7b3, 0xb39873cc19e8c646, 0x7f155b2e03456b6b, 0x14c1b4b6c22a9e9e, 0x8a12bd0576889be7, 0x2d8c6c9c66d3daf1, 0xb3ac7d9c8d946e44},
+		{0x9522bff075ff8cad, 0xf27e996160273c72, 0x5c540e79fccc5137, 0x9034209e0a335061, 0x5a44c1fd509aa076, 0x209be2f10d50fcee, 0x583f88acf5d9b23, 0x2e5eb222ccb9e16a},
+		{0x72e68b8686535f2d, 0x583b7fb4c8f0b264, 0x4d62434c9d68c08f, 0x74baeb72f79ff8a4, 0xe9f7ee18d8c65acf, 0x4accab628ac74287, 0xecfc8a34a03ddcec, 0x5ca7fd8b9c537e0b},
+		{0xe53bf4907b97a61c, 0xb3c2dea1dca30aea, 0x4497b661eb14402b, 0xfc263192bc2dae50, 0xb88d6da170deda1a, 0x3308ea4b64430a12, 0x2668a000f345cdee, 0x954d781ab912e513},
+		{0xde95dbe8a441f93c, 0xb3261b7a2f1fe318, 0x59bce25548c495d8, 0x240c45d2aa1952d5, 0xa6429a753ea6deb9, 0xe184f9aab7598af6, 0x6389e41f16582a58, 0x8cababb9a214490b},
+		{0xc55bb94fcb256870, 0x51a753304d7d4622, 0xc393817f6b6f9666, 0x9770c730e39c3634, 0xa1ce8b012c47246e, 0x4f75c81e4a5cf1d1, 0x45cb037214a00cff, 0x32c560a447a12558},
+		{0x99028ceeceb764cb, 0x4c47f2da7d954ca, 0xc262684aa9847069, 0xdc0fcde9d2e772c2, 0x71ddb49793e542b6, 0x8ad27fc0f643930e, 0xb29ea4342ee20f91, 0x1ddf113097ce42b4},
+		{0xfe01de64b5b31f9c, 0xa63a646bcaaa34d0, 0x92b4f76baed9c9e0, 0x82532241f089a21f, 0x114b1378935aa1ab, 0x3ac2e475bd813273, 0x6916bfa49e55ee8f, 0xfc2022ab15075c5b},
+		{0x127df5b134c38003, 0xacba29aac4f117b6, 0x4bd9314761939563, 0x71f931fab6ce1656, 0x43fff5d321a82ea9, 0x74dc0b93e0969ebf, 0x7ff61a6ae894fabe, 0x38699c014ec4c29d},
+		{0x43a592f54440da81, 0xa93cd23e5f81458, 0x44e769513578cbcc, 0x4962db996e6ef5e, 0xc2986e50662091d, 0x881fe41454d2db6d, 0xfa692161eea7f7a6, 0x8c8f5107626b7372},
+		{0x822e84c64dc7468, 0xdc9b7586c46e7406, 0x27b17006131f63c9, 0x708de0d2681bd5ef, 0x4712ec633cd89490, 0x64d3b68488171856, 0x98bd288a9d117943, 0x23c065d3762b1825},
+		{0x11d4e6995c886ba0, 0x1e3d010874d4eccc, 0x37e721bc200eaea2, 0x5b61196f1f5fb331, 0x486ab022d472ff0a, 0xa97f7999076149ed, 0x96685e8ed4833a1f, 0x416ec4f8169491b0},
+		{0x36b9acd9a703c4f3, 0x50140c53c4f38112, 0x5936a9770a48e9b1, 0x97c088bdbf6f1acc, 0x7500fb4188094ee2, 0xb1f4dbf4abcb22f0, 0x6577e1280f4b7387, 0x6a77b3116649544a},
+		{0xee047ffb6ca530f5, 0x2d11ace24a2cdbce, 0xa9b2d263e79a2f1, 0xdaf50fd2d2fbd0c9, 0x40cbee99a9593ebf, 0xe340d76399d2012a, 0xbba8d8cc7c27dbc3, 0xfe948cdeebb9037d},
+		{0x811998af20ce217d, 0x6377d0dc53bd3cd7, 0x458078aa33f3838a, 0xc41a82683414f8de, 0xc681cbecceba792d, 0x9293b15fc5dca604, 0xd850bdf20f6aa746, 0x567a4f209c261990},
+		{0x4165c0edda598b04, 0xa6540a8445a4daa8, 0x9ce6d1c260508005, 0xe5dfbb898036b44, 0x9cf9136a87177ad9, 0xd67fdc514b752ed4, 0xc585cec662c6cc82, 0xb7a3cfaa972fc5eb},
+		{0xcbdf374171e0ed37, 0x30ffb643dc10fcc0, 0xddfa44417f7073dd, 0xcd68bbf9d0cd951a, 0xc5ac224df2c637d2, 0xfc773688e7a226c3, 0x260e3231ff38eb6e, 0xccaad81040d7a695},
+		{0xbd8c4d4fcc8c24a6, 0x4dc983b6179d066e, 0x1a223fbf409d9a16, 0xcea924b96ac88aa, 0xa3ea76a89001e71, 0xad4d56a204f402f8, 0x912a377ead30e520, 0x128554b810431efc},
+		{0x3f31fc5575f83b3d, 0x675fdb8e5bfe8203, 0x6c65439d8850b48c, 0xff457c8c4bc87a73, 0x959cd11b32eb827f, 0x5fc644c44b70edc9, 0x6f64e7ea2c0f416f, 0xf95323e5557cede4},
+		{0xce7d8bbedc030235, 0x218f92dd67de5853, 0x2ac0395a9ce35622, 0x44dc53c4151400d8, 0x1440d7c73c90dac2, 0x80ee048e33a06036, 0xdfa8538ace1a85fd, 0x2ba22601dcc4dc45},
+		{0x73d59803112c576c, 0x357163ef61687524, 0x1298d0f9947bf8e3, 0xf7cd50f30fd6e58d, 0x816c0d847c212af9, 0x958bb7c2ec0c9a6e, 0x4e708074db8feded, 0x31a3f507ad1fadb7},
+		{0x8e0fa44fd5467d22, 0x4b8de6a1befbff78, 0x97b6ca729cc9cb48, 0x5601875558622a33, 0x239dc84b59ea3dfa, 0xc82795c1617b7a5f, 0xee0f42274d9a43b9, 0x5bb98f0ac9cc8814},
+		{0x23976a9540601f48, 0xf7fafb0a46b25fe1, 0xe45cb453b59ef331, 0x39c4418f36b79ea3, 0x723226b546a52f1d, 0xd7bd3923e5815d5f, 0xc3cf7f25f6f4ee6, 0x82cfe65e076c0010},
+		{0xad76d5bc04855ecc, 0xa84f358e083265a6, 0x4f9002b8cfb8ac6f, 0xfabdbcbff587195f, 0x53844b642f0b2aa, 0xd893eefbd59d1060, 0xf74f0bab9d005d3c, 0x7ec771698265a9a7},
+		{0xf293a630dfb3008f, 0x76c2e006ca8576c6, 0x6d1cd9d162383df0, 0xa7e4ae18dca67406, 0x35d0b655e63e4328, 0xabf414df81ab3895, 0xf7647322dfa91f1, 0xd14d728a0ddd15e5},
+		{0x74ff315d2bb5c254, 0x5d7dfd9400fe150d, 0x499d58acbaf14be0, 0xe01f959758e9f644, 0x8ea2ae5bd4e5c5a2, 0x4ab0d544c8301915, 0xb05c2ab9ed72d4a9, 0x59f139891de1ed71},
+		{0x2d71d5c26647d1d3, 0xd010db341185974a, 0xfb5c9d505eb791a, 0x1c0b71112b062c03, 0x62974f6d8441ec9e, 0x5059f5ab677b7f75, 0x7cdf257903ce93fc, 0x6b9ff1192df2732c},
+		{0x932ec87323a9e487, 0x2c8cbdd85cb9fec4, 0x55f5ac454e381182, 0xe5764be1f0c5cbea, 0x5c3c18e3b0e69e4a, 0xbaeaa031b3498af3, 0xe115f3bc8baa4a46, 0x4a9ba0073c7fc2cd},
+		{0xf8d341d51c69cdd3, 0xbeb31bf3df484fb4, 0x8e675e1bb601cebd, 0xb057a78767ae7596, 0x47c1c338b6503386, 0x653cde57209f1561, 0x15ffd0b5c694cb29, 0x835620e7a249c3af},
+		{0xb8c4ce5292b3ba7, 0xb77649317be424d2, 0x7a286b2a1c354fdf, 0x159b832c0fac20, 0x65f700d3823507a4, 0x90339a23abac2e47, 0x422a7989aa89014d, 0x64ea745a32f176f8},
+		{0x166c77c04dee3436, 0xb6a080af0a86c198, 0xdc7cb63a6e9e5206, 0xb8ea9e28cf988f96, 0x742a29a58df66af9, 0x220c60675c077958, 0x3fd239d18c17a226, 0xb4d093581800ec6a},
+		{0x137093f507c3106d, 0x16a73191dbda766, 0xb0974ab4a24210c6, 0x1f7d80509a310ca3, 0xcdcd33039204f54e, 0xdb4eed16bd1c5ba, 0x9a73f97bb898af01, 0x3d41acbd4ad3a5b7},
+		{0x25c5c814e61d996e, 0x121c41580371cfff, 0x76f089d91fe728ff, 0x4e2673bd654e0a32, 0x2fd3205e47b15643, 0x6816f258af3f9393, 0xb62d1f6bae6dd8d9, 0x8881838cb76d8289},
+		{0xac8483a4d4d3dc74, 0x2caa43b6ab01ab51, 0x3c7437f529dcaa74, 0x1b7794816b5763de, 0xa4550de8cf4c33ab, 0xcaedf51a2a0acd75, 0x492a958f62a2b21a, 0x54b1fa47eb57765f},
+		{0x8a9ef5ac4992c3ae, 0x2637e643b29d38cc, 0x3054c64e4cabcab7, 0x609c7c932aae3d72, 0xb4b34b3ed4282e2f, 0x3af5b9537ee6a8d5, 0xfa5a852dfe0f01d6, 0xe3c716abecc2db27},
+		{0x67f0633c17181c46, 0x5258113f09d807ae, 0xcc6ce8439e708cd7, 0x4a23de0727430544, 0xb39a04be466385b6, 0xdb6d4013f2092cab, 0x96188514c01541e6, 0xe28c30371ca38a7},
+		{0x155bdac307020d4d, 0xa9a8dbc136db4511, 0x17c15861d71ac3f9, 0xef3e622a11653665, 0xff3145d55ffb0a93, 0x40a4cb433ae4e429, 0x7bbc79e1e4d4339d, 0xfa2e2b7d85d21a0},
+		{0x12179db8f474b827, 0xeb860a3b5e1e0cb6, 0x39bdec6934bb9088, 0x3b7011958f28c8e3, 0xa0d1a483b2e23de3, 0x2102308ab01e9e84, 0xbd7388049bb8ecd6, 0x2ff7b9fc3eb91df1},
+		{0x120f10be755045cf, 0xa68f47025503ee2b, 0x33d81b6720d29fcd, 0x4a7deae34577d06, 0xbc686cf3bc2f3971, 0x42e9d99249093371, 0x53b963de97d1a781, 0x64a8ba9799719a13},
+		{0x68cd238516fb3034, 0x37e38d3f5b5cbfab, 0xf34bb64a9b375dac, 0xbdfa1a2b82fb7cd9, 0x937bfd3b3b9dbdd, 0x9b98d444b332d6fb, 0xbfb93842f43d6877, 0x9e35d72ad5ceaac},
+		{0xe158eccc8f2ee525, 0xbd99a5a9265c317, 0x2bbb4f2807594888, 0x98f77b9cd18bd17b, 0x69e509d98ba4037d, 0x806447abee43f9c8, 0xdb662e6f60ec2d71, 0x7285d37bd221aa69},
+		{0xd0142a2ff37ad124, 0x832fc21392f0a9bb, 0xedb8a858004b48c5, 0x1ae5963d64e98c61, 0x68ceed68b8f5b8c2, 0x1655815ad38a93d0, 0x57e21b1642ff739, 0xd9d6797930e8f132},
+		{0x8595334f8b7d1266, 0x4899937d486cb205, 0xeb8c30f17033dc5d, 0x86c8bdb0fbdb82bf, 0x85012f5dcb0c2caa, 0x4510bbc9ff58f38c, 0xf3989a675864e6c8, 0x28de0975a34a034f},
+		{0x256ab9a690da9114, 0xe2678c1a532f4de3, 0xe8ac7d0ce600b4e5, 0x8b1bf1f059fdaba6, 0xb98812872bee945d, 0xcaa7ad5cf4eb2178, 0xa24f5523b26765f5, 0xc02bab0140f45d6e},
+		{0x46511da39f44db76, 0x64093b424eb9b49f, 0xa71930f8a310b427, 0x3c1ae1dd0657d460, 0x7b38b01ba34aa9c5, 0xaeca187664d2eebb, 0x1c7ccfa42e76c0d1, 0x7190b9807a125984},
+		{0x33816c7e3fe81a5, 0x5e3458415e18fcc6, 0xb30887e1e547fa82, 0x3d07f521eb28fcb1, 0x6eef33ed28b58ebf, 0x9ec9bdcba09a5f24, 0xd6d31d5ee0a205a5, 0xb68653933197ed81},
+		{0x9e1537b081d4ecea, 0xa65d765224513059, 0x56ea6bc8d7e5f549, 0xdea884ebd74eee2a, 0x19cd267c35cd384b, 0x44e6b65fde0f127d, 0xf014a77de20ddaa5, 0x64d179729dc0d282},
+		{0xb1287e0285ad2c9e, 0xb4bd080f01d7a6d7, 0x8fe69adb0178ce23, 0xfd602c4241ffa533, 0x52413ea2f029b557, 0x7507634748a8f36d, 0xa7820ec60f085761, 0x1ed8127caf0283e7},
+		{0x1dc99795b25cc424, 0x41d7cae902ad9ee2, 0xf83a619e47d2d397, 0xd03fea44f0c2333b, 0xdb7b6a2aea027a27, 0x399ff93771b3733c, 0x92feb7949e1e4a45, 0xbbdfbd3bf3e2ed3d},
+		{0xc81f3bc39834d6cb, 0xb7b2fc35e104c527, 0x5c5a25bf3065413c, 0xd86f4c9345e95fb3, 0x5b3123caa715d63c, 0xf71b669cbdf0e5c8, 0x4adca25934296551, 0xe49b6455bd4fd0e7},
+		{0x3e8947363a60c80b, 0x4d7e369093e134ea, 0x681ae237f360fa3d, 0x93c6c95e71b3950c, 0xdda98e72fe746960, 0x8d82bb521215ac3, 0x69ab1fc57377683e, 0x6395cd44f68221},
+		{0x397b4dbb06322bc5, 0xc7f6090a4fccb8c0, 0x8e9351415b0e2b4b, 0x9f10f208d5c045fe, 0x560238a1d5bebc40, 0x8774445ee27c4f44, 0xe495b477a8d8e964, 0xc9cce83b25306764},
+		{0x897a05bbc2fb31c5, 0x58568b61b324c215, 0x92ac812e00b221e8, 0xcbbbe91762a87d77, 0x1314dfcab9401cdf, 0xb6314c0712763a1e, 0xfb692f71cbb1b867, 0x645db35eab51644},
+	},
+	{
+		{0xb6b50eda272e9751, 0x3fe27c6a195edbf5, 0x9cdd9c462ef8a9cf, 0x4c276680ccc851a3, 0xc8e079a46f0a9ef3, 0x614800006b0ba84c, 0xdced315c48b8fa3e, 0x911a49b77f23719c},
+		{0x58a746cc23eb95d8, 0xb295913233a5c0b2, 0x33af25d495c8c842, 0xc1d584228d5372f1, 0x35f6c6815b3cace, 0x68986e01cb068d90, 0xd1672698fea468a7, 0x398ca3be948fe35e},
+		{0x24d6f33af48c02a0, 0x38fa7d939a6b055d, 0xd71eb4495b7699f8, 0xef56b91d50db0390, 0x8925a9d4de66789c, 0x466f30a7cf94e94, 0x229a97c5309b9776, 0xd18fded84d0efc80},
+		{0xff9ba069f3c5a5ae, 0x477a8745983ef82f, 0x2c76ffbefc6dff89, 0xa46d7ac0bc0581c9, 0x330b695a3812e747, 0x1127b1201ef269ea, 0x671abdc3268fc9ee, 0xb79b5cd6292c037d},
+		{0xef32ca294b05ddfe, 0xf0a1d65555f1e45d, 0x73334086c4c07e40, 0xd94cedad7d37650a, 0x68fc0478e77f62f3, 0x778538df6f86d927, 0x535477983e88f10b, 0x202e147ac69c79f7},
+		{0x352c193f55af1ec4, 0xd7c55bbc93ef8e8e, 0x125861f9d64b2035, 0x3608cefde6d5f30d, 0xb51ae2f4ad9f900d, 0x750511253f5e7e65, 0x13666cccdb369bcb, 0x4b860a203d293bdc},
+		{0x8324de532528eb1d, 0x3e0bd21e01bc884f, 0x52e759fde7033a75, 0x2b650e94b4dd9a8, 0x54d8ac61043e9841, 0x72593a53db242bbf, 0x3758f4ae5cab882c, 0xc90e0e6f2561c6bd},
+		{0x93d3cae18fe51d76, 0xe1ca5c0f0d3ef578, 0x8895d6f162d4a204, 0x40f1d4b8b4b41b5f, 0x57aa8c6527f2892a, 0x61656212900e284b, 0x539e37efbeac4ac5, 0xa44657e422defd99},
+		{0x527733d265d524e7, 0x46922641ac54edb9, 0x214ee73a7c3abbfd, 0xcc0823fc19d4db91, 0xd0fb80007e048a00, 0x38c0cc594d503e08, 0x47887be995aa4fb3, 0xb1aaff36d69f1e7a},
+		{0xbcdc7e9bf85361bb, 0x6108993c16c0c714, 0xd07acd48aaa95baa, 0x170340a5e0fa5c4e, 0xc4f641cdb7952d95, 0x46abb8af972097c2, 0x66dd8774405e2e5c, 0x4b5e06e0e65cd521},
+		{0x1f6e05eca5f6104e, 0x499e15ac3a07c6b4, 0xa3e4464633663c56, 0xf53a6b9f88eb0020, 0xdb5523961803e5ef, 0xcff8733e73b33705, 0x25c48cdb0a2b3599, 0xc1ed86ce9dedbe77},
+		{0xfad72cdea9214a69, 0x47befe5e3a043b40, 0x81a6331b8756eb1, 0x742baa0ab07bfe5c, 0x139afd20ca969b23, 0x8e79ff985b0efb6f, 0x597adc3c4fe3d560, 0x43f3575a4ec7705f},
+		{0xf287b62332573393, 0xb6c8c122a70faa41, 0x1920eb65a813ece4, 0x413ce00210f25914, 0x1bfef2af8f0de961, 0x56a3ad75a1c99d94, 0xda79421806c897bb, 0xbbe1cf09d80d2be},
+		{0x305631d061fce3f1, 0xb2dc2f83a2551e17, 0xe659c07fc760a38e, 0xf69036000d2af67c, 0x9dd7c13c5bd2eba7, 0x7bc961e840d2ab55, 0x57d801aa2bc435b4, 0x5967507da869a219},
+		{0x6af6ebdddb231269, 0xe7b2f6e4d16ecf49, 0x6da4a77c78dcf4d8, 0x66105abdbc47379f, 0xf562d8c40ffcf230, 0xea7274820e10ad7c, 0xf011c75586a540aa, 0x2f20552ac0ec8191},
+		{0xa14d871a40bd35f9, 0xc1384abcb7659a1e, 0x1961a70612e90672, 0x3788ccb546eaabfe, 0x4e4ca62eb6789ce7, 0x84726335d2ec4e1e, 0xe9034c249148edd1, 0xe958747607fc2fcb},
+		{0x484f0b69091c3936, 0x77784eef9fa4356, 0xe9727f0fbb4ab7, 0xa13756c111f21fde, 0x38c7d5bbdb3b04f4, 0xad814291a4b4f330, 0x1e45cedad1275329, 0xfd6e2a142a9ff16c},
+		{0xe88cb8bf622c25e5, 0xe32926884f6042ba, 0x1d38ceaeeaa3436c, 0x7fe77187bff94b9f, 0x18d09c943f4ee695, 0x5d7c0b76f13184c6, 0xd8d7b8e3461b6fb9, 0xfde6d53d530e0c13},
+		{0x778714280f1966f2, 0xd5c2a3e7763bfcf2, 0x9beeddf502460927, 0xc94b9429a4212a31, 0x3bb2242bd92e055, 0x459eabf8791a3c42, 0xbdca022f090f30f9, 0xc3cfac7afb0731e2},
+		{0x7d3395b72881642f, 0x771d76d5a0cbec6f, 0xb029094734d73aa2, 0x10d7aa95ce56c2da, 0xf53eac663fd5105c, 0xa35e861e69e0e3b9, 0x5a059005666b044c, 0x454b6ae08edc0aac},
+		{0xebd67b1073581448, 0x68a176c10cd96865, 0xb6f74e43431f2850, 0xd197fd50cbb0e7b6, 0xbe08e6e3dcf91166, 0x55c1d2757f352044, 0x6a4a95519c6f6a9e, 0x895357c4eec5397},
+		{0xe6583840dc596d55, 0xae34785422b85b55, 0x7459da235f830ca7, 0xaaac78635d0aeaf3, 0xd15b423439f6641f, 0xe2cdef5f7d73d1fc, 0x33b23b4093c5047e, 0x6d2c7618e76c6b3d},
+		{0x595a2dbd70e1f3be, 0x137b4b824bffd22b, 0x7068b87d4c79d1ef, 0x72d9cf4b6a13d4e4, 0xe1d50aa80f1de19d, 0xd28a3f19df64fe49, 0x6930c5c69a6b3678, 0x31daa8f9317bfe0f},
+		{0xfe60f5efbe316807, 0x9e7e4c4f59aafe3c, 0xdaf0da8f90a7b6ec, 0x2c5965275f1eaa69, 0x7d18d60fc39819f5, 0xb675c2034a304c16, 0x37b8631c0ff20329, 0x6fb02d7c9732e456},
+		{0x3af21492928b317d, 0xedebfe14e625dbec, 0x882adcacd2d0ac72, 0x7530992ae3f0dc3e, 0x4f98c433e788d494, 0x646229a07b44cceb, 0x52172585d19821e2, 0xa2db0cf608ff69a4},
+		{0xdb6e1ad48a2c1d36, 0x8b0666148f21dfb1, 0x1aee1681c9e7fbc0, 0x7dff680820ea4290, 0xa858a751e975e0f1, 0x2c3ab1f5b78dede3, 0x5843b3f3a97fbc7b, 0x771b4fffeb503324},
+		{0xb11c79ed9b47d2ce, 0xb4ce749cf4ab886, 0x27c843890881fbb5, 0x3e6c2eb0e17146af, 0x5e4d46aba4ef02da, 0x5a17762868cc4b6d, 0x2366de5111cfa7f4, 0xa0532022f4cd73c8},
+		{0x75e1fb1b6a6fec5b, 0x25961d087b1548f8, 0x9b2af7cee71aeb4d, 0x954778c8add3027b, 0x687d9d23486e6930, 0xd40c23dc6f1efd75, 0x8fc251c0aecd95c7, 0xa612feaf3021c740},
+		{0x9c589c16f3dc351d, 0xa9c4f6a4a2ecf5e9, 0x23bc51ec3f7c5b8f, 0xd4574dee6e6f0060, 0xc475aa1ae123a600, 0x3481f51978d57eb0, 0xa1b0bf4da0ea5956, 0x93762b681db6b2bf},
+		{0x690cb6dda630ab49, 0x32222225b85d745e, 0x31c6b41fc0708c21, 0x42e89d9961a25b7f, 0x329e0492940f0245, 0xd72322a5d9fcfb97, 0x96d481a4a77329f, 0x1056ad3706e5309},
+		{0x9dff3e40338c664e, 0x3740576693c7dc0, 0x92b7b005791b1597, 0x29a9e22f11c54208, 0xf0d875d57410de90, 0x7cd16d1883fd50c6, 0x6f52407d5817100a, 0x520c3c11a3dc2a22},
+		{0xbbb71107cd78a1d, 0xba16cf930c0637d, 0x3a3490e3ed58951, 0x86d1e3b77deeb5e0, 0xaee7a3c9d010b9dc, 0x81c96b110ff636ca, 0x874f54825c537e67, 0x2527b16927e37be3},
+		{0x501fe1c125140725, 0xce6418abdbb9a8f4, 0x21eb2b7dc4031801, 0xb79cb60d2cb0d660, 0x5514aadd17da84a2, 0x2c1d2fb4eaf34bc4, 0x77ea4d71d5c524fc, 0x6a13b10cae6fa80c},
+		{0x88cdf6044eea6e8d, 0x9fe098009c979758, 0xa09dab5555090037, 0x6fa3756d7dd7bc21, 0x673da4578103240, 0x561c526c318a14a4, 0xa61a5bb2ded3e9a8, 0x85d03e8c68ea6fb},
+		{0x43ffc8a4fea39763, 0x99e993f73f14a32d, 0x669758ef80218de8, 0xb2740e2ecccf27b, 0xe0367791f1fc84a5, 0xbeb1c59771ef6bc5, 0x7282de60064e99f3, 0xd6b992b7730e8742},
+		{0x8308071b289c4d07, 0xcfe6af09bbbbeaab, 0x86d3ff14d7ddc5ba, 0xf1cfd3bc2b7fbd8a, 0xfc8a38f9aae039b8, 0x3d7d604ec3cb13fd, 0x3c87fb85cd1b1466, 0xb57f5d15b0140ca6},
+		{0x36330331a00a312b, 0xa0c6707b50e7c0f0, 0x4241459161b238bf, 0x2ccb29e8f10357c9, 0x9d484f30678b5737, 0x1742d9a9fc10b04b, 0xd3021a37a01a381a, 0xa408d9c4112bbc7d},
+		{0xa6c515d9d11d3eba, 0xe723c9905adcfa6e, 0xec227fd4c5697a2c, 0x359854955fd3b40e, 0x38fc429dcfaa1942, 0x26330a39decc9fa3, 0xf378e4829930b68b, 0xd0df6b50f51a9a10},
+		{0x4bf7e6c133d389dd, 0xfb6eccf5cd23fe0d, 0x212e75b13e70f8b4, 0x70d19d61fde1627c, 0x3d8cdfe25378fbe0, 0xcdddcc09245e44e7, 0x50dff1138e0f4108, 0xa36f3218dd57e5aa},
+		{0xf9bdea8a95084954, 0x66cc499b4792fe9c, 0xb1e1e66ef1188287, 0x62c2021baff2a2f7, 0xade3cb96e6b6b862, 0x3b465ba0e4d90c9c, 0xbd25d7ff50405013, 0xc0fa788d2ff2f162},
+		{0xa3dcd18e9cb6287e, 0xa4d27984c4aaf23a, 0x9cec91de63d8c2a2, 0x6e30a726b0b181a, 0x950a51c9e1363333, 0x4371f529e307f8df, 0x9316c0957f2efc92, 0x2a0fcd286a508550},
+		{0x5c22b1621ddd12e6, 0xf352321b76e7ffbf, 0xdf12181c1350b616, 0x6facf790350ce387, 0x562d531174b0ffcd, 0x35f52cdeaf8715fe, 0x3d7ac620fd6eb895, 0x6e67733f871b1509},
+		{0x35a4f04f2ac85aee, 0xab4ce754b87a75dc, 0x1d6cf6075e003a8c, 0x7721f17127e2efb2, 0xfe49a252fafb3b73, 0xc3e3ff20c0cefda, 0xf1334aa3026ad093, 0x599e289f7fc02686},
+		{0xddd96d1884adc7de, 0xfea8f471943f2ee3, 0x62630803662bfd1f, 0x53753bbc1adb80bf, 0x138072221604dfd, 0xfe52d66e1935964, 0xd2aa79dc6466420c, 0x2a02720940df0d40},
+		{0x5d1bf76b07180ea, 0x69e04ad3337f6832, 0x73b6f22261f3c607, 0x5cfda948cac475f0, 0xa411cc41c8cebbde, 0xf8a58e5665f7aeb5, 0x45ce82aedccd42ec, 0x1fa8fd768e85aa2f},
+		{0x80fd6240cec7868c, 0xf01533dc92b98f14, 0x3570e5d77333cfcb, 0xe4909c094c55c6bf, 0xea01060eb3c79c87, 0x479578da412fefc1, 0x906ea59c13283385, 0x4a22e9ce20c36a6b},
+		{0x811cdcf17912681b, 0x739f3438b34883d2, 0x44d2af1682974737, 0x3338c6123911f17e, 0xf4a697e6757e287b, 0xb4a0a177190815b5, 0x15de4799e6462437, 0x31bae4219922fd97},
+		{0x53c90d11e83267f2, 0x626aff817540a8c3, 0x297450b5837a80e5, 0xbc4fa97e9f797f59, 0xe9099c1a22610ee5, 0x2104a0aaaef8763d, 0xba285345a546c539, 0x647bc59136ae4779},
+		{0xc90650a77691b3c7, 0xd478dfc168df02fb, 0x50ba703e8fdf746b, 0x89926247abc6fa08, 0x94d3612fdc9d4bf9, 0x49f6cc66922b01fc, 0xf56e09eee40b9f5e, 0xcbddc519277a1980},
+		{0xa4bc8c7e27d57ec1, 0xbbc8363ba1583d01, 0x3bea3622bb927d89, 0x8aaa17502c7600f7, 0x8aa08b7ed03cc727, 0x9442697b23278940, 0x5b382ae87dee872b, 0xb05a2b3bc8e6856b},
+		{0x5ee0933bcbd2be64, 0x310bab4c8a8672ed, 0x5d346f1077f850d7, 0xc25a12ea53a30c9d, 0xa2d1d8ade43f9a7c, 0x56e2b7185a18e16a, 0x9e9e45b3bc9c852, 0x9f59f741235f},
+		{0xfdbcf0ea2bcbd857, 0xf23c688a7b3ca73c, 0xedacb1f53230421d, 0x274811e78a14789e, 0xd7e922f74d82efe3, 0xdda9d0743a2311dc, 0xb850024fdc0417ea, 0x53802d92667c4fea},
+		{0xc491a96ed717c898, 0x9ebff11ff7848449, 0xa43054af2a6b6efb, 0x945bf90a8740f49e, 0x77b06bd3f45b5eaf, 0x199f00cd17eaf4b8, 0x65b5d550ff15d0e3, 0xb11529807d2de804},
+		{0x306dba1244fa318e, 0x1f917fba18fd1660, 0x630b2bb3bbff5e52, 0xa2616553b36a0c0f, 0x49f9ff5dfa809d61, 0xb2fa4c76d90ef84a, 0xa29eb169e59323a4, 0x61b4178a00b81092},
+		{0xa5cf04ea492c4ad2, 0x5e2886647e9c1ea3, 0x612bbea429a95817, 0xc4b5ed315a7c68b, 0x1a9e427111e64060, 0x3382458d2f21c4e5, 0x50c50b5dedf021a1, 0x8c42db690a38b33d},
+		{0x2b6a01f4061256cd, 0xe2548d956f56319f, 0x95277e6ede20587e, 0x948922a81e2fe9f3, 0x959d451191c1cd6f, 0x11fae564bef66619, 0xeb1b6adcc9f43d5c, 0xd6127a59a8985856},
+		{0xc346e3fea182717a, 0x99c22e68eaf497c1, 0xcd412bb0519653c0, 0xef980ba8d18ed615, 0xa51e4971cda757b3, 0xed826c571750266f, 0x39106316b49f8b0c, 0x5ff0ab86fda735a5},
+		{0xe34ca706a90de984, 0xc192685aa62b296f, 0x20971111c371a528, 0xf7d6927689757800, 0x32f58baa67dab2db, 0x8c31c32b834721b1, 0xe5d8f471e529522a, 0xa58a65aa9b7f4949},
+		{0x661d84dda71f8592, 0x2366507d217300ab, 0x8cdb093bd3337b2f, 0x6a45965849b7ef82, 0x280e591ec26bb8c2, 0x57bffb83538ebab3, 0xd5d973ab006a0f23, 0x5900254f2432095d},
+		{0x48b9ae1c3c4d924b, 0x65318541c0627e3e, 0x622d3cd6a59b2eb3, 0x735ee2eb8034d3ca, 0x5da24f44abe6a6f3, 0x7f94b678883b8738, 0xfc0fb34c9d14cddd, 0x2e70bf1e14563344},
+		{0x61b38447b2eadad4, 0xa131c5c8f5b07210, 0xc1420dce748a74ec, 0xbf3f9becb0d0b9ec, 0x8e038caa79dd9769, 0x399b8e7ff653e28e, 0x1224c71ef68f5305, 0x61b9503192f8635f},
+		{0x82773b9e57fcc90d, 0xc936f16fb84fb177, 0xb0b2c4fe50a7a663, 0x581a216254cb67a4, 0x2023120edf4b98f0, 0x7bcb03a9fe1fbd95, 0xf120abea3c956cd0, 0x82e2839d4d1ec7c8},
+		{0x8659b4ee51f629c1, 0x3599faaec309e8a9, 0xa27fff22d098f6f4, 0xfe4c9721418d45d7, 0xcc4736a57771f768, 0x146f2145b774e9eb, 0x5f95519e06701bfd, 0xba43497eff5c7edc},
+		{0x9dc1ed0fc9b55235, 0x65323feb2fb8674c, 0x25d264113ac0fea5, 0xe0d458be42f6f479, 0x3ab70fc096e635d0, 0xff40cab8c9985e6f, 0x92e1116e8c63b669, 0x82513bbfc592f29},
+	},
+	{
+		{0xbe0b2578f8023127, 0xa95ccd098a8b68de, 0xdc602351925e578, 0xb2153f53004fca40, 0xb025456b72665f8e, 0xa5327909a0246bee, 0xfcfc4ef6f075a443, 0xb013b77bfbf58688},
+		{0x7dc23489e31d4659, 0xa9e52e03cb43db1c, 0xc0ea0d9fefff5157, 0x39049ef851c0fc54, 0xce36f42f87926762, 0x9592667aba4686f9, 0xc10ac0af2e18e003, 0xd8331f211d5b8020},
+		{0x9da2838d27ab3b85, 0x28cb26b3a5619978, 0x83856b951c50fc16, 0xfbaefd9066b521a6, 0xea3368d4b120ca34, 0x35416c27a2fd88d, 0xc5138982682644b8, 0xfcab1a3b402255f2},
+		{0x84e0dcd6aeabbaf7, 0x50d114cd7cfd0130, 0x8d645a7160841fc, 0xb3484d484787649, 0x887546e0b36d4cd3, 0x3ea2dcbfe57d6c61, 0x5ac69355ccf12537, 0x2c0738718d9ea7e8},
+		{0x28fcd9b5d37d6a85, 0xac6df3753e25a01b, 0x3a88d67c09581c8d, 0x3de489a2c91e80c1, 0x6698cf2cfef28d61, 0xfb519fc67ec55f12, 0xbc25cc567d0a60f7, 0x3815b5781fc0d1ef},
+		{0x987fbf19f6bc53d1, 0x463f62647640ccdc, 0x906c6ca16d77f958, 0x401557217e034231, 0x6a97b3c91d5bed33, 0xfede82365523bff1, 0x5fe71bc71b99bd54, 0x90b2bdaefc4797c},
+		{0x35630afec5d95f3e, 0x90562d89d1d2c22d, 0x4ce69fe4ea7241d3, 0xdfe26ef1c1f398f7, 0xa3b354054cc07afb, 0xc4dc0af8068d5928, 0x35124e9d341e982c, 0x7bd8b508adf07afc},
+		{0xc61223801bde66ec, 0xfd726077ea38576, 0xe10000e4804a44e6, 0x89c6d7fc3607bf1b, 0x2444766156fbbe3c, 0x7a6e60be34e83784, 0x1c2e35c65ac0d58, 0x5eabf057f66df727},
+		{0xd59e953c5c36695f, 0xf7a62dcc1bf513d9, 0x9d19c30458dc462e, 0x1f29b6b12287bae1, 0x2897347c8eb7665a, 0x9e4e65123cf945fd, 0x3f9d9ac2b8100515, 0x7f7d0bd95f3fca4a},
+		{0x86809c20d21cd4a1, 0x88d53a6935cc7d3d, 0x6ea15230aeaeecf9, 0x5f9c845e0d4b8e41, 0x50150e1980012703, 0x2b815b54b59eecfc, 0x4cf3f8085f185f6e, 0xc4ee564371a0dc04},
+		{0x693e74528f6c4286, 0x8fe2725c588012de, 0xf20fbd9b71f135dc, 0x29674a5a3956bc1c, 0x45a6ab5507269d70, 0xd16e80cbb5cdd43c, 0xbeeff7f7435d1cc8, 0x58cec1d16e22ec2},
+		{0x54b3e2f620e02f4, 0x55b699606789f6f4, 0x9c1b023df6171139, 0x1d57f164fa0df29d, 0x84940a21e1fcd4f4, 0xa72cb6bc889b6c0f, 0xf6b3d64b4b0e77f6, 0xdf6286bf2534b793},
+		{0xac5731cbc4935f08, 0xa949f2583c04006b, 0x980c9c9f136a1064, 0x7aad87c0af7448cb, 0xcc1dfea22bafc88a, 0x396e9be585ca1701, 0x4f4f2b3981ee32c, 0x7075d0f40f60d30},
+		{0xcdc1b94da86031b8, 0x84d8d43fe3b8a8cf, 0xa6e1eac79acebbb6, 0x83f89b9be2aaea77, 0x8c1bfc697a25d5c8, 0xaf7ddf238ce54fd, 0x6ed6b61df2caf5c4, 0xd0fc60fee192fde4},
+		{0xf14dddab024f605, 0x2a255f7a5343ee3a, 0x415be312fdd033d7, 0x97770222f1b98fed, 0x90d32b2f5ecfc5c8, 0xe899adfb512231b0, 0x135e299ba6c92274, 0xc85cdfda39f0ceb3},
+		{0xebe3d2f1c786cecb, 0xff6d01cdba007dea, 0x6d79c4ba40f25660, 0x21a16c665c69060, 0xe68f7d7649bd9b07, 0x675a2e2c1cd0fc22, 0x2ff660aa8d336524, 0x8f90f2067ea395a},
+		{0x328c7bad0f751144, 0x11f15359eadbf7cd, 0x146f2952637a5325, 0xd4aa2d38f47a1a53, 0xe88fba952a43bac5, 0xd9f9737e93ce4be5, 0xf7f095d109765ff2, 0x546aef918b9102ab},
+		{0xdd872a5fbb21d800, 0x6ff60bb6d5c8eeb0, 0x47dbb4547f931945, 0xd7ff8f7d347333c4, 0xb0c059ab76e587a7, 0x4473e083f5792c18, 0xaaac3a24d791649, 0xb360d2dda4e44c55},
+		{0x241e5236883252dc, 0xcf7ce4d13a407a5a, 0x87f67a449049a8cc, 0xa3e33ba58dce52fc, 0x9c1d24321d38743f, 0xfed5f2075315e0ea, 0x40decf9e44bf34f8, 0x591d3a6c3ee1e1b4},
+		{0xb6e3d16a3d159c9e, 0x36488ec168a0fb, 0x62df196bdb602ab8, 0xc86e765d7e834b69, 0xc22f63ae4b7d494, 0xbc04cd57fa35f7ef, 0xae560bf6bd7e6241, 0x5b4052a25b7950df},
+		{0x6e8da7b69cabd66b, 0xb5d5e2e0df3b59f9, 0xc0de3f4f7cefad5, 0xc6633a551b3e8235, 0xa4e25bb44f12ea42, 0xd07d86209711e6b5, 0x238275e1ba65c3dd, 0xe7560c0b11dfa23},
+		{0x12e1af52ee4611e4, 0xa4bfe901e3bd2649, 0x48559e936a6e2d04, 0x246f62a455185b82, 0x8ce45fbd403594c, 0x912c8d3ff3343abc, 0x896aa7507119e059, 0x90858ffedc8b9845},
+		{0xa101c51dab595fdd, 0xc4a06e4e0dcbce1a, 0x3b434b5c283fbe98, 0xbbdd8e8cb72e19c4, 0x7eb456f47fe3206c, 0x58e1fa727565f90f, 0x558cb1b0d40ffddb, 0x3cc2fd379f854c41},
+		{0x23469b36379574dd, 0x8b65340f93cf1754, 0x39619475f2658cc3, 0x2b9f7f1bd90366e, 0x18edf6d9f557a172, 0x130447da5c7d150d, 0xdb8ff2b1740fdc2b, 0xeaaca52a6456a10c},
+		{0x55c4fd377f5dd5d8, 0x359f89d4fb017c84, 0xe43f808395c5e163, 0xe5134e4ac3d1b053, 0x6895aed7bbc86eda, 0xbe1bdc61e9978c2a, 0x85aae388336acd2b, 0x9675fc313220227b},
+		{0x3e4c304754007f7f, 0x7d6dc1c25c930077, 0xe3c84bbcb3ff337b, 0xce121a37ac190691, 0x6b6f2e150c1b95ef, 0xb0491ed15dfba25d, 0x9ee64b5c6e5494ab, 0x1c7c4388c955e7b6},
+		{0x49b6f9629996516a, 0xc150c92f0bb15bb3, 0xda87b98b5f133ac8, 0x88f4d9121a30d1e4, 0xed0e1fe7185dab56, 0xde0babfe9729947d, 0x72473e281770b2d6, 0x6b1d513e24afd67f},
+		{0xdf9da4ab4b91f0a9, 0x161ae0aea5ee5a21, 0x6c307527f585447e, 0x5ea06f0f638bcc47, 0x60af8be3e855b90d, 0xd832b683f5846f09, 0x790720fe03c66f18, 0xf08926377f317326},
+		{0xe3ca0884e640c536, 0xfc94cfd2a0f3a20c, 0x9d0b6714e584506c, 0x4256dab2aa4251bf, 0x56de104b9ebe7b8d, 0xd70525420cc59de2, 0xa5b7bb72f8a668ef, 0x29e4ad914a31b8da},
+		{0x9ba7896e2657073a, 0xe9d6ffb61d303869, 0x5ebd2ea1f3b9a6c8, 0x929f69f9b6c86dbd, 0x223e1902b535289f, 0xa3c214c166526b6c, 0xafa42ad586ecd181, 0x71521017984bf81b},
+		{0xd92375169fcddcfa, 0x21ad0c0edcb2cabe, 0x813c2865ae9d4f7, 0x5be4c2f175ae87ee, 0xdb51356ae013223d, 0x7ccb0dc52544de1a, 0x6f99986301fd094e, 0xa9c6f0be841b3bee},
+		{0xcd53793795eeb64f, 0xb418b98b85854630, 0x541eb2b780714ff0, 0xec8806d7e781684e, 0xc51f177ea90b658c, 0x92be87d58da39f6c, 0xfd75fe20bdf0f2db, 0x79434c0239204e78},
+		{0x7818de7f02472ee8, 0x37d576f8b6d2aa6d, 0xed288d0311b83d59, 0x4950ff460bc4a82d, 0x92243ad55573427f, 0xd0fe4540fa4d28e3, 0x64f0fac0c1df27a8, 0x18e26c9d8e7f6960},
+		{0xa2683038f6f97115, 0x75f7a3c8385fc999, 0x7144bba93b8e3f17, 0x6e115f95f4be95fa, 0x76cd21f76e6e224e, 0xb9237097063560fa, 0x93a533a01f44505, 0x860e0b09b0cfe19d},
+		{0x91aca069b9e82182, 0x9a26f3e4ae730df7, 0x1c1702c932b9dd62, 0xd1eefa5c504202b0, 0xfed20f0cfbac48f6, 0xbffaef7c42df9370, 0x80892041f0d19ec1, 0x3664c14f5faf57f6},
+		{0x488076e26b11c126, 0x91da909ddc8e5056, 0x67a5846c57b3a9de, 0xaa59b00cc6faca50, 0x95b117a4d5285db2, 0xdba0df2742a85cae, 0xdbd30468916c5c6e, 0x8b761b8574a99c5e},
+		{0xc769f510d8fcc08e, 0xf68ef4048bfbe397, 0x8a6c007279fc2338, 0x3e626f4dd87ec7b9, 0x7af280480938481f, 0x9a0102150a8d5ad1, 0x9d7340b7ef162986, 0x30f195061fe1508a},
+		{0xcb0df0368d4e63ed, 0xd057e73be22234ce, 0xefcd58ed1c86d472, 0xf762f1d83080cf79, 0x8c216c28365c16fa, 0x5fd34ebbf3ddf6ad, 0x99288e404cccbad, 0x956f8a16ee4005e},
+		{0x7a4736881e038634, 0xbae318f6d0b6c1f1, 0x189862a6a66c8c44, 0x84a3c4757dcadd0a, 0xc079348511469df8, 0x9db057df41e42323, 0x61f7b3dd6634219c, 0x7f71de72bb5c523f},
+		{0x1b3fccd097fe5f2e, 0x383d1142544de99b, 0x64c7774202f4523b, 0xafcd298f433aa0e3, 0x69e837b01a7540d2, 0xaf9b05fb2d74685f, 0x1c7540e03c29b025, 0x4ee3ba3de969db71},
+		{0x606e701ab178f04e, 0xa6163fa8172518c9, 0x152d40d90a91f2c0, 0x3be86ae52ee49aa6, 0xf7c1154b6d1240a2, 0x4301bb6ddf5839de, 0x7b5ecda5838f51ce, 0x32366f75f157fadc},
+		{0x81e87a4124d5e2e5, 0x340ef9eb73b81462, 0xdc707947bce60971, 0x303c407064084ea8, 0xc15ef9487ab11e7e, 0xaf374e5e819ca6cb, 0x7fe5da7749704ac5, 0xd352a19a920788ee},
+		{0x2d86268a9b3e0d34, 0xee22285cfa1f2b83, 0x2929544058512094, 0x26c784c7648b07c2, 0x1cb2aa4dbf493368, 0xd4dbc1d546e54347, 0x8d80ac58ae9e8d7d, 0x16562f0c083f382d},
+		{0x6d9aceab95f0b6ba, 0xe3e35330fdf0a574, 0x2f9f17c2abff8cfa, 0x926715bf9f6e0558, 0x69a21f0b0f111291, 0xd8b3efa52a872ce4, 0xb13f0526dd324e89, 0x1902cb430c3da9b3},
+		{0xc3f1e8ccd3c33f18, 0x5189497c85b4d81f, 0xafdf3257f3345000, 0xeb26712a7e0b7298, 0xeaf84a54c26dab39, 0x63b220b8dde0dda2, 0x19cfecec0aa391d0, 0x9dd28dba7427d1c0},
+		{0xce31dbac3dd1004c, 0x3259b6680e0e9d6, 0x3883ccb196539ea7, 0xf0dfcc41269ffda1, 0x311c1335c2dc412c, 0xdb0e6c5c2f0fc894, 0x70e909b778d8f856, 0xe20f186bb1ef7f2d},
+		{0xc71810e505060870, 0x78a83556228c2a9b, 0x80ab3afacb1beff4, 0x86cc1605e3c2f3f8, 0x207e8a1a0a2bf867, 0x8d44f4ef35fbb409, 0x250ef15a0d602701, 0x543a3dedb3014bfc},
+		{0x3f2cc7bc339fccf2, 0x727f0f7ee79dbf0b, 0x70b04511c43f3ca8, 0x139c4c7cf49342c2, 0xcdc5b8e84002c9c4, 0xb67c541d374377bf, 0x9a6e7b7f363f444b, 0x2c98a7b811648d30},
+		{0x2f48b90fdac5e49c, 0xb8a21e3d671f39ef, 0xf5be17198dafe3df, 0x5f175465d5e502c8, 0x5f104ef0e8fd0b59, 0x8844280ebb8ecf60, 0x7a7abb460e33ae39, 0x6e48ef6c6a95a7dd},
+		{0x83cd83c79bc8c66, 0x3e84b3910ff840e5, 0xff96c6e6c3c39a49, 0x656df3c1981b03b8, 0x5b1f1348686d1002, 0xd4be55975ffe8f6c, 0x314d26f2cfbdf0f5, 0xf67ee391743d0d1},
+		{0xcd70b799c0996d75, 0x5c34d585a17ac268, 0x964221baf22996dc, 0xe4d84670898ad0ca, 0xa45134980ca330b, 0x94eae945ae5770f2, 0x32e34aeb673a44e4, 0x60b067b446fdc72b},
+		{0x54e3e16aa456b6a0, 0xab932bc8635de548, 0x3947650fc341f175, 0x511486111682af1c, 0x438e66a08ebd593, 0x3b9c9c42d1511667, 0xb43eed1bb7d77e45, 0xdb2ebea2d4db8aa},
+		{0x73a57ec67b78d416, 0xb9b755cb7b170e92, 0x8213e8b2f776be3a, 0x17f20b806da7a8, 0x7a1cce7b46833642, 0xf5f6a62971d5effa, 0x7b21bc477ba85d38, 0xec71114463d73c7e},
+		{0x60ed94586fa136ae, 0x2f49d721d2bc31f7, 0x6d814035b09144a3, 0x979969b241169f4d, 0xffb05a59b9f923d0, 0x86bf0e0fa7667990, 0x8a43d869d520a71c, 0xa775c1b469f66454},
+		{0x1d18f40961c695b1, 0x830bee1ec16996f3, 0x82cc8797d3573228, 0x75f5b441ed2b974e, 0xca64d99eadeb9566, 0x689c838d2b1b3afc, 0xd0c0de5c1ed5451a, 0xfd260d290664e1cd},
+		{0x65da9012cfc3f2ef, 0x5602fc1d3085abbc, 0xec76e96f71a9bc9b, 0x176e15ea751719b9, 0x64c50146d076ed3e, 0xbc81247188797376, 0xc360a2f9967d9dbb, 0x5c6c7a962cfa8792},
+		{0x3a90b189a09239a5, 0x94c95e3cd54a1a7c, 0x5c2fe345f6e419ca, 0xe6f71509e64f4fc7, 0xae4f6b39a3c301bd, 0x66d27c76cc388893, 0x426420f824c4b23d, 0x2d5328020f4c21f9},
+		{0xc7f77257238509a9, 0x62e071fce0b350de, 0x323bd8c36d0480ba, 0xeadd96c08069f8b6, 0xd14eb1cd2fa8d8c9, 0x22e00c609d8a9d97, 0xcdb0293d799151b8, 0xd6bf715a8bd1466b},
+		{0x27029198539285fc, 0xc11fc1320fef6b85, 0xd4ff4af172c626c9, 0x79e68fc2f2e06768, 0x3d4a632347343fd3, 0x91ee4b80d8836a5f, 0xaa235dad2aaa9612, 0x11b25671c87ed736},
+		{0x93072900ebd3cabe, 0x4cdaa8c3265c66e1, 0x8c48d7e127dde9e5, 0xbed89f8f6c444273, 0x6f8dbca4cf493f33, 0x2547fbce055d5140, 0xdf6fc69ff4bfa48f, 0x20faebad5301b6dc},
+		{0xfc60541fc12f52de, 0xc694f371ef17e0d2, 0xe832c65fee3be1b0, 0x7ea487ef3b2d1bc0, 0xf63b157cf6596206, 0xc84787588fd55bbc, 0x4e286dd05e7a1d86, 0xd5733d070faea3d6},
+		{0x7e82301692af58f9, 0xcc6a2436e5c0f44e, 0x4a34d38b76644588, 0xf1b7e35dc92f3a9d, 0x78f5b44ab75b01a6, 0x3c2f3aabe11596e3, 0xc5cd83708695ed7, 0x8f3d849aeff590a7},
+		{0xe2c98af7d137089f, 0x8cd64f13be01991e, 0x8188cd7ec1b86883, 0x12f812076f5d9d6, 0x981ca84da6c178d1, 0x88a6c9a32d8afb55, 0x44501d5c39913543, 0xa18376c2f39ea161},
+		{0x89d0e99746511eed, 0x69af514ad785d420, 0xdcea9edd55dd3886, 0x642eb96cbc6f8616, 0x4f17b1dbd954b20b, 0x98794f5efc33bb73, 0xeaf8fa5bdfc6ff88, 0xb415222982cff6cc},
+	},
+	{
+		{0xf65f52adb12a1ef, 0xd9fc7c9e500a563f, 0xdd2b85686ed4060, 0x9af924bef72c1af9, 0x3fdab0955c6b1f4e, 0xe2efbf13a963708b, 0xb54ab5de579f69b4, 0x7db04144323a9634},
+		{0x5a75dcaf2ef3ab9c, 0xe3eb3970c9f8fea2, 0x888298f4275ef099, 0x39b5629a3919b926, 0xd1a85310132a7427, 0x67db8173e961db2f, 0x21ae8020a5f83d89, 0x65d14eac095ad814},
+		{0x9e8d5543464bbe95, 0xc99b0f4186c44436, 0x73460bd9d3806666, 0xaf5b4800db32b4d, 0xd99f0a3f1fbf96d0, 0x6f2e66dffdac684f, 0xf8e795df0d9b9303, 0xecf4204fb6ccf56c},
+		{0xa07c36c55dc2426c, 0x6f3615ed5263a34d, 0x1c63d414f7633eb5, 0x4950a79dd19ae4cb, 0x96a299ae672ae1f3, 0xcc6482be5aeace07, 0xa3b5b0fc8d1550ad, 0x76e77f688d9feace},
+		{0x278a8b27827a4b26, 0xaa6ee0bb3f4deac1, 0xa39ca7a65cceeb32, 0x27b7fd62a96d2118, 0xccc16c7fc167fa4b, 0x2ff5f64f11d9e9e, 0xc7495f453b8a69ad, 0x7beaddb6075e76c6},
+		{0x33a135218a63dfa3, 0x639b3dc015aa2956, 0x5ce0f7a3fd393bd, 0x7e638dd3459faf81, 0x6f8bafb0e2d06864, 0xb1267d5cb09fafef, 0x1e4ce6f84d8001ba, 0xb32951e40d63cade},
+		{0x72d9a7bbc9a716c4, 0xc28a3647d59cd1df, 0x4f04026b081d6565, 0xb4971b39b21292db, 0xd3d1d39901e2f94b, 0xa83fa4fb0fa4d729, 0x6d418b4a286d91dc, 0x325adb2b9c57e140},
+		{0x3bc31c6043090b1c, 0xe5b847abe62f65a5, 0x254dab158f2f1725, 0xfea985c050c7961a, 0x13896ecfd5dbbea7, 0xef2c5a5c971aba3, 0xddc95c1b07a93585, 0x9a5c8f97cb4830c7},
+		{0x23644850af6ac55b, 0x61e05e560b31fa87, 0xcfaa634f9f74a983, 0x3e9217fd757c2a39, 0x8d62d791780c52, 0x72c73f85e8b16220, 0xc5d6c9bc438c09f2, 0xaee91068b271481c},
+		{0xa4759923e4bec62f, 0xde8ec2a2877980d6, 0x3677de6b95c52cd0, 0xe635f4491bfb5aec, 0xc295cea72900d8b7, 0x2020ff3c34874105, 0x9f0c229ed75268f9, 0x2d54a010f4859b80},
+		{0x22cd6e5dd3395963, 0xb5d255eef9cf2aed, 0x99ce8d90960075e6, 0x48c5914ff77a0a0b, 0x3548ea8e977a5ada, 0xa2e6d34e8a6e34db, 0xb41d032136f629a9, 0xcf568f27de269e3d},
+		{0xe88abe219d8785bf, 0x5ffa37ce24a28d5c, 0x2199c687eb9437bc, 0x452e17ce6b9aaf12, 0x672f369035b3bbdb, 0xe1c8ad9fa65a5db5, 0x6c6412736682d019, 0x62facdcbbe21b50c},
+		{0x55e51f2605636e38, 0x34a138446568e592, 0xd682c99dd21b4db8, 0xe5f2195c8678abf, 0x98ea45cf8e0e6105, 0x9634cd19e7e76789, 0xeb4bb3b2c9b39b39, 0xa7739e4b6847883e},
+		{0x6e77cfebb68bc391, 0xb2d1dc62bc298af0, 0x18e908fc3d281091, 0xbe544886f5f41afc, 0xad33d90e2c889bb7, 0x5bbf7a86f6939967, 0xb27d6d566d882817, 0x4a2d0104c598dc56},
+		{0xc4e956d83b080079, 0x8536c70ed40949f1, 0xe5d108ac54272bb5, 0x9306fb943b956a98, 0x75d15994dcc2b8d3, 0xa42015a5f8f3dea8, 0x270ef6325028919a, 0xc2adc1c050dbc006},
+		{0xbb8ba1153aa211e2, 0x399752c5888cae68, 0xa555b40669511a36, 0x54e4f0fec36cb72a, 0x44dee4e6f8efe1e7, 0x6aa388b45edb671d, 0x4313911396a1847c, 0x8811aa69018e8057},
+		{0xb144f6a9efe562e2, 0x409da1e22b8a662f, 0x4a1188c582c41ba, 0x4d7ca2982999e530, 0xca1a870890388fa7, 0xe05a827dcd90945f, 0xba81d65edcd121e4, 0x39f2a08d7c650dae},
+		{0x34a903e8ef9c1073, 0x4fbb612ee64db654, 0xd6eb25e9600b8cbf, 0x280f50b7f7b9ed35, 0xaa2ea000fd7c894c, 0xc62b8e674f97d3d4, 0x17476d1f3711fc6d, 0xa8222a7a46d8f51a},
+		{0xa9edcc96f27c661a, 0x9285b4d7a71737f6, 0x3894774eed570389, 0x763948832c56980a, 0x7b311ff19c39d40f, 0x29c543a8c9412337, 0x4124c3ee932f2bfe, 0xf1f001570610d7af},
+		{0x52c475d659825ed0, 0xa4b9cf624e8d820e, 0x9603d886051c0481, 0x84ac8332be59dae6, 0xc56cc607452fd137, 0x7acdbdd94fadfccf, 0xd18f2de8c7eda05e, 0x8a01f02b3e1a2904},
+		{0x31d15c757bdebe64, 0x538fc7f59d3aafd8, 0x3845bdde2ee18788, 0x274df978af948c79, 0x84fcca9dd3e0ae69, 0x180eda07ee40a9cb, 0x3184d837ec323bb3, 0x22b4b3a5f2018ebf},
+		{0x5274984c84d395f8, 0xd772be8f44fa7356, 0x1d6957f6a44a8ef, 0x6b1d10e9463690d7, 0xbc513b64e4a2c1de, 0x815c5f45f34696c9, 0xb4b800ef025fa519, 0xe49066bd27b804a0},
+		{0xe4f2a5187f1f3ed0, 0x3586b94a5d8c6625, 0x27fec096bfa70a82, 0xbf1e576fef43a689, 0xa1a7d43045ba89e4, 0xd3a1c6343dcd095c, 0xeb31c8d74b03fc40, 0xce0fe1bd029f7a83},
+		{0x5ff3bece516bd702, 0xb8e6072fdbfb350, 0x989436f94591e01b, 0xa7a52093f1e96941, 0x152fcaf516d1e5bb, 0x3d306535dc8ac49d, 0xf81ede6adad36420, 0xadf2c5cd8445c070},
+		{0x55627a76f53c9034, 0x6df4563cef2c5cd4, 0x2c03a87d2f71e4d2, 0x9574051c434293b8, 0xfacfeb1d67ea4287, 0xdd5d9001781b34a8, 0x8db7c1ad6ca29849, 0xb8c6c849847b2c4f},
+		{0x779ace0cd0ceed4c, 0xd9cae158e4ba4524, 0x9b8d554fcf121b5c, 0xc1dcfbb456a654f1, 0xaf27ed27f907ef46, 0x6d17f51078d2a7e8, 0x4ef3780a0950b8e8, 0xd8f630304b01cef0},
+		{0x8741b23e481c935f, 0xce19523409105b90, 0x94d07a4b87f4d25c, 0x398cb67f76f837c3, 0x5451bec00b60f48d, 0xdabd3978ecfbf762, 0x6671b21a6d96b688, 0xc0e9b17605d535f4},
+		{0xd6f53e99ee5b8c67, 0xff85c5885aedb602, 0x2aca7dc1ad406f69, 0x1be1e9b1ee736ddf, 0x9b1845a6ccabb6ea, 0x4f551bc7a4df7105, 0xa06af2d952251312, 0x7737144779d0e2d2},
+		{0x102ec83ea56b8b67, 0x1d3767e8215c98f3, 0x5d103e9b1883ac5f, 0x2dea9f01cab366ea, 0x3a340902d00eb8a0, 0xbf33a4ff3033eee0, 0x57faf12d5d2dec16, 0x726101671b379b41},
+		{0x92115f7a23793b6e, 0x49582aa6c7089566, 0x472eca121a782db9, 0x59336b5553a33e27, 0x7467a0bc39053e2c, 0x5bb5cba4c6f6b3ed, 0x873fd67f926e67db, 0xff276be09074a316},
+		{0x145c2fdcbac5b42d, 0x52345d3c814def0a, 0x657a588733804def, 0xbb6ac81ef375ff4, 0xdf792c218d8b4d17, 0xead26ec83116a1ba, 0x85a8f5aac9f2a1f3, 0x3855badbfde5b1bb},
+		{0x441d9191dc58a291, 0x605996f35d1dec4a, 0x6a8f8c805ba05deb, 0x6a06c1ebde30f574, 0xf02f23ffca3c2b1a, 0x1312b0206be07cfc, 0x1847d1522daea900, 0xbebb5778cf972170},
+		{0x848761fc0cbd92b2, 0x8d862e9c54615a94, 0x59f7b174193522eb, 0xdfc2d1e23c2de, 0x645d19932f78e445, 0x71a16c5f867a6dc2, 0xc8ba0baafcde805e, 0x5feb57a51ee69822},
+		{0xa584289bb0a55e89, 0xc6e14732e5a1ecc6, 0xae47a9b087f9c511, 0x2d48bbd0877fbc37, 0x30c11bf332917b54, 0x2c94b339cbb1fab0, 0xfe0fb5479a36c4b0, 0x1be5a5d5427ae624},
+		{0xfb8c2b05e896ec12, 0x8e90c56600aa40a9, 0x3f89dde5f0187af9, 0x6610c7199343dde, 0x25235450607688d5, 0xd9d61a8ed17ed524, 0x8baab112e2a64bd0, 0x2b61b88441fb3d91},
+		{0x303050253ea0d8b8, 0x90f88f9927149e0, 0x2ee14451929d839b, 0xdb384b84f7d4101c, 0x58d7bb9fad7e89a, 0xefd979b57a99ead0, 0x5497bf7dc03686be, 0x510c742b7e56ec63},
+		{0xce4b3a3ba4c76e35, 0x14095afa010364, 0x6dbba897a34fb547, 0x822d416e7cb2ed67, 0x365c990d5234ff82, 0xbf314740583faaf2, 0xce6ca81fd935c2a7, 0x94a9a98924639393},
+		{0xd336db3a89b57792, 0x82a6caee4f841ace, 0x3fce0461049473ed, 0xcf9084836b61ad4d, 0xf8d9cb9262d8441d, 0xc3c2b25986045b2e, 0x637357fc8dbb4359, 0x4c1149e021df0a3b},
+		{0x332cf3071f9a642f, 0xbdea41a9f308fa9d, 0x2589119301b67bc6, 0x539c68c7aa7dd5aa, 0x4e9711f625d3e4a2, 0x5dd8d870a050f062, 0x969166f7f9e8a455, 0xb178a79ab44e9801},
+		{0x9bdc365a4b5cee43, 0x9e9a2927515c52b3, 0x48e6e641d4f0aeaf, 0x797226bf5a99af7d, 0xecdc0b997f27d3bc, 0x69e621a02436817, 0x9cd268c012471347, 0x18353a3f109d1aba},
+		{0xac4ac55555711120, 0xe3a956b844ae7e2e, 0x426d8c11352b834c, 0xefb79ec7fc65889f, 0x28c430e1bf0c495, 0x7e0e7aeb3a3f0fcb, 0x51e90e4cb60aa741, 0xa979ce25bf55c85f},
+		{0xffeeb6b448c3e5ce, 0xd04fb2e3271e7d8b, 0xc2203076e6322bed, 0x74c3b657769cbb95, 0x341c96b61e021c4a, 0xde435ef424ccb175, 0x419489ccbba573c6, 0xb5f6e273b787efd5},
+		{0xb9a0583f449a97c7, 0x44c9da9c8f60de3a, 0x44b6cd0077751652, 0xf048a981ce017c54, 0x36225d7c8299a62c, 0x9943462c9d9f1148, 0xd62e72322bbb974, 0xb439b6db00af0ef1},
+		{0x3b0d344c563a6a5c, 0xb7f1c99b5ac7c746, 0xb1169e95bd763052, 0x9cf1e96c2711ebff, 0x5ea6ba5eb65c3693, 0x2d01c9e1a9915fc7, 0x57230551bdf9386b, 0x75210d71a5c5aa92},
+		{0xd908cf0bda2c9716, 0x640ec666d624b217, 0x8629b03ce4b638ee, 0xabc396dcb65932d, 0x5dd85851a1839d86, 0x5bb5e28a10736a13, 0x7b552c30dfaff93d, 0xc102a3b0c538b368},
+		{0x6a1bb04ced5acda2, 0xf8a9c4670ce2d83f, 0xe6ed98000996916, 0x4110e1fff9031c0b, 0xf6964a634819c3c5, 0x1563e8e7857b22d4, 0xf90a8ccc550d4cad, 0x5d2b4cd02494d971},
+		{0x3aa69e959dad0efe, 0x9204f5872c1a49fa, 0x1d806b0e89bc937c, 0x4e3fd13a84146761, 0x57a71f765689f268, 0x703c402dbcc5be89, 0xf75b9b98f8f8ee49, 0x2887c01f33d7a42b},
+		{0xcd4a2be1c048ec62, 0x19d1923cc2e7aa23, 0x8bacc23067f1dcba, 0x3cc8513c5adaa076, 0x1fefab430faf2706, 0xe5c7595f366937de, 0x4a84bed4de4e74d3, 0xb6ddb55463cff7e8},
+		{0x5b71512a0cbdb411, 0xed30f36feb10db93, 0x7fb1347110142fbf, 0xe0994ab0fd7abb8a, 0x48f4b97dcc774f91, 0xe42bb631372b498b, 0x2c017ab6249a1091, 0xf0340c27a0bc1597},
+		{0x7c131f6710b92811, 0xcb6aca072025a0c5, 0x9a65c0729f3fdef7, 0x5b0043fe863a008c, 0xa025d17f5fe94bae, 0x594f94d7cdd86753, 0xd554b05f62b1b62e, 0x82e8d02e17236782},
+		{0xa2ab4b9428588de, 0x68d3b00adfe8a64c, 0x14e70c62afeffa3d, 0x56bf26f570a79e52, 0xc585c4b931fbaaa2, 0xf3dbb0908d4c54a9, 0x269106c43c99b17, 0x2267940cf07ba728},
+		{0x21d3637774b91add, 0x5a6b8eb4d5e9a2bb, 0x7c3124549108abad, 0x827ba64faf6e8253, 0x11cc467d8bbc96e8, 0x9bb4134fabe85194, 0x67c207316b2ce54, 0xbd84872eb2fc4bc5},
+		{0x55a8d8d508610c5b, 0x23d613b2b9e50808, 0x2a90354cfdfbcf4f, 0x11c50d629302593c, 0x27c44e64b08403ba, 0xc478fb3c6f3e0a0a, 0xa48d22e896c72f0c, 0x6ec761af042bcfea},
+		{0xdb7da50155ed68fe, 0x280362c6e64a0ae2, 0x889caf5eef9896d7, 0x3883baea6626d5d3, 0x31b71de715b588b2, 0x73b40dc9bc608382, 0x46ebdbeefe4c34eb, 0x3a42f5fc5954e06c},
+		{0xc49c08297a0ccd49, 0xb4aad6668a5c4811, 0x2133befdd697cd1, 0x250afabe2af2d06b, 0x606a85eea0dd812b, 0x7ed08898f922f846, 0x561efe2139dc6cf7, 0xf0628b7470c64d70},
+		{0x6a3ead5e27cd6bdb, 0x7c26a325313e38dc, 0x1cffffe914967b1b, 0xb174d64656ad8093, 0x9a5582fba9dfcf34, 0x653e2087b244c6e7, 0xe39a5ea8d0073b29, 0xea8f59a53abc9ce6},
+		{0xbc4aa8f68940aceb, 0x32db0f5b8b08872e, 0xb1288c146c63f15b, 0xc899619a6aa4feb2, 0x5a8086616aa1d336, 0xeda790e548d6c4b2, 0x637e35207430d93, 0xb70b650b6939bd79},
+		{0x4d7a1ddbe42cd6f5, 0x2e6f6d88fd972f34, 0x57b6e5a9aa6a9156, 0x92d000158f645329, 0x90479dc64ed93bf5, 0x2951906c4c409fd1, 0x13ff73d15d5d12b0, 0xcab3e68831f3467b},
+		{0xb66e0a7958152146, 0x14c42774d3daff20, 0x1a0351399c0d3c16, 0xf0cac8d9039ddf7d, 0x99c5b13f4303675b, 0x6e0f7f97c532e7fe, 0xd7642bd724cfe4d0, 0x15ebf5fae0d8812b},
+		{0xdd95902b4a2f755b, 0x5a95655a00a8bbd0, 0x87afd0ccc1dd9245, 0xe490676ca422e77b, 0x58c7646a2d509f4a, 0xbd8c67daf3b823a5, 0x2883ff4f76390fbe, 0xab7eb6d5617ca0c8},
+		{0xa3fe3e75e12adf2a, 0x825b380f90cc7af9, 0x4f184814f6662f63, 0x85e1e7f328a37edc, 0x6ddb8361912f906c, 0x390642534428be8d, 0xd02d75703ab52dc8, 0x26b1ec9dd162173f},
+		{0x8aaba9896a4159ea, 0x76c374708de0f67d, 0x8af58a1d535eade4, 0x6814fc947be3bd5e, 0x49b86bbed9c9f4d5, 0x5c182e4daab742f8, 0x3079225a3cd92f7f, 0xff7018723102c32b},
+		{0x5b409e6374bb8a3, 0x74f19e1738551c0a, 0x9692404ae69be49c, 0x32c7736734db0888, 0x34e4dec14c1c32eb, 0x736a07477104c654, 0xf73df9199b9e4a3f, 0xf9c7a6a9a9b8b5ec},
+		{0xce0ac6b2de541df6, 0x83b26cd70b46bee1, 0xc3a65127559a3447, 0x2eccdd7adde0857a, 0x95f24aa011441030, 0xff47e83948231b84, 0x25ccfdfbae01c914, 0x39d4ffb41696112a},
+	},
+	{
+		{0xf18e7aed83f43b77, 0x920f9627c2aa04da, 0x6cdbef514a82737d, 0xa4c4552e6f73484c, 0xd5746e8131d71064, 0xd3dc3b6a879a4556, 0xfe97658c8195822d, 0x29f23dbb5487724},
+		{0x38d4bbc00e9a20f3, 0x894e9aacfd2e38f0, 0x2da01f5ecd9b4b2a, 0x16a3865f318c4485, 0xaf5b433bbfa19d23, 0x25a7b72f4364d1fb, 0xc95fb6617560aff9, 0x45f88a2f46c4ad73},
+		{0x6215553f63ab511, 0x33aa9936bf0024f2, 0xa6976aa3fe9443de, 0xbb3a3f6b9cfd96c8, 0x7685d1a5a01db04, 0x719b19431aff9ee0, 0x78b18d3336b9d144, 0xe053504883314c11},
+		{0x4d42e7cade493b9e, 0x20d44d8bd50a2e6f, 0x776744dda136cacf, 0x7076da71b7c9a92b, 0xabbf894e623f74a4, 0x97a82645198a20d0, 0xe7cf94a93afc2cbc, 0x321d0eaeaf78625c},
+		{0xc32ef561bdba221b, 0x7337f9d2f1977fa6, 0xed39e4b6df87c904, 0x33d38bed4e4b42a7, 0xba067411ac1d51b7, 0x557fd8cc839580df, 0xb78c9d10e811e09a, 0xc88f41c6503fba95},
+		{0x1114778d36ff3e85, 0x20c0ae5934666923, 0xd26327050cd3fbad, 0x8425117a0fe20d2d, 0x6357f5265d9c1135, 0x15d71312ce4a2395, 0x39e9cb25e9c2710, 0x52fdb2b344b60831},
+		{0x2c71da306b86a3b3, 0x1e12a3a99a09fed7, 0xa2db3dcc7384cdc, 0xc02178255e36f8a6, 0xe24dbd758ede307f, 0x2facc912ebe9f510, 0xb61be98796182ac0, 0xb620848e02763251},
+		{0xf1b7e242cd4f3698, 0x1a6bf1730f5938c1, 0x3b63b49081993de2, 0x85339f495142c9bc, 0xe26a056d2cd76fda, 0xb256a6e96fcc0a94, 0xd16bfc926f3e73af, 0x7803a77f888c44e8},
+		{0xf4534c6049323f3d, 0xd8f714ea403f3cbb, 0xb322ce9c0c9114b6, 0xed59811404f5a26f, 0xbf4370f4ca4d6380, 0x5b47ec2a5617b049, 0xb76cda8e8f29216c, 0x43ae42dfb7d21102},
+		{0x2ed69769d19d5645, 0x2cd37a8edb690597, 0x78cb56ca0e409770, 0xabd82184b925a814, 0xc9956eed6699f32, 0x643f9fdea7403d75, 0xdff1c91c096a5ae0, 0xf5521502715e451b},
+		{0x37ecde9a596ffa8d, 0x59986958141a8cf, 0xbfd09cde64a41e17, 0x757eb754d36cd34a, 0xb7af7dcb924eca9a, 0x7ea1037bf080f0fb, 0x83ba980e5cb45e86, 0x6507f349432460dd},
+		{0x49c02443fafb4ebe, 0x13393c0ae835bb64, 0x8fb3c1b7831318c5, 0x6cfc12e47a953ee8, 0x40bc5dabbdc3382e, 0xafceaf3891477169, 0x2bce857e5d96bbaa, 0x773d0f016d82bfa3},
+		{0x74d1848c404994d0, 0x61ea7b3a9f616104, 0x3c3db2acae606590, 0x828f4bd8a5b84251, 0x10657402c1a21d1f, 0xdda85a8aa0712849, 0x7b67dde1399c8655, 0x2bbedf8d893527b9},
+		{0xd85a07f1d4847bae, 0x2b4ad0a311a06651, 0xd0f77fc377eb1904, 0x2c5e08b0cedef25f, 0x8912c8d132e22a19, 0x5236775a5e0a50a3, 0x72355d3989d1ab73, 0xfa4673c5fdcc5841},
+		{0xf90857b0250bc42a, 0x98730ce08bcd0271, 0x9f030b2253f21acb, 0x50c9baadd1588b81, 0xbbb075d9e5c6a667, 0xa0fa1f395a4b52e8, 0x1187885fed4c6bd6, 0xa8e4cb265c4eaf8e},
+		{0x18c0652e9541ba26, 0x73e8774d170a01ac, 0xeb54b7b9acfdd329, 0xd794c7b30e76b5a0, 0x42e74020b3892898, 0x9e5e6c8d99f6cb8d, 0xa6a31eecfe03f52e, 0x513d88fbbb39d4e0},
+		{0x8a6cde7e1afcbd90, 0xd76a01bd58749b85, 0x5c80cb11f0c26554, 0x9ed38d6484527e02, 0x7f29a74ca4c30681, 0x86407c741719616f, 0x32e3c46af4a3b7b4, 0x24405d9ee6ab5da2},
+		{0x468e8c2e757d31ff, 0xced6d2c186509e5b, 0xe094527e6fe40e59, 0x60692b7462366279, 0xd295d74c9e74e755, 0x290e1d9f0dab0489, 0xb9a186febb27ee4b, 0x42148ee2e8317dce},
+		{0x7a56cf9a37cbfa09, 0x4b516223b16c3053, 0x1fd1ee9391594292, 0x4d162bcd557f80ac, 0xf5fd3b0488ff9c2d, 0x68987ad13fb1b85d, 0xf67d59ec6155de65, 0x4c07af003f0bd172},
+		{0x160cf5a5061c67b3, 0xb0a00f93f0f09a2f, 0x264485b68a8da43, 0xc759f15d6489b156, 0x2a11b987dd829e9c, 0x173147436b5c345b, 0x2975e9612f69f6c8, 0x9bb740167e20896},
+		{0x3f1f63580dc952ae, 0xd895c1e711b5d367, 0x7f274c343fbfa003, 0xd857788cac2cc640, 0x112215215e2bb440, 0x55434a7772593f54, 0x66b05d1368e4dd9c, 0x12a8fb5712e3d41a},
+		{0x744a599a80425123, 0x6bda3f5c423fd4e6, 0x3955ccf35965b90, 0x62c8848a53f0a6fe, 0x178e553b3a3a35a1, 0x6efc0c2a9e030f02, 0x7e56b0e638be10f3, 0x1191d9cebb300b38},
+		{0xad5c677c471905f2, 0x1a68ba11182ec89d, 0x12a327f6bfa487ee, 0x30ca2aa2276055b9, 0xd566652ba59cd8ce, 0xf0b59854474a03e4, 0x422e45cab765798, 0x3e953c8d1cb17c04},
+		{0x797b7e87f683528b, 0x9f20f8626d94d4b0, 0x16cd0e38ed3e4230, 0x4d0b0bdfc87982cc, 0x85717733cee676f6, 0xf3496084dd374f60, 0xff44623e166094ee, 0x7dc4021e2810898},
+		{0x5219eec80eaa7534, 0x53ea9a05fd0dd6d0, 0x756529cf5dde2351, 0xa736062929e2d804, 0x28771b2bfb5a4761, 0x60da5eb0b3bb1f38, 0xd4a3b5c46d3cfe20, 0x469b04b592024c1a},
+		{0xf81ab2f88deab946, 0xe6b9acc5817ffd53, 0xcaf68e052b0381df, 0x106a5cc9e93c470, 0xd26d2f91e163c645, 0x6bdcffe768aadebe, 0x71f4884cc395c555, 0x4f4030b8254fb79a},
+		{0x26e4f31b2feef039, 0x4f4fc7c7f9b43327, 0x3b84e5f3addd1fa2, 0xc0eef944d4242404, 0x2b673fd1dc3ba34b, 0xf986f95269183309, 0xffcd5144ec8e36fd, 0x478507eab0c127b8},
+		{0xacfe69e0c46af91c, 0xdc0c487e459ac4f0, 0xa751e9fa9aa5f8c5, 0x25ef51fbb1c0d6a4, 0x4ab118939815bf13, 0x4d325f7cc3fb6b63, 0xdaafe4b465c46bec, 0x859dc702c81f3920},
+		{0xac657070590d3ed9, 0xc654c74a69359bfc, 0x944e6469bb29ff2, 0x597d58cf36a431f7, 0x63d2b11374699e50, 0x8300bd870ebaa3a7, 0x44c9fce15af7d764, 0x63664d89f510d9a6},
+		{0x5944a4ddbbec3a57, 0x259378015458e1c0, 0x66d303d617353358, 0xaecf3b39e345d659, 0xeb40a3735810d1f6, 0x8cc2508d8f000190, 0x4d5b34ccaf2a1798, 0xa8b0819886fa636e},
+		{0x72d7baff2d8695c1, 0x657382ae56fa041, 0xb9e1fb03df07e3e0, 0x7e33a239ed38264, 0x101e6eeb82ac5348, 0x5dbc24f1c3bd1c42, 0x615650070240c4ba, 0x70cd3678061c2210},
+		{0x817864762fef6fcd, 0xd07dfd8c863ca79c, 0x3bf40078fcde5989, 0x9a46927c1c5049bc, 0x4820f7d44ff33b6d, 0x9d68d68dd545bf61, 0x7ab773513d87d526, 0xd3a745f22092c747},
+		{0x849b3a252597270b, 0x8336663b348e175e, 0x6b33e29660a7dda3, 0xe640c81a9e232b70, 0x1f7f1287e2c4013, 0xe44283394518ff31, 0x28cd5f56e88ee99, 0xecb0e5e7dcb871ac},
+		{0x6446288deb1b3c38, 0x8d5290f7da41de9c, 0x227fd3373814d655, 0x943709e30205c4c8, 0xd65f3c7de99c57a4, 0xc3b7fc201d2263b6, 0xf7a68f5035ee9294, 0x67c5d42afb3ca28b},
+		{0xbfe17d0e7a5d9a05, 0x4bf1d2bac22f3b08, 0x6e6e334a570301a5, 0x63e3b3061c706ca9, 0xcc3f395e939e6635, 0xb9a1e916c697162a, 0xf5ab06e504f8f3d7, 0xb0abcafeb5114d99},
+		{0x4ac28a288df6077e, 0x8c614e22da1786d, 0x44b3f4e991515541, 0xe67764c6537dd057, 0xf570a719964d95f9, 0x29b7fb81c20177c1, 0xe7211b2d4bb0c26f, 0xeae4735f4f3797e1},
+		{0x322c4cbae0bdfa3b, 0x17b08980aef23147, 0xb7aab5bfce9d3d4b, 0xc541f8a0f911527d, 0x739bf4d31c94f4a6, 0x1cabdd5cc02747f9, 0xac88afb2248624b4, 0x3c38bd8820d9b3df},
+		{0xef303cb9bf52bd89, 0xcbadcdecb6719c40, 0xff3f115826c08156, 0x6506b19182c4bed, 0x35632f8492409820, 0xa1a3c180944e0f99, 0x97b506307fd0fde8, 0xcfebdd1f4e8e103a},
+		{0x932b2f6e961d3144, 0xf18309975e25528c, 0x9ca27b3888530fed, 0xab2a19cde0d2d36f, 0x24967e047ae15dac, 0x49953b9d97c7229c, 0x4445f253252aef5f, 0xf2fa5dd615513d1f},
+		{0xb4df829398c2ed45, 0x8659b9fcfc691b64, 0xf20fb92bd9acb0d6, 0x30b1a23cd12a49a2, 0x1372721955ddfc54, 0xf2889c2b115f9384, 0x1e053e87b23ebc51, 0x9df1a167d7e864b0},
+		{0x9ba8e82f3fc1aad5, 0xdb0274262fba2baf, 0x9451aba081d8a809, 0x75826699bdf30706, 0xa90a9cbdac14bf24, 0x69a7096c0cac1877, 0x56b69c50889ab20c, 0x7315030819d060d3},
+		{0x22f25bc2e196287, 0x683d7097cc7ad452, 0x47d1013e6c854d0d, 0x6f2e4e93fcd2f936, 0x611962b576aea40a, 0xb961a227c7d234ee, 0x5127e74fb8a5f79f, 0xb254923e1a075c27},
+		{0xc905f089f0de6358, 0x1da131d8552450b0, 0x7cccc5952a3b325e, 0xb4e14757621eca5d, 0x95ffad0f44444f71, 0xe16307d615daef4c, 0xdd713757eb8c5f5a, 0xb4f67ed7c00334ec},
+		{0xed5be14802d4803e, 0x9b960a98e07c6f49, 0x52f5c1577ae68958, 0x7975b1db6ed52386, 0x46b8f4f09c15ca8a, 0x9f42600a3350c91a, 0x93a4da53a08337a3, 0x1f86185bebd3c8a8},
+		{0x581cb9cb8132968e, 0x70c29ef5a9bf5c82, 0x8720e831da5419df, 0xa21b049292fc8f0d, 0x2a3265e31aee018e, 0xf12116a22094b56f, 0xfc12513f63c5091d, 0x45b82c6d033baa1b},
+		{0x35534b2aa4f16004, 0x2a68ea137b51ba3f, 0x556f066c3fafeb50, 0xc249893cf444015c, 0x5af1dd4a77ab026c, 0xd25d62ea056413cc, 0xd520b782ee4a1661, 0xa392678f6ca99441},
+		{0xa6554959a1f1d1c0, 0x7a510505a0d8d206, 0x9d94a197b32f3db7, 0x5700616e6fa585e4, 0x99a1eb288ae9f0d1, 0xb26f635464f0517a, 0x2376cc7f94b20454, 0x928c38b523c9e866},
+		{0x3c1769294bd46cf3, 0x643f29dfa770277e, 0x4b7bc52dc89e03ab, 0xd4adba01d3f85dd2, 0x731c79bd24a0b89a, 0x8e4321c796f9779f, 0xb95ef255449af003, 0x5b1b9b606afb3997},
+		{0x649f8f62470a2720, 0x9a6939059c5ea4c9, 0xbd6b46233fb39400, 0xb3c3507385440ecc, 0x9039f91d8b45787e, 0x8e2a1961111cb0f0, 0x6f744eda5a6d1bb7, 0x88dc8ebb91982145},
+		{0x92154b78b2cef50c, 0x8d6f60e33c417536, 0x6f43ad1bebb2143f, 0x4f6682739d041b1c, 0x365e0f67092c9efd, 0x243f85c8ce8c66cd, 0x2c7ae67ee315c102, 0x50f981223549fbe0},
+		{0xc308748d57369e82, 0xa8767ebf3ec37c6e, 0x72918c14b0c64a44, 0xd7a61970ac7a4f6c, 0x58ea1ece9541d15d, 0x6a48e07f73fdd2ef, 0xff1a3610eb8ac571, 0x813f1e46df328b51},
+		{0x79fafb21cbb4b9d6, 0x7eec305b4327a812, 0x854f5fd34658b5b2, 0x38e7ffad8218e03f, 0xad8cac935b61720b, 0xa163df6700dcfb5c, 0x3857703c65453690, 0xb90e2f14201e43be},
+		{0xf504f0327b46b065, 0x595339c63dbd4c0, 0xff4fe665d8144b33, 0x5d6414d160ad5f99, 0x37464a7648bb2332, 0xf8f4e8b15c877122, 0xc70c3f59470a6d1, 0xe468cff024946e5f},
+		{0x368417e192654417, 0x24b96796ff3dcd31, 0xea70cabc015e9c1e, 0xb89b5b7db20a3841, 0x2ac12780cec3afb3, 0x1e821bc1b1b7b513, 0x10d1ffda4f7d74b, 0xc41c927f9cea3b10},
+		{0xd313ba647758a20b, 0xb03bf553b17c9726, 0xc6c902a172ed4e10, 0xa8bb36bbde302844, 0x56ebd2f4fa719b19, 0xa29b95251d75bd41, 0x86d015f7a1a0946a, 0x916e4ed577bd0d68},
+		{0x478fcfbff9d043c3, 0x4e2c2eda880912a2, 0x63fc115d241e588f, 0xae128ecbd1ed594, 0x3e6e243bb9c4d0a9, 0x85f185d7bfc6a0f2, 0x767490f485059b82, 0x74d87fe5439a7242},
+		{0x94e91b6a2e4bdd91, 0x76767cb88ed18dd3, 0x2f7f1a8ae19d8ab3, 0x477efa85c34461d1, 0x6fa6c7d26b505d75, 0x3595c00f51cfe0e8, 0x76fa58befc1010d7, 0x6aff57fd169cb550},
+		{0xb97a6ef4e125e363, 0x7a611e5ee3b62c24, 0x90501cf1748f1e1c, 0x49d5f557b11cb3a1, 0x9ce73ebf67d92810, 0x73700cdf22b26bab, 0x3cd2a8f1a52ae105, 0x864031288403852},
+		{0x496e0629e8721a9b, 0x7c8a966ff1c329db, 0xcac94686bb86212c, 0x236f023f52e1f34c, 0xf55a75e2d9c594dd, 0xa3b0f4315695f87f, 0x162c42885ff30c53, 0x53455c2642f842a7},
+		{0x8fdf190ed16f90c5, 0x771eb80da218d48b, 0x1a9f1fc0b4b2dd49, 0xcc36cae7cadf2fb0, 0xc2d483b7203b1a63, 0x50f74f2348bb5dec, 0xa34f48d591eb1a22, 0x4178e1925a1d2d92},
+		{0x4aa371f4deb19d2c, 0xc4571b0bc350e21b, 0x1a221063225f4cb0, 0xd0580cdaf1d67eb1, 0xa2265c8651578caf, 0x28ec9ae8464528c6, 0xfb956a4e75a9b9cf, 0x67387ba450273533},
+		{0x7b521ede8be2880, 0xff3caa6a62e1a8ad, 0x27ae994cfcda0b84, 0x3d72db6249f74c4a, 0xe9f3f304d96fbd37, 0xe21ac961ff2c3918, 0xc827716aba06d70, 0xf826c1acec3c1845},
+		{0xa1cf9b4dc765d142, 0xa17e27dbd64f6f0a, 0x81a8ae0c2c54b79f, 0x20ea9d9f5b3099fc, 0x34028d6eccffb385, 0xa71024904dff42d5, 0x3c406dfc789b8243, 0x2eeff6a56a919d0d},
+		{0x9b53663d21a9f371, 0xd829c72ef8c5a1d8, 0x73b382a5320ecaf1, 0x27723487100c472f, 0x268759f485c71291, 0xa4b822902399b64f, 0xfc73913942b6b61e, 0xc3e0e43d2ffeac85},
+	},
+}
@@ -0,0 +1,110 @@
+// Copyright 2026 The bip32 Authors.
+
+package secp256k1
+
+import (
+	"github.com/islishude/bip32/v2/internal/secp256k1/field"
+	"github.com/islishude/bip32/v2/internal/secp256k1/scalar"
+)
+
+// PublicPoint is a decoded affine public key. It lets callers keep the result
+// of point decompression or scalar multiplication instead of repeating it. The
+// zero value is not a valid point.
+type PublicPoint struct {
+	x, y  field.Element
+	valid bool
+}
+
+// ParsePublicKey decodes a canonical compressed SEC 1 point.
+func ParsePublicKey(key *[PublicKeySize]byte) (PublicPoint, bool) {
+	p, ok := parseCompressed(key)
+	if !ok {
+		return PublicPoint{}, false
+	}
+	// parseCompressed returns z = 1, so the coordinates are already affine.
+	return PublicPoint{x: p.x, y: p.y, valid: true}, true
+}
+
+// PublicPointFromScalar derives the public point of a private key using the
+// constant-time fixed-base multiplication path.
+func PublicPointFromScalar(key *[PrivateKeySize]byte) (PublicPoint, bool) {
+	var k scalar.Element
+	if !k.SetBytes(key) || k.IsZero() {
+		return PublicPoint{}, false
+	}
+	p := scalarBaseMultProjective(&k)
+	x, y, ok := p.affine()
+	if !ok {
+		return PublicPoint{}, false
+	}
+	return PublicPoint{x: x, y: y, valid: true}, true
+}
+
+// ScalarMult returns key*p using the constant-time variable-base path. The
+// point is public, but key may be secret.
+func (p *PublicPoint) ScalarMult(key *[PrivateKeySize]byte) (PublicPoint, bool) {
+	var k scalar.Element
+	if !p.valid || !k.SetBytes(key) || k.IsZero() {
+		return PublicPoint{}, false
+	}
+	q := projectivePoint{x: p.x, y: p.y}
+	q.z.SetOne()
+	r := scalarMultProjective(&q, &k)
+	x, y, ok := r.affine()
+	if !ok {
+		return PublicPoint{}, false
+	}
+	return PublicPoint{x: x, y: y, valid: true}, true
+}
+
+// Valid reports whether p holds a decoded point.
+func (p *PublicPoint) Valid() bool {
+	return p.valid
+}
+
+// Bytes returns the compressed SEC 1 encoding of p, or zero bytes for an
+// invalid point.
+func (p *PublicPoint) Bytes() [PublicKeySize]byte {
+	if !p.valid {
+		return [PublicKeySize]byte{}
+	}
+	return encodeAffine(&p.x, &p.y)
+}
+
+// UncompressedBytes returns the 65-byte uncompressed SEC 1 encoding of p, or
+// zero bytes for an invalid point. Legacy signed messages may commit to it.
+func (p *PublicPoint) UncompressedBytes() [65]byte {
+	var out [65]byte
+	if !p.valid {
+		return out
+	}
+	out[0] = 0x04
+	p.x.PutBytes((*[field.Size]byte)(out[1:33]))
+	p.y.PutBytes((*[field.Size]byte)(out[33:]))
+	return out
+}
+
+// AddScalarBaseVarTime returns p + tweak*G. Both inputs must be public; see
+// the package-level AddScalarBaseVarTime.
+func (p *PublicPoint) AddScalarBaseVarTime(tweak *[PrivateKeySize]byte) (PublicPoint, bool) {
+	if !p.valid {
+		return PublicPoint{}, false
+	}
+	var t scalar.Element
+	if !t.SetBytes(tweak) {
+		return PublicPoint{}, false
+	}
+	if t.IsZero() {
+		return *p, true
+	}
+
+	var parent, child point
+	parent.setAffine(&p.x, &p.y)
+	tweakPoint := scalarBaseMultVarTime(&t)
+	child.add(&parent, &tweakPoint)
+	x, y, ok := child.affine()
+	if !ok {
+		return PublicPoint{}, false
+	}
+	return PublicPoint{x: x, y: y, valid: true}, true
+}
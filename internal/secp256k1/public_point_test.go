@@ -0,0 +1,44 @@
+package secp256k1
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+func TestPublicPointRoundTrip(t *testing.T) {
+	generator := mustDecode33("0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798")
+	one := scalarBytes(1)
+	seven := scalarBytes(7)
+
+	parsed, ok := ParsePublicKey(&generator)
+	if !ok || !parsed.Valid() || parsed.Bytes() != generator {
+		t.Fatalf("ParsePublicKey(G) = %x, %v", parsed.Bytes(), ok)
+	}
+	derived, ok := PublicPointFromScalar(&one)
+	if !ok || derived.Bytes() != generator {
+		t.Fatalf("PublicPointFromScalar(1) = %x, %v", derived.Bytes(), ok)
+	}
+
+	child, ok := parsed.AddScalarBaseVarTime(&seven)
+	want, wantOK := AddScalarBase(&generator, &seven)
+	if !ok || !wantOK || child.Bytes() != want {
+		t.Fatalf("PublicPoint.AddScalarBaseVarTime = %x, want %x", child.Bytes(), want)
+	}
+
+	uncompressed := parsed.UncompressedBytes()
+	if hex.EncodeToString(uncompressed[:]) != "0479be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798483ada7726a3c4655da4fbfc0e1108a8fd17b448a68554199c47d08ffb10d4b8" {
+		t.Fatalf("UncompressedBytes(G) = %x", uncompressed)
+	}
+
+	var zero PublicPoint
+	if zero.Valid() || zero.Bytes() != [PublicKeySize]byte{} {
+		t.Fatal("zero PublicPoint reported as valid")
+	}
+	if _, ok := zero.AddScalarBaseVarTime(&one); ok {
+		t.Fatal("zero PublicPoint accepted as parent")
+	}
+	var invalid [PrivateKeySize]byte
+	if _, ok := PublicPointFromScalar(&invalid); ok {
+		t.Fatal("zero scalar accepted")
+	}
+}
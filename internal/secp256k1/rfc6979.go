@@ -0,0 +1,62 @@
+// Copyright 2026 The bip32 Authors.
+
+package secp256k1
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+)
+
+// rfc6979 is the HMAC-SHA256 deterministic nonce generator of RFC 6979
+// section 3.2, with optional additional data as in section 3.6. It matches
+// libsecp256k1's nonce_function_rfc6979, whose ndata argument is the extra
+// input used for Bitcoin Core's low-R grinding.
+type rfc6979 struct {
+	k, v  [sha256.Size]byte
+	retry bool
+}
+
+func newRFC6979(key, hash *[PrivateKeySize]byte, extra []byte) *rfc6979 {
+	g := new(rfc6979)
+	for i := range g.v {
+		g.v[i] = 0x01
+	}
+	for _, separator := range []byte{0x00, 0x01} {
+		mac := hmac.New(sha256.New, g.k[:])
+		_, _ = mac.Write(g.v[:])
+		_, _ = mac.Write([]byte{separator})
+		_, _ = mac.Write(key[:])
+		_, _ = mac.Write(hash[:])
+		_, _ = mac.Write(extra)
+		mac.Sum(g.k[:0])
+		g.update()
+	}
+	return g
+}
+
+// next returns the next 32-byte candidate nonce. Callers reject candidates
+// that are not valid scalars and ask for another.
+func (g *rfc6979) next() [PrivateKeySize]byte {
+	if g.retry {
+		mac := hmac.New(sha256.New, g.k[:])
+		_, _ = mac.Write(g.v[:])
+		_, _ = mac.Write([]byte{0x00})
+		mac.Sum(g.k[:0])
+		g.update()
+	}
+	g.update()
+	g.retry = true
+	return g.v
+}
+
+// update assigns V = HMAC_K(V).
+func (g *rfc6979) update() {
+	mac := hmac.New(sha256.New, g.k[:])
+	_, _ = mac.Write(g.v[:])
+	mac.Sum(g.v[:0])
+}
+
+func (g *rfc6979) wipe() {
+	clear(g.k[:])
+	clear(g.v[:])
+}
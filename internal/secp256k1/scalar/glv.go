@@ -0,0 +1,79 @@
+// Copyright 2026 The bip32 Authors.
+
+package scalar
+
+import "math/bits"
+
+// Constants for the secp256k1 GLV endomorphism. lambda is the cube root of
+// unity modulo n with lambda*(x, y) = (beta*x, y). minusB1 and minusB2 are the
+// negated short lattice basis coordinates, and g1 and g2 are
+// round(2^384 * b2 / n) and round(2^384 * -b1 / n).
+var (
+	minusLambda = elementFromWords([4]uint64{0xe0cfc810b51283cf, 0xa880b9fc8ec739c2, 0x5ad9e3fd77ed9ba4, 0xac9c52b33fa3cf1f})
+	minusB1     = elementFromWords([4]uint64{0x6f547fa90abfe4c3, 0xe4437ed6010e8828, 0, 0})
+	minusB2     = elementFromWords([4]uint64{0xd765cda83db1562c, 0x8a280ac50774346d, 0xfffffffffffffffe, 0xffffffffffffffff})
+	g1          = [4]uint64{0xe893209a45dbb031, 0x3daa8a1471e8ca7f, 0xe86c90e49284eb15, 0x3086d221a7d46bcd}
+	g2          = [4]uint64{0x1571b4ae8ac47f71, 0x221208ac9df506c6, 0x6f547fa90abfe4c4, 0xe4437ed6010e8828}
+)
+
+// SplitLambda decomposes k into k1 + k2*lambda mod n where |k1| and |k2| are
+// below 2^128. The magnitudes are returned as little-endian limbs together
+// with their signs.
+//
+// SplitLambda runs in variable time and must only be used with public scalars.
+func SplitLambda(k *Element) (k1, k2 [4]uint64, neg1, neg2 bool) {
+	words := k.Words()
+	var c1, c2, r1, r2 Element
+	c1.setWords(mulShift384(words, g1))
+	c2.setWords(mulShift384(words, g2))
+	c1.Mul(&c1, &minusB1)
+	c2.Mul(&c2, &minusB2)
+	r2.Add(&c1, &c2)
+	r1.Mul(&r2, &minusLambda)
+	r1.Add(&r1, k)
+	k1, neg1 = signedMagnitude(&r1)
+	k2, neg2 = signedMagnitude(&r2)
+	return k1, k2, neg1, neg2
+}
+
+// signedMagnitude interprets a scalar in (-2^128, 2^128) mod n.
+func signedMagnitude(r *Element) ([4]uint64, bool) {
+	words := r.Words()
+	if words[2]|words[3] == 0 {
+		return words, false
+	}
+	var neg Element
+	neg.Neg(r)
+	return neg.Words(), true
+}
+
+// mulShift384 returns round(a*b / 2^384) for 256-bit a and b.
+func mulShift384(a, b [4]uint64) [4]uint64 {
+	var t [8]uint64
+	for i := range a {
+		var carry uint64
+		for j := range b {
+			hi, lo := bits.Mul64(a[i], b[j])
+			var c uint64
+			lo, c = bits.Add64(lo, t[i+j], 0)
+			hi += c
+			lo, c = bits.Add64(lo, carry, 0)
+			hi += c
+			t[i+j] = lo
+			carry = hi
+		}
+		t[i+len(b)] = carry
+	}
+	round := t[5] >> 63
+	var out [4]uint64
+	var c uint64
+	out[0], c = bits.Add64(t[6], round, 0)
+	out[1], _ = bits.Add64(t[7], 0, c)
+	return out
+}
+
+func elementFromWords(words [4]uint64) Element {
+	var out Element
+	out.setWords(words)
+	return out
+}
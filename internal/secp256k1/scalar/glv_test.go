@@ -0,0 +1,59 @@
+package scalar
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestSplitLambdaAgainstBigInt(t *testing.T) {
+	modulus := new(big.Int).SetBytes(Order[:])
+	lambda, _ := new(big.Int).SetString("5363ad4cc05c30e0a5261c028812645a122e22ea20816678df02967c1b23bd72", 16)
+	bound := new(big.Int).Lsh(big.NewInt(1), 128)
+
+	cases := []*big.Int{
+		big.NewInt(0),
+		big.NewInt(1),
+		new(big.Int).Sub(modulus, big.NewInt(1)),
+		new(big.Int).Set(lambda),
+		new(big.Int).Rsh(modulus, 1),
+		new(big.Int).Lsh(big.NewInt(1), 128),
+	}
+	state := uint64(0x13198a2e03707344)
+	for range 1024 {
+		var b [32]byte
+		for i := range 4 {
+			state = state*6364136223846793005 + 1442695040888963407
+			putUint64BE(b[i*8:], state)
+		}
+		cases = append(cases, new(big.Int).Mod(new(big.Int).SetBytes(b[:]), modulus))
+	}
+
+	for _, value := range cases {
+		encoded := bigToScalar(value)
+		var k Element
+		if !k.SetBytes(&encoded) {
+			t.Fatalf("canonical scalar %x rejected", value)
+		}
+		k1, k2, neg1, neg2 := SplitLambda(&k)
+		r1 := wordsToBig(k1, neg1)
+		r2 := wordsToBig(k2, neg2)
+		if new(big.Int).Abs(r1).Cmp(bound) >= 0 || new(big.Int).Abs(r2).Cmp(bound) >= 0 {
+			t.Fatalf("split of %x exceeds 2^128: %x, %x", value, r1, r2)
+		}
+		got := new(big.Int).Mul(r2, lambda)
+		got.Add(got, r1)
+		got.Mod(got, modulus)
+		if got.Cmp(value) != 0 {
+			t.Fatalf("k1 + k2*lambda = %x, want %x", got, value)
+		}
+	}
+}
+
+func wordsToBig(words [4]uint64, negative bool) *big.Int {
+	b := wordsToBytes(words)
+	out := new(big.Int).SetBytes(b[:])
+	if negative {
+		out.Neg(out)
+	}
+	return out
+}
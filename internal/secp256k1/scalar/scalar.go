@@ -1,7 +1,7 @@
 // Copyright 2026 The bip32 Authors.
 
 // Package scalar implements the small subset of secp256k1 scalar arithmetic
-// required by BIP-32 child-key derivation.
+// required by BIP-32 child-key derivation and signing.
 package scalar
 
 import (
@@ -48,6 +48,24 @@ func (z *Element) SetBytes(b *[Size]byte) bool {
 	return true
 }
 
+// SetBytesReduced parses a 32-byte big-endian integer and reduces it modulo n,
+// as ECDSA does with message hashes. Since 2^256 < 2n, at most one subtraction
+// is needed, and it is selected with a mask rather than a branch.
+func (z *Element) SetBytesReduced(b *[Size]byte) *Element {
+	words := bytesToWords(b)
+	var reduced [4]uint64
+	var borrow uint64
+	reduced[0], borrow = bits.Sub64(words[0], order0, 0)
+	reduced[1], borrow = bits.Sub64(words[1], order1, borrow)
+	reduced[2], borrow = bits.Sub64(words[2], order2, borrow)
+	reduced[3], borrow = bits.Sub64(words[3], order3, borrow)
+	mask := borrow - 1 // all ones when words >= n
+	for i := range words {
+		words[i] = (words[i] &^ mask) | (reduced[i] & mask)
+	}
+	return z.setWords(words)
+}
+
 // LessThanOrder reports whether b is a canonical scalar encoding.
 func LessThanOrder(b *[Size]byte) bool {
 	return LessThanOrderWords(bytesToWords(b))
@@ -69,6 +87,50 @@ func (z *Element) Add(x, y *Element) *Element {
 	return z
 }
 
+// Mul assigns z = x * y mod n.
+func (z *Element) Mul(x, y *Element) *Element {
+	fiat.Mul(&z.x, &x.x, &y.x)
+	return z
+}
+
+// Neg assigns z = -x mod n.
+func (z *Element) Neg(x *Element) *Element {
+	fiat.Opp(&z.x, &x.x)
+	return z
+}
+
+// Inv assigns z = x^-1 mod n, or zero when x is zero. It raises x to n-2
+// with a fixed square-and-multiply sequence, so its timing is independent of
+// x.
+func (z *Element) Inv(x *Element) *Element {
+	exponent := [4]uint64{order0 - 2, order1, order2, order3}
+	var r Element
+	fiat.SetOne(&r.x)
+	for i := len(exponent) - 1; i >= 0; i-- {
+		for bit := 63; bit >= 0; bit-- {
+			fiat.Square(&r.x, &r.x)
+			if exponent[i]>>bit&1 == 1 {
+				fiat.Mul(&r.x, &r.x, &x.x)
+			}
+		}
+	}
+	*z = r
+	return z
+}
+
+// IsHigh reports whether z is greater than n/2. It runs in variable time and
+// must only be used with public values such as signature scalars.
+func (z *Element) IsHigh() bool {
+	words := z.Words()
+	halfOrder := [4]uint64{0xdfe92f46681b20a0, 0x5d576e7357a4501d, 0xffffffffffffffff, 0x7fffffffffffffff}
+	for i := len(words) - 1; i >= 0; i-- {
+		if words[i] != halfOrder[i] {
+			return words[i] > halfOrder[i]
+		}
+	}
+	return false
+}
+
 // IsZero reports whether z is zero.
 func (z *Element) IsZero() bool {
 	return z.x == fiat.MontgomeryDomainFieldElement{}
@@ -91,6 +153,13 @@ func (z *Element) Words() [4]uint64 {
 	return [4]uint64(out)
 }
 
+// setWords assigns z from canonical little-endian non-Montgomery limbs.
+func (z *Element) setWords(words [4]uint64) *Element {
+	in := fiat.NonMontgomeryDomainFieldElement(words)
+	fiat.ToMontgomery(&z.x, &in)
+	return z
+}
+
 func bytesToWords(b *[Size]byte) [4]uint64 {
 	return [4]uint64{
 		binary.BigEndian.Uint64(b[24:32]),
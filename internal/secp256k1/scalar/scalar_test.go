@@ -64,6 +64,53 @@ func TestScalarCanonicalBounds(t *testing.T) {
 	}
 }
 
+func TestScalarInvReducedAndHigh(t *testing.T) {
+	modulus := new(big.Int).SetBytes(Order[:])
+	half := new(big.Int).Rsh(modulus, 1)
+	state := uint64(0x13198a2e03707344)
+	values := []*big.Int{big.NewInt(1), big.NewInt(2), half, new(big.Int).Add(half, big.NewInt(1))}
+	for range 64 {
+		var b [32]byte
+		for i := range 4 {
+			state = state*6364136223846793005 + 1442695040888963407
+			putUint64BE(b[i*8:], state)
+		}
+		values = append(values, new(big.Int).Mod(new(big.Int).SetBytes(b[:]), modulus))
+	}
+	for _, value := range values {
+		if value.Sign() == 0 {
+			continue
+		}
+		b := bigToScalar(value)
+		var x, inv Element
+		x.SetBytes(&b)
+		inv.Inv(&x)
+		invBytes := inv.Bytes()
+		want := new(big.Int).ModInverse(value, modulus)
+		if got := new(big.Int).SetBytes(invBytes[:]); got.Cmp(want) != 0 {
+			t.Fatalf("Inv(%x) = %x, want %x", value, got, want)
+		}
+		if x.IsHigh() != (value.Cmp(half) > 0) {
+			t.Fatalf("IsHigh(%x) = %v", value, x.IsHigh())
+		}
+	}
+
+	for _, value := range []*big.Int{
+		big.NewInt(5),
+		new(big.Int).Set(modulus),
+		new(big.Int).Add(modulus, big.NewInt(5)),
+		new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1)),
+	} {
+		b := bigToScalar(value)
+		var x Element
+		reduced := x.SetBytesReduced(&b).Bytes()
+		want := new(big.Int).Mod(value, modulus)
+		if got := new(big.Int).SetBytes(reduced[:]); got.Cmp(want) != 0 {
+			t.Fatalf("SetBytesReduced(%x) = %x, want %x", value, got, want)
+		}
+	}
+}
+
 func bigToScalar(value *big.Int) (out [32]byte) {
 	value.FillBytes(out[:])
 	return out
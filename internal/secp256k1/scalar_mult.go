@@ -37,6 +37,42 @@ func scalarBaseMultProjective(k *scalar.Element) projectivePoint {
 	return r
 }
 
+// variableWindow is the fixed window width of scalarMultProjective.
+const variableWindow = 4
+
+// scalarMultProjective multiplies an arbitrary point by a secret scalar. It
+// builds the multiples 0..15 of q, then for every 4-bit window performs four
+// doublings and one addition of a table entry chosen by scanning the whole
+// table with mask selection. The scalar only affects which entry is selected,
+// and the complete formulas handle infinity and equal points uniformly, so the
+// sequence of field operations is independent of the scalar.
+func scalarMultProjective(q *projectivePoint, k *scalar.Element) projectivePoint {
+	words := k.Words()
+	defer clear(words[:])
+
+	var table [1 << variableWindow]projectivePoint
+	table[0].setInfinity()
+	table[1] = *q
+	for i := 2; i < len(table); i++ {
+		table[i].addComplete(&table[i-1], q)
+	}
+
+	var r projectivePoint
+	r.setInfinity()
+	for i := scalar.Size*8/variableWindow - 1; i >= 0; i-- {
+		for range variableWindow {
+			r.doubleComplete(&r)
+		}
+		digit := fixedWindowDigit(&words, uint(i), variableWindow)
+		selected := table[0]
+		for j := 1; j < len(table); j++ {
+			selected.selectPoint(&selected, &table[j], equalByte(digit, byte(j)))
+		}
+		r.addComplete(&r, &selected)
+	}
+	return r
+}
+
 func fixedWindowDigit(words *[4]uint64, windowIndex, window uint) byte {
 	bit := windowIndex * window
 	wordIndex := bit / 64
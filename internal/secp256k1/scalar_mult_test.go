@@ -0,0 +1,112 @@
+package secp256k1
+
+import "testing"
+
+func TestScalarMultMatchesScalarBaseMult(t *testing.T) {
+	generatorKey := mustDecode33("0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798")
+	generator, ok := ParsePublicKey(&generatorKey)
+	if !ok {
+		t.Fatal("generator parse failed")
+	}
+	keys := [][32]byte{
+		scalarBytes(1),
+		scalarBytes(2),
+		scalarBytes(15),
+		scalarBytes(16),
+		mustDecode32("fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364140"),
+	}
+	state := uint64(0x9e3779b97f4a7c15)
+	for range 16 {
+		var key [32]byte
+		for i := range key {
+			state = state*6364136223846793005 + 1442695040888963407
+			key[i] = byte(state >> 56)
+		}
+		keys = append(keys, key)
+	}
+	for _, key := range keys {
+		want, wantOK := PublicKeyFromScalar(&key)
+		got, gotOK := generator.ScalarMult(&key)
+		if gotOK != wantOK || got.Bytes() != want {
+			t.Fatalf("ScalarMult(G, %x) = %x, %v; want %x, %v", key, got.Bytes(), gotOK, want, wantOK)
+		}
+	}
+
+	var zero [32]byte
+	if _, ok := generator.ScalarMult(&zero); ok {
+		t.Fatal("zero scalar accepted")
+	}
+	order := mustDecode32("fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364141")
+	if _, ok := generator.ScalarMult(&order); ok {
+		t.Fatal("non-canonical scalar accepted")
+	}
+}
+
+func TestECDHIsSymmetric(t *testing.T) {
+	a := mustDecode32("c9afa9d845ba75166b5c215767b1d6934e50c3db36e89b127b8a622b120f6721")
+	b := mustDecode32("0b1dcf9f12fa7a1d6a8d4e8c4d0b4c4a2b6f0e2f4a3d0b0f5c7e7f2a1b4c3d2e")
+	pubA, _ := PublicKeyFromScalar(&a)
+	pubB, _ := PublicKeyFromScalar(&b)
+
+	ab, ok := ECDH(&a, &pubB)
+	if !ok {
+		t.Fatal("ECDH(a, B) failed")
+	}
+	ba, ok := ECDH(&b, &pubA)
+	if !ok || ab != ba {
+		t.Fatalf("ECDH mismatch: %x != %x", ab, ba)
+	}
+
+	one := scalarBytes(1)
+	if got, ok := ECDH(&one, &pubB); !ok || [32]byte(pubB[1:]) != got {
+		t.Fatalf("ECDH(1, B) = %x, want x(B)", got)
+	}
+	invalidPoint := mustDecode33("020000000000000000000000000000000000000000000000000000000000000007")
+	if _, ok := ECDH(&a, &invalidPoint); ok {
+		t.Fatal("off-curve peer accepted")
+	}
+}
+
+func TestCompleteProjectiveFormulas(t *testing.T) {
+	generatorKey := mustDecode33("0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798")
+	twoGKey := mustDecode33("02c6047f9441ed7d6d3045406e95c07cd85c778e4b8cef3ca7abac09b95c709ee5")
+	g, _ := ParsePublicKey(&generatorKey)
+	p := projectivePoint{x: g.x, y: g.y}
+	p.z.SetOne()
+	negP := p
+	negP.y.Neg(&p.y)
+	var infinity projectivePoint
+	infinity.setInfinity()
+
+	var sum, doubled projectivePoint
+	sum.addComplete(&p, &p)
+	doubled.doubleComplete(&p)
+	for name, q := range map[string]*projectivePoint{"P+P": &sum, "2P": &doubled} {
+		x, y, ok := q.affine()
+		if !ok || encodeAffine(&x, &y) != twoGKey {
+			t.Fatalf("%s does not equal 2G", name)
+		}
+	}
+	sum.addComplete(&p, &negP)
+	if !sum.z.IsZero() {
+		t.Fatal("P + (-P) is not infinity")
+	}
+	sum.addComplete(&infinity, &p)
+	if x, y, ok := sum.affine(); !ok || encodeAffine(&x, &y) != generatorKey {
+		t.Fatal("infinity + P does not equal P")
+	}
+	doubled.doubleComplete(&infinity)
+	if !doubled.z.IsZero() {
+		t.Fatal("2 * infinity is not infinity")
+	}
+}
+
+func BenchmarkECDH(b *testing.B) {
+	key := mustDecode32("c9afa9d845ba75166b5c215767b1d6934e50c3db36e89b127b8a622b120f6721")
+	peer := mustDecode33("02c6047f9441ed7d6d3045406e95c07cd85c778e4b8cef3ca7abac09b95c709ee5")
+	for b.Loop() {
+		if _, ok := ECDH(&key, &peer); !ok {
+			b.Fatal("ECDH failed")
+		}
+	}
+}
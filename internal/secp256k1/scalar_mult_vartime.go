@@ -0,0 +1,190 @@
+// Copyright 2026 The bip32 Authors.
+
+package secp256k1
+
+import (
+	"encoding/hex"
+
+	"github.com/islishude/bip32/v2/internal/secp256k1/field"
+	"github.com/islishude/bip32/v2/internal/secp256k1/scalar"
+)
+
+// This file holds the variable-time multiplication used for public inputs
+// only. Its running time and memory access pattern depend on the scalar, so it
+// must never be reached with private keys or other secrets.
+
+const (
+	wnafWindow    = 8
+	wnafTableSize = 1 << (wnafWindow - 2)
+	// GLV halves are below 2^128 and are cut into 16-bit chunks, each with its
+	// own table, so only about wnafChunkBits doublings remain.
+	wnafChunkBits = 16
+	wnafChunks    = 128 / wnafChunkBits
+	// wnafMaxDigits covers one chunk plus the final wNAF carry.
+	wnafMaxDigits = wnafChunkBits + 2
+)
+
+var (
+	// generatorOddMultiples[j] holds B, 3B, ..., 127B for B = 2^(16j)*G, and
+	// lambdaOddMultiples the same points under (x, y) -> (beta*x, y).
+	generatorOddMultiples = loadOddMultiplesW8(&generatorOddMultiplesW8Words)
+	lambdaOddMultiples    = endomorphismTable(&generatorOddMultiples)
+
+	beta = fieldElementFromHex("7ae96a2b657c07106e64479eac3434e99cf0497512f58995c1396c28719501ee")
+)
+
+func loadOddMultiplesW8(words *[wnafChunks][wnafTableSize][8]uint64) [wnafChunks][wnafTableSize]affinePoint {
+	var table [wnafChunks][wnafTableSize]affinePoint
+	for j := range table {
+		for i := range table[j] {
+			w := &words[j][i]
+			table[j][i].x.SetMontgomeryWords([4]uint64{w[0], w[1], w[2], w[3]})
+			table[j][i].y.SetMontgomeryWords([4]uint64{w[4], w[5], w[6], w[7]})
+		}
+	}
+	return table
+}
+
+func endomorphismTable(table *[wnafChunks][wnafTableSize]affinePoint) [wnafChunks][wnafTableSize]affinePoint {
+	var out [wnafChunks][wnafTableSize]affinePoint
+	for j := range table {
+		for i := range table[j] {
+			out[j][i].x.Mul(&table[j][i].x, &beta)
+			out[j][i].y.Set(&table[j][i].y)
+		}
+	}
+	return out
+}
+
+// wnafTerm is one chunk of a GLV half with its table and effective sign.
+type wnafTerm struct {
+	digits [wnafMaxDigits]int8
+	table  *[wnafTableSize]affinePoint
+	negate bool
+}
+
+// scalarBaseMultVarTime returns k*G using the GLV split k = k1 + k2*lambda.
+// Each half is cut into 16-bit chunks c_j with k_i = sum c_j*2^(16j), and all
+// chunks are processed with interleaved width-8 wNAF over precomputed affine
+// odd multiples of 2^(16j)*G and 2^(16j)*lambda*G. It runs in variable time.
+func scalarBaseMultVarTime(k *scalar.Element) point {
+	k1, k2, neg1, neg2 := scalar.SplitLambda(k)
+
+	var terms [2 * wnafChunks]wnafTerm
+	length := 0
+	for j := range wnafChunks {
+		t1, t2 := &terms[2*j], &terms[2*j+1]
+		t1.table, t1.negate = &generatorOddMultiples[j], neg1
+		t2.table, t2.negate = &lambdaOddMultiples[j], neg2
+		length = max(length, wnaf(&t1.digits, chunk(&k1, j)), wnaf(&t2.digits, chunk(&k2, j)))
+	}
+
+	var r point
+	r.setInfinity()
+	for i := length - 1; i >= 0; i-- {
+		r.double(&r)
+		for n := range terms {
+			if d := terms[n].digits[i]; d != 0 {
+				r.addAffineVarTime(&r, &terms[n].table[abs8(d)/2], (d < 0) != terms[n].negate)
+			}
+		}
+	}
+	return r
+}
+
+func chunk(k *[4]uint64, j int) uint64 {
+	const perWord = 64 / wnafChunkBits
+	return (k[j/perWord] >> (wnafChunkBits * (j % perWord))) & (1<<wnafChunkBits - 1)
+}
+
+// wnaf writes the width-8 non-adjacent form of k, least significant digit
+// first, and returns the number of digits used. Every non-zero digit is odd
+// with magnitude below 2^(wnafWindow-1).
+func wnaf(out *[wnafMaxDigits]int8, k uint64) int {
+	length := 0
+	carry := uint64(0)
+	for bit := 0; bit < wnafMaxDigits; {
+		if (k>>bit)&1 == carry {
+			bit++
+			continue
+		}
+		width := min(wnafWindow, wnafMaxDigits-bit)
+		word := (k>>bit)&(1<<width-1) + carry
+		carry = (word >> (wnafWindow - 1)) & 1
+		out[bit] = int8(int64(word) - int64(carry<<wnafWindow))
+		length = bit + 1
+		bit += width
+	}
+	return length
+}
+
+func abs8(d int8) int {
+	if d < 0 {
+		return -int(d)
+	}
+	return int(d)
+}
+
+// addAffineVarTime assigns p = p1 + q, or p1 - q when negate is set, using the
+// madd-2007-bl mixed Jacobian-affine formula. It branches on its inputs.
+func (p *point) addAffineVarTime(p1 *point, q *affinePoint, negate bool) *point {
+	qy := q.y
+	if negate {
+		qy.Neg(&q.y)
+	}
+	if p1.isInfinity() {
+		return p.setAffine(&q.x, &qy)
+	}
+
+	var z1z1, u2, s2, h, hh, i, j, r, v, t field.Element
+	z1z1.Square(&p1.z)
+	u2.Mul(&q.x, &z1z1)
+	t.Mul(&p1.z, &z1z1)
+	s2.Mul(&qy, &t)
+	h.Sub(&u2, &p1.x)
+	r.Sub(&s2, &p1.y)
+	if h.IsZero() {
+		if r.IsZero() {
+			return p.double(p1)
+		}
+		return p.setInfinity()
+	}
+	hh.Square(&h)
+	i.Double(&hh)
+	i.Double(&i)
+	j.Mul(&h, &i)
+	r.Double(&r)
+	v.Mul(&p1.x, &i)
+
+	var x3, y3, z3 field.Element
+	x3.Square(&r)
+	x3.Sub(&x3, &j)
+	t.Double(&v)
+	x3.Sub(&x3, &t)
+	t.Sub(&v, &x3)
+	y3.Mul(&r, &t)
+	t.Mul(&p1.y, &j)
+	t.Double(&t)
+	y3.Sub(&y3, &t)
+	t.Add(&p1.z, &h)
+	t.Square(&t)
+	t.Sub(&t, &z1z1)
+	z3.Sub(&t, &hh)
+
+	p.x.Set(&x3)
+	p.y.Set(&y3)
+	p.z.Set(&z3)
+	return p
+}
+
+func fieldElementFromHex(value string) field.Element {
+	var b [field.Size]byte
+	if n, err := hex.Decode(b[:], []byte(value)); err != nil || n != field.Size {
+		panic("secp256k1: invalid field constant")
+	}
+	var out field.Element
+	if !out.SetBytes(&b) {
+		panic("secp256k1: invalid field constant")
+	}
+	return out
+}
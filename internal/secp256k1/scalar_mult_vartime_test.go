@@ -0,0 +1,110 @@
+package secp256k1
+
+import (
+	"testing"
+
+	"github.com/islishude/bip32/v2/internal/secp256k1/scalar"
+)
+
+func TestAddScalarBaseVarTimeMatchesConstantTime(t *testing.T) {
+	generator := mustDecode33("0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798")
+	negativeGenerator := mustDecode33("0379be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798")
+	tweaks := [][32]byte{
+		scalarBytes(0),
+		scalarBytes(1),
+		scalarBytes(2),
+		scalarBytes(127),
+		scalarBytes(128),
+		mustDecode32("fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364140"),
+		mustDecode32("5363ad4cc05c30e0a5261c028812645a122e22ea20816678df02967c1b23bd72"),
+		mustDecode32("00000000000000000000000000000001ffffffffffffffffffffffffffffffff"),
+		mustDecode32("7fffffffffffffffffffffffffffffff5d576e7357a4501ddfe92f46681b20a0"),
+	}
+	state := uint64(0xa4093822299f31d0)
+	for range 64 {
+		var tweak [32]byte
+		for i := range tweak {
+			state = state*6364136223846793005 + 1442695040888963407
+			tweak[i] = byte(state >> 56)
+		}
+		tweaks = append(tweaks, tweak)
+	}
+
+	for _, parent := range [][33]byte{generator, negativeGenerator} {
+		for _, tweak := range tweaks {
+			want, wantOK := AddScalarBase(&parent, &tweak)
+			got, gotOK := AddScalarBaseVarTime(&parent, &tweak)
+			if got != want || gotOK != wantOK {
+				t.Fatalf("AddScalarBaseVarTime(%x, %x) = %x, %v; want %x, %v", parent, tweak, got, gotOK, want, wantOK)
+			}
+		}
+	}
+}
+
+func TestWNAFReconstructsChunk(t *testing.T) {
+	for _, k := range []uint64{0, 1, 0x7f, 0x80, 0xff, 0x1234, 0xaaaa, 0xffff} {
+		var digits [wnafMaxDigits]int8
+		n := wnaf(&digits, k)
+		var got int64
+		for i := n - 1; i >= 0; i-- {
+			got = got*2 + int64(digits[i])
+			if d := digits[i]; d != 0 && (d%2 == 0 || abs8(d) >= 1<<(wnafWindow-1)) {
+				t.Fatalf("invalid wNAF digit %d", d)
+			}
+		}
+		if got != int64(k) {
+			t.Fatalf("wNAF(%#x) reconstructs %#x", k, got)
+		}
+	}
+}
+
+func FuzzAddScalarBaseVarTime(f *testing.F) {
+	generator := mustDecode33("0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798")
+	one := scalarBytes(1)
+	f.Add(one[:], one[:])
+	f.Add(generator[1:], one[:])
+	f.Fuzz(func(t *testing.T, parentScalar, tweakInput []byte) {
+		if len(parentScalar) != 32 || len(tweakInput) != 32 {
+			return
+		}
+		var secret, tweak [32]byte
+		copy(secret[:], parentScalar)
+		copy(tweak[:], tweakInput)
+		parent, ok := PublicKeyFromScalar(&secret)
+		if !ok {
+			return
+		}
+		want, wantOK := AddScalarBase(&parent, &tweak)
+		got, gotOK := AddScalarBaseVarTime(&parent, &tweak)
+		if got != want || gotOK != wantOK {
+			t.Fatalf("AddScalarBaseVarTime(%x, %x) = %x, %v; want %x, %v", parent, tweak, got, gotOK, want, wantOK)
+		}
+
+		var k scalar.Element
+		if !k.SetBytes(&tweak) || k.IsZero() {
+			return
+		}
+		viaVarTime := scalarBaseMultVarTime(&k)
+		viaConstTime := scalarBaseMultProjective(&k)
+		x1, y1, ok1 := viaVarTime.affine()
+		x2, y2, ok2 := viaConstTime.affine()
+		if ok1 != ok2 || !x1.Equal(&x2) || !y1.Equal(&y2) {
+			t.Fatalf("scalarBaseMultVarTime(%x) differs from the constant-time path", tweak)
+		}
+	})
+}
+
+func BenchmarkAddScalarBase(b *testing.B) {
+	parent := mustDecode33("0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798")
+	tweak := mustDecode32("e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855")
+	b.Run("ConstantTime", func(b *testing.B) {
+		for b.Loop() {
+			AddScalarBase(&parent, &tweak)
+		}
+	})
+	b.Run("VarTime", func(b *testing.B) {
+		for b.Loop() {
+			AddScalarBaseVarTime(&parent, &tweak)
+		}
+	})
+}
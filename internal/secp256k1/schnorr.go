@@ -0,0 +1,158 @@
+// Copyright 2026 The bip32 Authors.
+
+package secp256k1
+
+import (
+	"crypto/sha256"
+
+	"github.com/islishude/bip32/v2/internal/secp256k1/field"
+	"github.com/islishude/bip32/v2/internal/secp256k1/scalar"
+)
+
+// XOnlyPublicKeySize is the byte length of a BIP-340 x-only public key.
+const XOnlyPublicKeySize = 32
+
+// TaggedHash returns the BIP-340 tagged hash SHA256(SHA256(tag) ||
+// SHA256(tag) || parts...).
+func TaggedHash(tag string, parts ...[]byte) [32]byte {
+	tagHash := sha256.Sum256([]byte(tag))
+	h := sha256.New()
+	_, _ = h.Write(tagHash[:])
+	_, _ = h.Write(tagHash[:])
+	for _, part := range parts {
+		_, _ = h.Write(part)
+	}
+	var out [32]byte
+	h.Sum(out[:0])
+	return out
+}
+
+// SignSchnorr produces a BIP-340 signature of a 32-byte message with
+// auxiliary randomness aux. The key and nonce multiplications are constant
+// time.
+func SignSchnorr(key, msg, aux *[32]byte) ([SignatureSize]byte, bool) {
+	var sig [SignatureSize]byte
+	var d scalar.Element
+	if !d.SetBytes(key) || d.IsZero() {
+		return sig, false
+	}
+	P := scalarBaseMultProjective(&d)
+	px, py, _ := P.affine()
+	if py.IsOdd() {
+		d.Neg(&d)
+	}
+	pBytes := px.Bytes()
+
+	dBytes := d.Bytes()
+	defer clear(dBytes[:])
+	t := TaggedHash("BIP0340/aux", aux[:])
+	for i := range t {
+		t[i] ^= dBytes[i]
+	}
+	nonce := TaggedHash("BIP0340/nonce", t[:], pBytes[:], msg[:])
+	clear(t[:])
+	var k scalar.Element
+	k.SetBytesReduced(&nonce)
+	clear(nonce[:])
+	if k.IsZero() {
+		return sig, false
+	}
+	R := scalarBaseMultProjective(&k)
+	rx, ry, _ := R.affine()
+	if ry.IsOdd() {
+		k.Neg(&k)
+	}
+	rBytes := rx.Bytes()
+
+	var e, s scalar.Element
+	challenge := TaggedHash("BIP0340/challenge", rBytes[:], pBytes[:], msg[:])
+	e.SetBytesReduced(&challenge)
+	s.Mul(&e, &d)
+	s.Add(&s, &k)
+	sBytes := s.Bytes()
+	copy(sig[:32], rBytes[:])
+	copy(sig[32:], sBytes[:])
+	return sig, true
+}
+
+// VerifySchnorr reports whether sig is a valid BIP-340 signature of a 32-byte
+// message by the x-only public key pub.
+func VerifySchnorr(pub, msg *[32]byte, sig *[SignatureSize]byte) bool {
+	px, py, ok := affineFromXBytes(pub, false)
+	if !ok {
+		return false
+	}
+	rBytes := [32]byte(sig[:32])
+	sBytes := [32]byte(sig[32:])
+	var s scalar.Element
+	if !field.LessThanModulus(&rBytes) || !s.SetBytes(&sBytes) {
+		return false
+	}
+
+	var e scalar.Element
+	challenge := TaggedHash("BIP0340/challenge", rBytes[:], pub[:], msg[:])
+	e.SetBytesReduced(&challenge)
+	e.Neg(&e)
+	R := linearCombination(&s, &e, &PublicPoint{x: px, y: py, valid: true})
+	rx, ry, ok := R.affine()
+	if !ok || ry.IsOdd() {
+		return false
+	}
+	return rx.Bytes() == rBytes
+}
+
+// taprootTweak returns the BIP-341 tweak scalar t = hash_TapTweak(P || root)
+// for an x-only internal key, where merkleRoot is empty for key-path-only
+// outputs such as BIP-86.
+func taprootTweak(internalKey *[32]byte, merkleRoot []byte) (scalar.Element, bool) {
+	tweak := TaggedHash("TapTweak", internalKey[:], merkleRoot)
+	var t scalar.Element
+	return t, t.SetBytes(&tweak)
+}
+
+// TaprootOutputKey returns the x-only BIP-341 output key Q = P + tG for an
+// x-only internal key P, together with the parity of Q's y-coordinate.
+func TaprootOutputKey(internalKey *[32]byte, merkleRoot []byte) (outputKey [32]byte, oddY bool, ok bool) {
+	px, py, ok := affineFromXBytes(internalKey, false)
+	if !ok {
+		return outputKey, false, false
+	}
+	t, ok := taprootTweak(internalKey, merkleRoot)
+	if !ok {
+		return outputKey, false, false
+	}
+	var one scalar.Element
+	oneBytes := [32]byte{31: 1}
+	one.SetBytes(&oneBytes)
+	Q := linearCombination(&t, &one, &PublicPoint{x: px, y: py, valid: true})
+	qx, qy, ok := Q.affine()
+	if !ok {
+		return outputKey, false, false
+	}
+	return qx.Bytes(), qy.IsOdd(), true
+}
+
+// TaprootTweakPrivateKey returns the private key for the BIP-341 output key of
+// key's x-only public key, negating key first when its public key has an odd
+// y-coordinate as BIP-341 requires.
+func TaprootTweakPrivateKey(key *[PrivateKeySize]byte, merkleRoot []byte) ([PrivateKeySize]byte, bool) {
+	var d scalar.Element
+	if !d.SetBytes(key) || d.IsZero() {
+		return [PrivateKeySize]byte{}, false
+	}
+	P := scalarBaseMultProjective(&d)
+	px, py, _ := P.affine()
+	if py.IsOdd() {
+		d.Neg(&d)
+	}
+	pBytes := px.Bytes()
+	t, ok := taprootTweak(&pBytes, merkleRoot)
+	if !ok {
+		return [PrivateKeySize]byte{}, false
+	}
+	d.Add(&d, &t)
+	if d.IsZero() {
+		return [PrivateKeySize]byte{}, false
+	}
+	return d.Bytes(), true
+}
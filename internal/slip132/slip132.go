@@ -0,0 +1,89 @@
+// Package slip132 lists the SLIP-132 extended-key versions for Bitcoin
+// mainnet and testnet, shared by the key inspector and the bip32 command.
+//
+// bip32secp256k1 accepts only the standard xprv/xpub/tprv/tpub versions, so
+// callers swap another version for the standard one of the same network and
+// kind before parsing, and swap it back when encoding.
+package slip132
+
+import "github.com/islishude/bip32/v2/bip32secp256k1"
+
+// Version is one SLIP-132 extended-key version.
+type Version struct {
+	Name    string
+	Bytes   [4]byte
+	Private bool
+	Network bip32secp256k1.Network
+	// Address is the single-key address type of the family, or zero for the
+	// multisig families, which have no single-key address.
+	Address bip32secp256k1.AddressType
+}
+
+// Versions lists the registry entries. The first entry of each network and
+// kind is the BIP-32 default.
+var Versions = []Version{
+	{"xprv", [4]byte{0x04, 0x88, 0xad, 0xe4}, true, bip32secp256k1.Mainnet, bip32secp256k1.P2PKH},
+	{"xpub", [4]byte{0x04, 0x88, 0xb2, 0x1e}, false, bip32secp256k1.Mainnet, bip32secp256k1.P2PKH},
+	{"yprv", [4]byte{0x04, 0x9d, 0x78, 0x78}, true, bip32secp256k1.Mainnet, bip32secp256k1.P2SHP2WPKH},
+	{"ypub", [4]byte{0x04, 0x9d, 0x7c, 0xb2}, false, bip32secp256k1.Mainnet, bip32secp256k1.P2SHP2WPKH},
+	{"zprv", [4]byte{0x04, 0xb2, 0x43, 0x0c}, true, bip32secp256k1.Mainnet, bip32secp256k1.P2WPKH},
+	{"zpub", [4]byte{0x04, 0xb2, 0x47, 0x46}, false, bip32secp256k1.Mainnet, bip32secp256k1.P2WPKH},
+	{"Yprv", [4]byte{0x02, 0x95, 0xb0, 0x05}, true, bip32secp256k1.Mainnet, 0},
+	{"Ypub", [4]byte{0x02, 0x95, 0xb4, 0x3f}, false, bip32secp256k1.Mainnet, 0},
+	{"Zprv", [4]byte{0x02, 0xaa, 0x7a, 0x99}, true, bip32secp256k1.Mainnet, 0},
+	{"Zpub", [4]byte{0x02, 0xaa, 0x7e, 0xd3}, false, bip32secp256k1.Mainnet, 0},
+	{"tprv", [4]byte{0x04, 0x35, 0x83, 0x94}, true, bip32secp256k1.Testnet, bip32secp256k1.P2PKH},
+	{"tpub", [4]byte{0x04, 0x35, 0x87, 0xcf}, false, bip32secp256k1.Testnet, bip32secp256k1.P2PKH},
+	{"uprv", [4]byte{0x04, 0x4a, 0x4e, 0x28}, true, bip32secp256k1.Testnet, bip32secp256k1.P2SHP2WPKH},
+	{"upub", [4]byte{0x04, 0x4a, 0x52, 0x62}, false, bip32secp256k1.Testnet, bip32secp256k1.P2SHP2WPKH},
+	{"vprv", [4]byte{0x04, 0x5f, 0x18, 0xbc}, true, bip32secp256k1.Testnet, bip32secp256k1.P2WPKH},
+	{"vpub", [4]byte{0x04, 0x5f, 0x1c, 0xf6}, false, bip32secp256k1.Testnet, bip32secp256k1.P2WPKH},
+	{"Uprv", [4]byte{0x02, 0x42, 0x85, 0xb5}, true, bip32secp256k1.Testnet, 0},
+	{"Upub", [4]byte{0x02, 0x42, 0x89, 0xef}, false, bip32secp256k1.Testnet, 0},
+	{"Vprv", [4]byte{0x02, 0x57, 0x50, 0x48}, true, bip32secp256k1.Testnet, 0},
+	{"Vpub", [4]byte{0x02, 0x57, 0x54, 0x83}, false, bip32secp256k1.Testnet, 0},
+}
+
+// ByBytes returns the version with the given version bytes.
+func ByBytes(b [4]byte) (Version, bool) {
+	for _, v := range Versions {
+		if v.Bytes == b {
+			return v, true
+		}
+	}
+	return Version{}, false
+}
+
+// ByName returns the version with the given name, such as "zpub".
+func ByName(name string) (Version, bool) {
+	for _, v := range Versions {
+		if v.Name == name {
+			return v, true
+		}
+	}
+	return Version{}, false
+}
+
+// Standard returns the xprv/xpub/tprv/tpub version for a network and kind.
+func Standard(network bip32secp256k1.Network, private bool) Version {
+	for _, v := range Versions {
+		if v.Network == network && v.Private == private {
+			return v
+		}
+	}
+	panic("slip132: missing standard version")
+}
+
+// Public returns the public version of v's family. Each family shares its
+// first letter: zprv pairs with zpub.
+func (v Version) Public() Version {
+	if !v.Private {
+		return v
+	}
+	for _, candidate := range Versions {
+		if !candidate.Private && candidate.Name[0] == v.Name[0] {
+			return candidate
+		}
+	}
+	panic("slip132: missing public version")
+}
@@ -0,0 +1,199 @@
+package bip32
+
+// Curve names the scheme an extended key belongs to. The values match the
+// curve field of the curve packages' keystore files.
+type Curve string
+
+const (
+	// CurveSecp256k1 tags standard BIP-32 keys from bip32secp256k1.
+	CurveSecp256k1 Curve = "secp256k1"
+	// CurveEd25519 tags Khovratovich-Law keys from bip32ed25519.
+	CurveEd25519 Curve = "ed25519-bip32"
+)
+
+// ExtendedPublicKey is the part of an extended public key API shared by
+// *bip32secp256k1.XPub and *bip32ed25519.XPub. Pub is the implementing type,
+// so derivation returns the concrete key and code generic over the curve
+// keeps full access to it:
+//
+//	func receive[Pub bip32.ExtendedPublicKey[Pub]](account Pub) ([]byte, error) {
+//		child, err := account.DeriveRelativePath("0/0")
+//		if err != nil {
+//			return nil, err
+//		}
+//		return child.PublicKeyBytes(), nil
+//	}
+//
+// Keys are never converted between curves. Code that needs keys of both
+// curves in one slice or map uses PublicKey and PrivateKey instead.
+type ExtendedPublicKey[Pub any] interface {
+	// Curve reports the scheme of the key.
+	Curve() Curve
+	// Derive derives a normal child.
+	Derive(index uint32) (Pub, error)
+	// DeriveRelativePath derives a normal relative path such as 0/0.
+	DeriveRelativePath(path string) (Pub, error)
+	// PublicKeyBytes returns a copy of the encoded public key: 33 compressed
+	// SEC 1 bytes for secp256k1 and 32 bytes for Ed25519.
+	PublicKeyBytes() []byte
+	// ChainCode returns a copy of the chain code.
+	ChainCode() []byte
+	// PathDepth returns the derivation depth. bip32secp256k1 serializes depth
+	// in one byte and bip32ed25519 tracks it as a uint32; PathDepth widens
+	// both to uint32 while each package's Depth keeps its own type.
+	PathDepth() uint32
+	// ChildNumber returns the index the key was derived at.
+	ChildNumber() uint32
+}
+
+// ExtendedPrivateKey is the part of an extended private key API shared by
+// *bip32secp256k1.XPrv and *bip32ed25519.XPrv, with Prv and Pub the concrete
+// private and public key types. DerivePath keeps each package's rules:
+// bip32secp256k1 accepts absolute paths only from a master key.
+type ExtendedPrivateKey[Prv, Pub any] interface {
+	// Curve reports the scheme of the key.
+	Curve() Curve
+	// Derive derives a normal or hardened child.
+	Derive(index uint32) (Prv, error)
+	// DerivePath derives an absolute path such as m/44'/0'/0'.
+	DerivePath(path string) (Prv, error)
+	// DeriveRelativePath derives a path relative to the key.
+	DeriveRelativePath(path string) (Prv, error)
+	// XPub returns the matching extended public key.
+	XPub() (Pub, error)
+	// PublicKeyBytes returns a copy of the encoded public key, as
+	// ExtendedPublicKey.PublicKeyBytes does.
+	PublicKeyBytes() ([]byte, error)
+	// ChainCode returns a copy of the chain code.
+	ChainCode() []byte
+	// PathDepth returns the derivation depth as a uint32.
+	PathDepth() uint32
+	// ChildNumber returns the index the key was derived at.
+	ChildNumber() uint32
+	// Wipe clears the key material on a best-effort basis.
+	Wipe()
+}
+
+// PublicKey is ExtendedPublicKey with the key type erased, so that keys of
+// both curves can share a variable, slice, or map. Derivation returns
+// PublicKey. NewPublicKey adapts a concrete key, and Unwrap recovers it:
+//
+//	keys := []bip32.PublicKey{bip32.NewPublicKey(secpXPub), bip32.NewPublicKey(edXPub)}
+type PublicKey interface {
+	// Curve reports the scheme of the key.
+	Curve() Curve
+	// Derive derives a normal child.
+	Derive(index uint32) (PublicKey, error)
+	// DeriveRelativePath derives a normal relative path such as 0/0.
+	DeriveRelativePath(path string) (PublicKey, error)
+	// PublicKeyBytes returns a copy of the encoded public key.
+	PublicKeyBytes() []byte
+	// ChainCode returns a copy of the chain code.
+	ChainCode() []byte
+	// PathDepth returns the derivation depth as a uint32.
+	PathDepth() uint32
+	// ChildNumber returns the index the key was derived at.
+	ChildNumber() uint32
+	// Unwrap returns the concrete key, such as a *bip32ed25519.XPub.
+	Unwrap() any
+}
+
+// PrivateKey is ExtendedPrivateKey with the key types erased. Derivation
+// returns PrivateKey and XPub returns PublicKey.
+type PrivateKey interface {
+	// Curve reports the scheme of the key.
+	Curve() Curve
+	// Derive derives a normal or hardened child.
+	Derive(index uint32) (PrivateKey, error)
+	// DerivePath derives an absolute path such as m/44'/0'/0'.
+	DerivePath(path string) (PrivateKey, error)
+	// DeriveRelativePath derives a path relative to the key.
+	DeriveRelativePath(path string) (PrivateKey, error)
+	// XPub returns the matching extended public key.
+	XPub() (PublicKey, error)
+	// PublicKeyBytes returns a copy of the encoded public key.
+	PublicKeyBytes() ([]byte, error)
+	// ChainCode returns a copy of the chain code.
+	ChainCode() []byte
+	// PathDepth returns the derivation depth as a uint32.
+	PathDepth() uint32
+	// ChildNumber returns the index the key was derived at.
+	ChildNumber() uint32
+	// Wipe clears the key material on a best-effort basis.
+	Wipe()
+	// Unwrap returns the concrete key, such as a *bip32secp256k1.XPrv.
+	Unwrap() any
+}
+
+// NewPublicKey returns key as a PublicKey.
+func NewPublicKey[Pub ExtendedPublicKey[Pub]](key Pub) PublicKey {
+	return publicKey[Pub]{key}
+}
+
+// NewPrivateKey returns key as a PrivateKey.
+func NewPrivateKey[Prv ExtendedPrivateKey[Prv, Pub], Pub ExtendedPublicKey[Pub]](key Prv) PrivateKey {
+	return privateKey[Prv, Pub]{key}
+}
+
+type publicKey[Pub ExtendedPublicKey[Pub]] struct {
+	key Pub
+}
+
+func (p publicKey[Pub]) Curve() Curve           { return p.key.Curve() }
+func (p publicKey[Pub]) PublicKeyBytes() []byte { return p.key.PublicKeyBytes() }
+func (p publicKey[Pub]) ChainCode() []byte      { return p.key.ChainCode() }
+func (p publicKey[Pub]) PathDepth() uint32      { return p.key.PathDepth() }
+func (p publicKey[Pub]) ChildNumber() uint32    { return p.key.ChildNumber() }
+func (p publicKey[Pub]) Unwrap() any            { return p.key }
+
+func (p publicKey[Pub]) Derive(index uint32) (PublicKey, error) {
+	return wrapPublic(p.key.Derive(index))
+}
+
+func (p publicKey[Pub]) DeriveRelativePath(path string) (PublicKey, error) {
+	return wrapPublic(p.key.DeriveRelativePath(path))
+}
+
+type privateKey[Prv ExtendedPrivateKey[Prv, Pub], Pub ExtendedPublicKey[Pub]] struct {
+	key Prv
+}
+
+func (k privateKey[Prv, Pub]) Curve() Curve                    { return k.key.Curve() }
+func (k privateKey[Prv, Pub]) PublicKeyBytes() ([]byte, error) { return k.key.PublicKeyBytes() }
+func (k privateKey[Prv, Pub]) ChainCode() []byte               { return k.key.ChainCode() }
+func (k privateKey[Prv, Pub]) PathDepth() uint32               { return k.key.PathDepth() }
+func (k privateKey[Prv, Pub]) ChildNumber() uint32             { return k.key.ChildNumber() }
+func (k privateKey[Prv, Pub]) Wipe()                           { k.key.Wipe() }
+func (k privateKey[Prv, Pub]) Unwrap() any                     { return k.key }
+
+func (k privateKey[Prv, Pub]) Derive(index uint32) (PrivateKey, error) {
+	return wrapPrivate(k.key.Derive(index))
+}
+
+func (k privateKey[Prv, Pub]) DerivePath(path string) (PrivateKey, error) {
+	return wrapPrivate(k.key.DerivePath(path))
+}
+
+func (k privateKey[Prv, Pub]) DeriveRelativePath(path string) (PrivateKey, error) {
+	return wrapPrivate(k.key.DeriveRelativePath(path))
+}
+
+func (k privateKey[Prv, Pub]) XPub() (PublicKey, error) {
+	return wrapPublic(k.key.XPub())
+}
+
+// wrapPublic and wrapPrivate adapt a derivation result, returning a nil
+// interface rather than a wrapped nil key on error.
+func wrapPublic[Pub ExtendedPublicKey[Pub]](key Pub, err error) (PublicKey, error) {
+	if err != nil {
+		return nil, err
+	}
+	return NewPublicKey(key), nil
+}
+
+func wrapPrivate[Prv ExtendedPrivateKey[Prv, Pub], Pub ExtendedPublicKey[Pub]](key Prv, err error) (PrivateKey, error) {
+	if err != nil {
+		return nil, err
+	}
+	return NewPrivateKey(key), nil
+}
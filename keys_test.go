@@ -0,0 +1,135 @@
+package bip32_test
+
+import (
+	"bytes"
+	"testing"
+
+	bip32 "github.com/islishude/bip32/v2"
+	"github.com/islishude/bip32/v2/bip32ed25519"
+	"github.com/islishude/bip32/v2/bip32secp256k1"
+)
+
+// accountReceive walks an account the way a curve-agnostic wallet would and
+// checks that the public and private halves agree.
+func accountReceive[Prv bip32.ExtendedPrivateKey[Prv, Pub], Pub bip32.ExtendedPublicKey[Pub]](t *testing.T, root Prv, account string, want bip32.Curve) {
+	t.Helper()
+	if root.Curve() != want {
+		t.Fatalf("Curve() = %q, want %q", root.Curve(), want)
+	}
+	acct, err := root.DerivePath(account)
+	if err != nil {
+		t.Fatalf("DerivePath: %v", err)
+	}
+	defer acct.Wipe()
+	xpub, err := acct.XPub()
+	if err != nil {
+		t.Fatalf("XPub: %v", err)
+	}
+	if xpub.Curve() != want || xpub.PathDepth() != 3 || xpub.ChildNumber() != bip32.HardenedOffset {
+		t.Fatalf("account metadata = %q, %d, %#x", xpub.Curve(), xpub.PathDepth(), xpub.ChildNumber())
+	}
+
+	pubChild, err := xpub.DeriveRelativePath("0/7")
+	if err != nil {
+		t.Fatalf("XPub.DeriveRelativePath: %v", err)
+	}
+	prvChild, err := acct.DeriveRelativePath("0/7")
+	if err != nil {
+		t.Fatalf("XPrv.DeriveRelativePath: %v", err)
+	}
+	defer prvChild.Wipe()
+	prvPub, err := prvChild.PublicKeyBytes()
+	if err != nil {
+		t.Fatalf("PublicKeyBytes: %v", err)
+	}
+	if !bytes.Equal(prvPub, pubChild.PublicKeyBytes()) || !bytes.Equal(prvChild.ChainCode(), pubChild.ChainCode()) {
+		t.Fatal("private and public derivation disagree")
+	}
+	if prvChild.PathDepth() != 5 || pubChild.PathDepth() != 5 || pubChild.ChildNumber() != 7 {
+		t.Fatalf("child metadata = %d, %d, %d", prvChild.PathDepth(), pubChild.PathDepth(), pubChild.ChildNumber())
+	}
+
+	direct, err := xpub.Derive(0)
+	if err != nil {
+		t.Fatalf("XPub.Derive: %v", err)
+	}
+	if direct, err = direct.Derive(7); err != nil || !bytes.Equal(direct.PublicKeyBytes(), prvPub) {
+		t.Fatalf("XPub.Derive chain = %v", err)
+	}
+}
+
+func TestExtendedKeyInterfaces(t *testing.T) {
+	seed := bytes.Repeat([]byte{0x42}, 32)
+
+	secp, err := bip32secp256k1.NewMasterKey(seed, bip32secp256k1.Mainnet)
+	if err != nil {
+		t.Fatalf("secp256k1 NewMasterKey: %v", err)
+	}
+	accountReceive(t, secp, "m/84'/0'/0'", bip32.CurveSecp256k1)
+
+	ed, err := bip32ed25519.NewMasterKeyIcarus(seed, nil)
+	if err != nil {
+		t.Fatalf("ed25519 NewMasterKeyIcarus: %v", err)
+	}
+	accountReceive(t, ed, "m/1852'/1815'/0'", bip32.CurveEd25519)
+}
+
+func TestKeyInterfacesMixCurves(t *testing.T) {
+	seed := bytes.Repeat([]byte{0x42}, 32)
+	secp, err := bip32secp256k1.NewMasterKey(seed, bip32secp256k1.Mainnet)
+	if err != nil {
+		t.Fatalf("secp256k1 NewMasterKey: %v", err)
+	}
+	ed, err := bip32ed25519.NewMasterKeyIcarus(seed, nil)
+	if err != nil {
+		t.Fatalf("ed25519 NewMasterKeyIcarus: %v", err)
+	}
+
+	roots := []bip32.PrivateKey{bip32.NewPrivateKey(secp), bip32.NewPrivateKey(ed)}
+	accounts := []string{"m/84'/0'/0'", "m/1852'/1815'/0'"}
+	curves := []bip32.Curve{bip32.CurveSecp256k1, bip32.CurveEd25519}
+	var xpubs []bip32.PublicKey
+	for i, root := range roots {
+		acct, err := root.DerivePath(accounts[i])
+		if err != nil {
+			t.Fatalf("DerivePath(%s): %v", accounts[i], err)
+		}
+		xpub, err := acct.XPub()
+		if err != nil {
+			t.Fatalf("XPub: %v", err)
+		}
+		xpubs = append(xpubs, xpub)
+
+		prvChild, err := acct.DeriveRelativePath("0/7")
+		if err != nil {
+			t.Fatalf("XPrv.DeriveRelativePath: %v", err)
+		}
+		want, _ := prvChild.PublicKeyBytes()
+		acct.Wipe()
+		prvChild.Wipe()
+		pubChild, err := xpub.Derive(0)
+		if err == nil {
+			pubChild, err = pubChild.Derive(7)
+		}
+		if err != nil || !bytes.Equal(pubChild.PublicKeyBytes(), want) {
+			t.Fatalf("%s: XPub.Derive chain = %v", curves[i], err)
+		}
+	}
+
+	for i, xpub := range xpubs {
+		if xpub.Curve() != curves[i] || xpub.PathDepth() != 3 || xpub.ChildNumber() != bip32.HardenedOffset {
+			t.Fatalf("key %d metadata = %q, %d, %#x", i, xpub.Curve(), xpub.PathDepth(), xpub.ChildNumber())
+		}
+	}
+	if _, ok := xpubs[0].Unwrap().(*bip32secp256k1.XPub); !ok {
+		t.Fatalf("Unwrap = %T, want *bip32secp256k1.XPub", xpubs[0].Unwrap())
+	}
+	if _, ok := xpubs[1].Unwrap().(*bip32ed25519.XPub); !ok {
+		t.Fatalf("Unwrap = %T, want *bip32ed25519.XPub", xpubs[1].Unwrap())
+	}
+
+	child, err := xpubs[1].Derive(bip32.HardenedOffset)
+	if err == nil || child != nil {
+		t.Fatalf("hardened XPub.Derive = %v, %v; want a nil key and an error", child, err)
+	}
+}